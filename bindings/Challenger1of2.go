@@ -0,0 +1,441 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Challenger1of2MetaData contains all meta data concerning the Challenger1of2 contract.
+var Challenger1of2MetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_opSigner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_otherSigner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_l2OutputOracleProxy\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"_result\",\"type\":\"bytes\"}],\"name\":\"ChallengerCallExecuted\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"L2_OUTPUT_ORACLE_PROXY\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"OP_SIGNER\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"OTHER_SIGNER\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"execute\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// Challenger1of2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use Challenger1of2MetaData.ABI instead.
+var Challenger1of2ABI = Challenger1of2MetaData.ABI
+
+// Challenger1of2 is an auto generated Go binding around an Ethereum contract.
+type Challenger1of2 struct {
+	Challenger1of2Caller     // Read-only binding to the contract
+	Challenger1of2Transactor // Write-only binding to the contract
+	Challenger1of2Filterer   // Log filterer for contract events
+}
+
+// Challenger1of2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type Challenger1of2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Challenger1of2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type Challenger1of2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Challenger1of2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Challenger1of2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Challenger1of2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Challenger1of2Session struct {
+	Contract     *Challenger1of2   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// Challenger1of2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Challenger1of2CallerSession struct {
+	Contract *Challenger1of2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// Challenger1of2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Challenger1of2TransactorSession struct {
+	Contract     *Challenger1of2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// Challenger1of2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type Challenger1of2Raw struct {
+	Contract *Challenger1of2 // Generic contract binding to access the raw methods on
+}
+
+// Challenger1of2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Challenger1of2CallerRaw struct {
+	Contract *Challenger1of2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// Challenger1of2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Challenger1of2TransactorRaw struct {
+	Contract *Challenger1of2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewChallenger1of2 creates a new instance of Challenger1of2, bound to a specific deployed contract.
+func NewChallenger1of2(address common.Address, backend bind.ContractBackend) (*Challenger1of2, error) {
+	contract, err := bindChallenger1of2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Challenger1of2{Challenger1of2Caller: Challenger1of2Caller{contract: contract}, Challenger1of2Transactor: Challenger1of2Transactor{contract: contract}, Challenger1of2Filterer: Challenger1of2Filterer{contract: contract}}, nil
+}
+
+// NewChallenger1of2Caller creates a new read-only instance of Challenger1of2, bound to a specific deployed contract.
+func NewChallenger1of2Caller(address common.Address, caller bind.ContractCaller) (*Challenger1of2Caller, error) {
+	contract, err := bindChallenger1of2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Challenger1of2Caller{contract: contract}, nil
+}
+
+// NewChallenger1of2Transactor creates a new write-only instance of Challenger1of2, bound to a specific deployed contract.
+func NewChallenger1of2Transactor(address common.Address, transactor bind.ContractTransactor) (*Challenger1of2Transactor, error) {
+	contract, err := bindChallenger1of2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Challenger1of2Transactor{contract: contract}, nil
+}
+
+// NewChallenger1of2Filterer creates a new log filterer instance of Challenger1of2, bound to a specific deployed contract.
+func NewChallenger1of2Filterer(address common.Address, filterer bind.ContractFilterer) (*Challenger1of2Filterer, error) {
+	contract, err := bindChallenger1of2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Challenger1of2Filterer{contract: contract}, nil
+}
+
+// bindChallenger1of2 binds a generic wrapper to an already deployed contract.
+func bindChallenger1of2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Challenger1of2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Challenger1of2 *Challenger1of2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Challenger1of2.Contract.Challenger1of2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Challenger1of2 *Challenger1of2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Challenger1of2.Contract.Challenger1of2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Challenger1of2 *Challenger1of2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Challenger1of2.Contract.Challenger1of2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Challenger1of2 *Challenger1of2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Challenger1of2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Challenger1of2 *Challenger1of2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Challenger1of2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Challenger1of2 *Challenger1of2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Challenger1of2.Contract.contract.Transact(opts, method, params...)
+}
+
+// L2OUTPUTORACLEPROXY is a free data retrieval call binding the contract method 0x40f2b79d.
+//
+// Solidity: function L2_OUTPUT_ORACLE_PROXY() view returns(address)
+func (_Challenger1of2 *Challenger1of2Caller) L2OUTPUTORACLEPROXY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Challenger1of2.contract.Call(opts, &out, "L2_OUTPUT_ORACLE_PROXY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// L2OUTPUTORACLEPROXY is a free data retrieval call binding the contract method 0x40f2b79d.
+//
+// Solidity: function L2_OUTPUT_ORACLE_PROXY() view returns(address)
+func (_Challenger1of2 *Challenger1of2Session) L2OUTPUTORACLEPROXY() (common.Address, error) {
+	return _Challenger1of2.Contract.L2OUTPUTORACLEPROXY(&_Challenger1of2.CallOpts)
+}
+
+// L2OUTPUTORACLEPROXY is a free data retrieval call binding the contract method 0x40f2b79d.
+//
+// Solidity: function L2_OUTPUT_ORACLE_PROXY() view returns(address)
+func (_Challenger1of2 *Challenger1of2CallerSession) L2OUTPUTORACLEPROXY() (common.Address, error) {
+	return _Challenger1of2.Contract.L2OUTPUTORACLEPROXY(&_Challenger1of2.CallOpts)
+}
+
+// OPSIGNER is a free data retrieval call binding the contract method 0xf7bc369b.
+//
+// Solidity: function OP_SIGNER() view returns(address)
+func (_Challenger1of2 *Challenger1of2Caller) OPSIGNER(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Challenger1of2.contract.Call(opts, &out, "OP_SIGNER")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OPSIGNER is a free data retrieval call binding the contract method 0xf7bc369b.
+//
+// Solidity: function OP_SIGNER() view returns(address)
+func (_Challenger1of2 *Challenger1of2Session) OPSIGNER() (common.Address, error) {
+	return _Challenger1of2.Contract.OPSIGNER(&_Challenger1of2.CallOpts)
+}
+
+// OPSIGNER is a free data retrieval call binding the contract method 0xf7bc369b.
+//
+// Solidity: function OP_SIGNER() view returns(address)
+func (_Challenger1of2 *Challenger1of2CallerSession) OPSIGNER() (common.Address, error) {
+	return _Challenger1of2.Contract.OPSIGNER(&_Challenger1of2.CallOpts)
+}
+
+// OTHERSIGNER is a free data retrieval call binding the contract method 0xb822d6d9.
+//
+// Solidity: function OTHER_SIGNER() view returns(address)
+func (_Challenger1of2 *Challenger1of2Caller) OTHERSIGNER(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Challenger1of2.contract.Call(opts, &out, "OTHER_SIGNER")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OTHERSIGNER is a free data retrieval call binding the contract method 0xb822d6d9.
+//
+// Solidity: function OTHER_SIGNER() view returns(address)
+func (_Challenger1of2 *Challenger1of2Session) OTHERSIGNER() (common.Address, error) {
+	return _Challenger1of2.Contract.OTHERSIGNER(&_Challenger1of2.CallOpts)
+}
+
+// OTHERSIGNER is a free data retrieval call binding the contract method 0xb822d6d9.
+//
+// Solidity: function OTHER_SIGNER() view returns(address)
+func (_Challenger1of2 *Challenger1of2CallerSession) OTHERSIGNER() (common.Address, error) {
+	return _Challenger1of2.Contract.OTHERSIGNER(&_Challenger1of2.CallOpts)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0x09c5eabe.
+//
+// Solidity: function execute(bytes _data) returns()
+func (_Challenger1of2 *Challenger1of2Transactor) Execute(opts *bind.TransactOpts, _data []byte) (*types.Transaction, error) {
+	return _Challenger1of2.contract.Transact(opts, "execute", _data)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0x09c5eabe.
+//
+// Solidity: function execute(bytes _data) returns()
+func (_Challenger1of2 *Challenger1of2Session) Execute(_data []byte) (*types.Transaction, error) {
+	return _Challenger1of2.Contract.Execute(&_Challenger1of2.TransactOpts, _data)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0x09c5eabe.
+//
+// Solidity: function execute(bytes _data) returns()
+func (_Challenger1of2 *Challenger1of2TransactorSession) Execute(_data []byte) (*types.Transaction, error) {
+	return _Challenger1of2.Contract.Execute(&_Challenger1of2.TransactOpts, _data)
+}
+
+// Challenger1of2ChallengerCallExecutedIterator is returned from FilterChallengerCallExecuted and is used to iterate over the raw logs and unpacked data for ChallengerCallExecuted events raised by the Challenger1of2 contract.
+type Challenger1of2ChallengerCallExecutedIterator struct {
+	Event *Challenger1of2ChallengerCallExecuted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Challenger1of2ChallengerCallExecutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Challenger1of2ChallengerCallExecuted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Challenger1of2ChallengerCallExecuted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Challenger1of2ChallengerCallExecutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Challenger1of2ChallengerCallExecutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Challenger1of2ChallengerCallExecuted represents a ChallengerCallExecuted event raised by the Challenger1of2 contract.
+type Challenger1of2ChallengerCallExecuted struct {
+	Caller common.Address
+	Data   []byte
+	Result []byte
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterChallengerCallExecuted is a free log retrieval operation binding the contract event 0xcb847280fc429a1fa2adb0778d17d774edd25630d2f18643b2261da6499b0b0f.
+//
+// Solidity: event ChallengerCallExecuted(address indexed _caller, bytes _data, bytes _result)
+func (_Challenger1of2 *Challenger1of2Filterer) FilterChallengerCallExecuted(opts *bind.FilterOpts, _caller []common.Address) (*Challenger1of2ChallengerCallExecutedIterator, error) {
+
+	var _callerRule []interface{}
+	for _, _callerItem := range _caller {
+		_callerRule = append(_callerRule, _callerItem)
+	}
+
+	logs, sub, err := _Challenger1of2.contract.FilterLogs(opts, "ChallengerCallExecuted", _callerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Challenger1of2ChallengerCallExecutedIterator{contract: _Challenger1of2.contract, event: "ChallengerCallExecuted", logs: logs, sub: sub}, nil
+}
+
+// WatchChallengerCallExecuted is a free log subscription operation binding the contract event 0xcb847280fc429a1fa2adb0778d17d774edd25630d2f18643b2261da6499b0b0f.
+//
+// Solidity: event ChallengerCallExecuted(address indexed _caller, bytes _data, bytes _result)
+func (_Challenger1of2 *Challenger1of2Filterer) WatchChallengerCallExecuted(opts *bind.WatchOpts, sink chan<- *Challenger1of2ChallengerCallExecuted, _caller []common.Address) (event.Subscription, error) {
+
+	var _callerRule []interface{}
+	for _, _callerItem := range _caller {
+		_callerRule = append(_callerRule, _callerItem)
+	}
+
+	logs, sub, err := _Challenger1of2.contract.WatchLogs(opts, "ChallengerCallExecuted", _callerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Challenger1of2ChallengerCallExecuted)
+				if err := _Challenger1of2.contract.UnpackLog(event, "ChallengerCallExecuted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChallengerCallExecuted is a log parse operation binding the contract event 0xcb847280fc429a1fa2adb0778d17d774edd25630d2f18643b2261da6499b0b0f.
+//
+// Solidity: event ChallengerCallExecuted(address indexed _caller, bytes _data, bytes _result)
+func (_Challenger1of2 *Challenger1of2Filterer) ParseChallengerCallExecuted(log types.Log) (*Challenger1of2ChallengerCallExecuted, error) {
+	event := new(Challenger1of2ChallengerCallExecuted)
+	if err := _Challenger1of2.contract.UnpackLog(event, "ChallengerCallExecuted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,620 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// FeeVaultMetaData contains all meta data concerning the FeeVault contract.
+var FeeVaultMetaData = &bind.MetaData{
+	ABI: "[{\"stateMutability\":\"payable\",\"type\":\"receive\"},{\"inputs\":[],\"name\":\"MIN_WITHDRAWAL_AMOUNT\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RECIPIENT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"WITHDRAWAL_NETWORK\",\"outputs\":[{\"internalType\":\"enumFeeVault.WithdrawalNetwork\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalProcessed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdraw\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"}],\"name\":\"Withdrawal\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"enumFeeVault.WithdrawalNetwork\",\"name\":\"withdrawalNetwork\",\"type\":\"uint8\"}],\"name\":\"Withdrawal\",\"type\":\"event\"}]",
+}
+
+// FeeVaultABI is the input ABI used to generate the binding from.
+// Deprecated: Use FeeVaultMetaData.ABI instead.
+var FeeVaultABI = FeeVaultMetaData.ABI
+
+// FeeVault is an auto generated Go binding around an Ethereum contract.
+type FeeVault struct {
+	FeeVaultCaller     // Read-only binding to the contract
+	FeeVaultTransactor // Write-only binding to the contract
+	FeeVaultFilterer   // Log filterer for contract events
+}
+
+// FeeVaultCaller is an auto generated read-only Go binding around an Ethereum contract.
+type FeeVaultCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FeeVaultTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type FeeVaultTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FeeVaultFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type FeeVaultFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FeeVaultSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type FeeVaultSession struct {
+	Contract     *FeeVault         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// FeeVaultCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type FeeVaultCallerSession struct {
+	Contract *FeeVaultCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// FeeVaultTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type FeeVaultTransactorSession struct {
+	Contract     *FeeVaultTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// FeeVaultRaw is an auto generated low-level Go binding around an Ethereum contract.
+type FeeVaultRaw struct {
+	Contract *FeeVault // Generic contract binding to access the raw methods on
+}
+
+// FeeVaultCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type FeeVaultCallerRaw struct {
+	Contract *FeeVaultCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// FeeVaultTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type FeeVaultTransactorRaw struct {
+	Contract *FeeVaultTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewFeeVault creates a new instance of FeeVault, bound to a specific deployed contract.
+func NewFeeVault(address common.Address, backend bind.ContractBackend) (*FeeVault, error) {
+	contract, err := bindFeeVault(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &FeeVault{FeeVaultCaller: FeeVaultCaller{contract: contract}, FeeVaultTransactor: FeeVaultTransactor{contract: contract}, FeeVaultFilterer: FeeVaultFilterer{contract: contract}}, nil
+}
+
+// NewFeeVaultCaller creates a new read-only instance of FeeVault, bound to a specific deployed contract.
+func NewFeeVaultCaller(address common.Address, caller bind.ContractCaller) (*FeeVaultCaller, error) {
+	contract, err := bindFeeVault(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FeeVaultCaller{contract: contract}, nil
+}
+
+// NewFeeVaultTransactor creates a new write-only instance of FeeVault, bound to a specific deployed contract.
+func NewFeeVaultTransactor(address common.Address, transactor bind.ContractTransactor) (*FeeVaultTransactor, error) {
+	contract, err := bindFeeVault(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FeeVaultTransactor{contract: contract}, nil
+}
+
+// NewFeeVaultFilterer creates a new log filterer instance of FeeVault, bound to a specific deployed contract.
+func NewFeeVaultFilterer(address common.Address, filterer bind.ContractFilterer) (*FeeVaultFilterer, error) {
+	contract, err := bindFeeVault(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &FeeVaultFilterer{contract: contract}, nil
+}
+
+// bindFeeVault binds a generic wrapper to an already deployed contract.
+func bindFeeVault(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := FeeVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FeeVault *FeeVaultRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _FeeVault.Contract.FeeVaultCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FeeVault *FeeVaultRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FeeVault.Contract.FeeVaultTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FeeVault *FeeVaultRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FeeVault.Contract.FeeVaultTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FeeVault *FeeVaultCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _FeeVault.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FeeVault *FeeVaultTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FeeVault.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FeeVault *FeeVaultTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FeeVault.Contract.contract.Transact(opts, method, params...)
+}
+
+// MINWITHDRAWALAMOUNT is a free data retrieval call binding the contract method 0xd3e5792b.
+//
+// Solidity: function MIN_WITHDRAWAL_AMOUNT() view returns(uint256)
+func (_FeeVault *FeeVaultCaller) MINWITHDRAWALAMOUNT(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FeeVault.contract.Call(opts, &out, "MIN_WITHDRAWAL_AMOUNT")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MINWITHDRAWALAMOUNT is a free data retrieval call binding the contract method 0xd3e5792b.
+//
+// Solidity: function MIN_WITHDRAWAL_AMOUNT() view returns(uint256)
+func (_FeeVault *FeeVaultSession) MINWITHDRAWALAMOUNT() (*big.Int, error) {
+	return _FeeVault.Contract.MINWITHDRAWALAMOUNT(&_FeeVault.CallOpts)
+}
+
+// MINWITHDRAWALAMOUNT is a free data retrieval call binding the contract method 0xd3e5792b.
+//
+// Solidity: function MIN_WITHDRAWAL_AMOUNT() view returns(uint256)
+func (_FeeVault *FeeVaultCallerSession) MINWITHDRAWALAMOUNT() (*big.Int, error) {
+	return _FeeVault.Contract.MINWITHDRAWALAMOUNT(&_FeeVault.CallOpts)
+}
+
+// RECIPIENT is a free data retrieval call binding the contract method 0x0d9019e1.
+//
+// Solidity: function RECIPIENT() view returns(address)
+func (_FeeVault *FeeVaultCaller) RECIPIENT(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _FeeVault.contract.Call(opts, &out, "RECIPIENT")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// RECIPIENT is a free data retrieval call binding the contract method 0x0d9019e1.
+//
+// Solidity: function RECIPIENT() view returns(address)
+func (_FeeVault *FeeVaultSession) RECIPIENT() (common.Address, error) {
+	return _FeeVault.Contract.RECIPIENT(&_FeeVault.CallOpts)
+}
+
+// RECIPIENT is a free data retrieval call binding the contract method 0x0d9019e1.
+//
+// Solidity: function RECIPIENT() view returns(address)
+func (_FeeVault *FeeVaultCallerSession) RECIPIENT() (common.Address, error) {
+	return _FeeVault.Contract.RECIPIENT(&_FeeVault.CallOpts)
+}
+
+// WITHDRAWALNETWORK is a free data retrieval call binding the contract method 0xd0e12f90.
+//
+// Solidity: function WITHDRAWAL_NETWORK() view returns(uint8)
+func (_FeeVault *FeeVaultCaller) WITHDRAWALNETWORK(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _FeeVault.contract.Call(opts, &out, "WITHDRAWAL_NETWORK")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// WITHDRAWALNETWORK is a free data retrieval call binding the contract method 0xd0e12f90.
+//
+// Solidity: function WITHDRAWAL_NETWORK() view returns(uint8)
+func (_FeeVault *FeeVaultSession) WITHDRAWALNETWORK() (uint8, error) {
+	return _FeeVault.Contract.WITHDRAWALNETWORK(&_FeeVault.CallOpts)
+}
+
+// WITHDRAWALNETWORK is a free data retrieval call binding the contract method 0xd0e12f90.
+//
+// Solidity: function WITHDRAWAL_NETWORK() view returns(uint8)
+func (_FeeVault *FeeVaultCallerSession) WITHDRAWALNETWORK() (uint8, error) {
+	return _FeeVault.Contract.WITHDRAWALNETWORK(&_FeeVault.CallOpts)
+}
+
+// TotalProcessed is a free data retrieval call binding the contract method 0x84411d65.
+//
+// Solidity: function totalProcessed() view returns(uint256)
+func (_FeeVault *FeeVaultCaller) TotalProcessed(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FeeVault.contract.Call(opts, &out, "totalProcessed")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalProcessed is a free data retrieval call binding the contract method 0x84411d65.
+//
+// Solidity: function totalProcessed() view returns(uint256)
+func (_FeeVault *FeeVaultSession) TotalProcessed() (*big.Int, error) {
+	return _FeeVault.Contract.TotalProcessed(&_FeeVault.CallOpts)
+}
+
+// TotalProcessed is a free data retrieval call binding the contract method 0x84411d65.
+//
+// Solidity: function totalProcessed() view returns(uint256)
+func (_FeeVault *FeeVaultCallerSession) TotalProcessed() (*big.Int, error) {
+	return _FeeVault.Contract.TotalProcessed(&_FeeVault.CallOpts)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns()
+func (_FeeVault *FeeVaultTransactor) Withdraw(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FeeVault.contract.Transact(opts, "withdraw")
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns()
+func (_FeeVault *FeeVaultSession) Withdraw() (*types.Transaction, error) {
+	return _FeeVault.Contract.Withdraw(&_FeeVault.TransactOpts)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns()
+func (_FeeVault *FeeVaultTransactorSession) Withdraw() (*types.Transaction, error) {
+	return _FeeVault.Contract.Withdraw(&_FeeVault.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_FeeVault *FeeVaultTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FeeVault.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_FeeVault *FeeVaultSession) Receive() (*types.Transaction, error) {
+	return _FeeVault.Contract.Receive(&_FeeVault.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_FeeVault *FeeVaultTransactorSession) Receive() (*types.Transaction, error) {
+	return _FeeVault.Contract.Receive(&_FeeVault.TransactOpts)
+}
+
+// FeeVaultWithdrawalIterator is returned from FilterWithdrawal and is used to iterate over the raw logs and unpacked data for Withdrawal events raised by the FeeVault contract.
+type FeeVaultWithdrawalIterator struct {
+	Event *FeeVaultWithdrawal // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FeeVaultWithdrawalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FeeVaultWithdrawal)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FeeVaultWithdrawal)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FeeVaultWithdrawalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FeeVaultWithdrawalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FeeVaultWithdrawal represents a Withdrawal event raised by the FeeVault contract.
+type FeeVaultWithdrawal struct {
+	Value *big.Int
+	To    common.Address
+	From  common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawal is a free log retrieval operation binding the contract event 0xc8a211cc64b6ed1b50595a9fcb1932b6d1e5a6e8ef15b60e5b1f988ea9086bba.
+//
+// Solidity: event Withdrawal(uint256 value, address to, address from)
+func (_FeeVault *FeeVaultFilterer) FilterWithdrawal(opts *bind.FilterOpts) (*FeeVaultWithdrawalIterator, error) {
+
+	logs, sub, err := _FeeVault.contract.FilterLogs(opts, "Withdrawal")
+	if err != nil {
+		return nil, err
+	}
+	return &FeeVaultWithdrawalIterator{contract: _FeeVault.contract, event: "Withdrawal", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawal is a free log subscription operation binding the contract event 0xc8a211cc64b6ed1b50595a9fcb1932b6d1e5a6e8ef15b60e5b1f988ea9086bba.
+//
+// Solidity: event Withdrawal(uint256 value, address to, address from)
+func (_FeeVault *FeeVaultFilterer) WatchWithdrawal(opts *bind.WatchOpts, sink chan<- *FeeVaultWithdrawal) (event.Subscription, error) {
+
+	logs, sub, err := _FeeVault.contract.WatchLogs(opts, "Withdrawal")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FeeVaultWithdrawal)
+				if err := _FeeVault.contract.UnpackLog(event, "Withdrawal", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawal is a log parse operation binding the contract event 0xc8a211cc64b6ed1b50595a9fcb1932b6d1e5a6e8ef15b60e5b1f988ea9086bba.
+//
+// Solidity: event Withdrawal(uint256 value, address to, address from)
+func (_FeeVault *FeeVaultFilterer) ParseWithdrawal(log types.Log) (*FeeVaultWithdrawal, error) {
+	event := new(FeeVaultWithdrawal)
+	if err := _FeeVault.contract.UnpackLog(event, "Withdrawal", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// FeeVaultWithdrawal0Iterator is returned from FilterWithdrawal0 and is used to iterate over the raw logs and unpacked data for Withdrawal0 events raised by the FeeVault contract.
+type FeeVaultWithdrawal0Iterator struct {
+	Event *FeeVaultWithdrawal0 // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *FeeVaultWithdrawal0Iterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(FeeVaultWithdrawal0)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(FeeVaultWithdrawal0)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *FeeVaultWithdrawal0Iterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *FeeVaultWithdrawal0Iterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// FeeVaultWithdrawal0 represents a Withdrawal0 event raised by the FeeVault contract.
+type FeeVaultWithdrawal0 struct {
+	Value             *big.Int
+	To                common.Address
+	From              common.Address
+	WithdrawalNetwork uint8
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawal0 is a free log retrieval operation binding the contract event 0x38e04cbeb8c10f8f568618aa75be0f10b6729b8b4237743b4de20cbcde2839ee.
+//
+// Solidity: event Withdrawal(uint256 value, address to, address from, uint8 withdrawalNetwork)
+func (_FeeVault *FeeVaultFilterer) FilterWithdrawal0(opts *bind.FilterOpts) (*FeeVaultWithdrawal0Iterator, error) {
+
+	logs, sub, err := _FeeVault.contract.FilterLogs(opts, "Withdrawal0")
+	if err != nil {
+		return nil, err
+	}
+	return &FeeVaultWithdrawal0Iterator{contract: _FeeVault.contract, event: "Withdrawal0", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawal0 is a free log subscription operation binding the contract event 0x38e04cbeb8c10f8f568618aa75be0f10b6729b8b4237743b4de20cbcde2839ee.
+//
+// Solidity: event Withdrawal(uint256 value, address to, address from, uint8 withdrawalNetwork)
+func (_FeeVault *FeeVaultFilterer) WatchWithdrawal0(opts *bind.WatchOpts, sink chan<- *FeeVaultWithdrawal0) (event.Subscription, error) {
+
+	logs, sub, err := _FeeVault.contract.WatchLogs(opts, "Withdrawal0")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(FeeVaultWithdrawal0)
+				if err := _FeeVault.contract.UnpackLog(event, "Withdrawal0", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawal0 is a log parse operation binding the contract event 0x38e04cbeb8c10f8f568618aa75be0f10b6729b8b4237743b4de20cbcde2839ee.
+//
+// Solidity: event Withdrawal(uint256 value, address to, address from, uint8 withdrawalNetwork)
+func (_FeeVault *FeeVaultFilterer) ParseWithdrawal0(log types.Log) (*FeeVaultWithdrawal0, error) {
+	event := new(FeeVaultWithdrawal0)
+	if err := _FeeVault.contract.UnpackLog(event, "Withdrawal0", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,3088 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IGnosisSafeMetaData contains all meta data concerning the IGnosisSafe contract.
+var IGnosisSafeMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"AddedOwner\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"approvedHash\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"ApproveHash\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"handler\",\"type\":\"address\"}],\"name\":\"ChangedFallbackHandler\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"guard\",\"type\":\"address\"}],\"name\":\"ChangedGuard\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"}],\"name\":\"ChangedThreshold\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"module\",\"type\":\"address\"}],\"name\":\"DisabledModule\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"module\",\"type\":\"address\"}],\"name\":\"EnabledModule\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"txHash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"payment\",\"type\":\"uint256\"}],\"name\":\"ExecutionFailure\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"module\",\"type\":\"address\"}],\"name\":\"ExecutionFromModuleFailure\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"module\",\"type\":\"address\"}],\"name\":\"ExecutionFromModuleSuccess\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"txHash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"payment\",\"type\":\"uint256\"}],\"name\":\"ExecutionSuccess\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"RemovedOwner\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"SafeReceived\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"initiator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"owners\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"initializer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"fallbackHandler\",\"type\":\"address\"}],\"name\":\"SafeSetup\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"msgHash\",\"type\":\"bytes32\"}],\"name\":\"SignMsg\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"VERSION\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"}],\"name\":\"addOwnerWithThreshold\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"hashToApprove\",\"type\":\"bytes32\"}],\"name\":\"approveHash\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"approvedHashes\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"}],\"name\":\"changeThreshold\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"dataHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"signatures\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredSignatures\",\"type\":\"uint256\"}],\"name\":\"checkNSignatures\",\"outputs\":[],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"dataHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"signatures\",\"type\":\"bytes\"}],\"name\":\"checkSignatures\",\"outputs\":[],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"prevModule\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"module\",\"type\":\"address\"}],\"name\":\"disableModule\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"domainSeparator\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"module\",\"type\":\"address\"}],\"name\":\"enableModule\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"enumEnum.Operation\",\"name\":\"operation\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"safeTxGas\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"baseGas\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"gasToken\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"refundReceiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_nonce\",\"type\":\"uint256\"}],\"name\":\"encodeTransactionData\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"enumEnum.Operation\",\"name\":\"operation\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"safeTxGas\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"baseGas\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"gasToken\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"refundReceiver\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"signatures\",\"type\":\"bytes\"}],\"name\":\"execTransaction\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"enumEnum.Operation\",\"name\":\"operation\",\"type\":\"uint8\"}],\"name\":\"execTransactionFromModule\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"enumEnum.Operation\",\"name\":\"operation\",\"type\":\"uint8\"}],\"name\":\"execTransactionFromModuleReturnData\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"},{\"internalType\":\"bytes\",\"name\":\"returnData\",\"type\":\"bytes\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getChainId\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"start\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"pageSize\",\"type\":\"uint256\"}],\"name\":\"getModulesPaginated\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"array\",\"type\":\"address[]\"},{\"internalType\":\"address\",\"name\":\"next\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOwners\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"offset\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"getStorageAt\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"enumEnum.Operation\",\"name\":\"operation\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"safeTxGas\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"baseGas\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"gasToken\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"refundReceiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_nonce\",\"type\":\"uint256\"}],\"name\":\"getTransactionHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"module\",\"type\":\"address\"}],\"name\":\"isModuleEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"isOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"nonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"prevOwner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"}],\"name\":\"removeOwner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"enumEnum.Operation\",\"name\":\"operation\",\"type\":\"uint8\"}],\"name\":\"requiredTxGas\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"handler\",\"type\":\"address\"}],\"name\":\"setFallbackHandler\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"guard\",\"type\":\"address\"}],\"name\":\"setGuard\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_owners\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"},{\"internalType\":\"address\",\"name\":\"fallbackHandler\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"paymentToken\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"payment\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"paymentReceiver\",\"type\":\"address\"}],\"name\":\"setup\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"signedMessages\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"targetContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"calldataPayload\",\"type\":\"bytes\"}],\"name\":\"simulateAndRevert\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"prevOwner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oldOwner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"swapOwner\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// IGnosisSafeABI is the input ABI used to generate the binding from.
+// Deprecated: Use IGnosisSafeMetaData.ABI instead.
+var IGnosisSafeABI = IGnosisSafeMetaData.ABI
+
+// IGnosisSafe is an auto generated Go binding around an Ethereum contract.
+type IGnosisSafe struct {
+	IGnosisSafeCaller     // Read-only binding to the contract
+	IGnosisSafeTransactor // Write-only binding to the contract
+	IGnosisSafeFilterer   // Log filterer for contract events
+}
+
+// IGnosisSafeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IGnosisSafeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IGnosisSafeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IGnosisSafeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IGnosisSafeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IGnosisSafeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IGnosisSafeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IGnosisSafeSession struct {
+	Contract     *IGnosisSafe      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IGnosisSafeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IGnosisSafeCallerSession struct {
+	Contract *IGnosisSafeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// IGnosisSafeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IGnosisSafeTransactorSession struct {
+	Contract     *IGnosisSafeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// IGnosisSafeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IGnosisSafeRaw struct {
+	Contract *IGnosisSafe // Generic contract binding to access the raw methods on
+}
+
+// IGnosisSafeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IGnosisSafeCallerRaw struct {
+	Contract *IGnosisSafeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IGnosisSafeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IGnosisSafeTransactorRaw struct {
+	Contract *IGnosisSafeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIGnosisSafe creates a new instance of IGnosisSafe, bound to a specific deployed contract.
+func NewIGnosisSafe(address common.Address, backend bind.ContractBackend) (*IGnosisSafe, error) {
+	contract, err := bindIGnosisSafe(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafe{IGnosisSafeCaller: IGnosisSafeCaller{contract: contract}, IGnosisSafeTransactor: IGnosisSafeTransactor{contract: contract}, IGnosisSafeFilterer: IGnosisSafeFilterer{contract: contract}}, nil
+}
+
+// NewIGnosisSafeCaller creates a new read-only instance of IGnosisSafe, bound to a specific deployed contract.
+func NewIGnosisSafeCaller(address common.Address, caller bind.ContractCaller) (*IGnosisSafeCaller, error) {
+	contract, err := bindIGnosisSafe(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeCaller{contract: contract}, nil
+}
+
+// NewIGnosisSafeTransactor creates a new write-only instance of IGnosisSafe, bound to a specific deployed contract.
+func NewIGnosisSafeTransactor(address common.Address, transactor bind.ContractTransactor) (*IGnosisSafeTransactor, error) {
+	contract, err := bindIGnosisSafe(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeTransactor{contract: contract}, nil
+}
+
+// NewIGnosisSafeFilterer creates a new log filterer instance of IGnosisSafe, bound to a specific deployed contract.
+func NewIGnosisSafeFilterer(address common.Address, filterer bind.ContractFilterer) (*IGnosisSafeFilterer, error) {
+	contract, err := bindIGnosisSafe(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeFilterer{contract: contract}, nil
+}
+
+// bindIGnosisSafe binds a generic wrapper to an already deployed contract.
+func bindIGnosisSafe(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IGnosisSafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IGnosisSafe *IGnosisSafeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IGnosisSafe.Contract.IGnosisSafeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IGnosisSafe *IGnosisSafeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.IGnosisSafeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IGnosisSafe *IGnosisSafeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.IGnosisSafeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IGnosisSafe *IGnosisSafeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IGnosisSafe.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IGnosisSafe *IGnosisSafeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IGnosisSafe *IGnosisSafeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.contract.Transact(opts, method, params...)
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(string)
+func (_IGnosisSafe *IGnosisSafeCaller) VERSION(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "VERSION")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(string)
+func (_IGnosisSafe *IGnosisSafeSession) VERSION() (string, error) {
+	return _IGnosisSafe.Contract.VERSION(&_IGnosisSafe.CallOpts)
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(string)
+func (_IGnosisSafe *IGnosisSafeCallerSession) VERSION() (string, error) {
+	return _IGnosisSafe.Contract.VERSION(&_IGnosisSafe.CallOpts)
+}
+
+// ApprovedHashes is a free data retrieval call binding the contract method 0x7d832974.
+//
+// Solidity: function approvedHashes(address , bytes32 ) view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeCaller) ApprovedHashes(opts *bind.CallOpts, arg0 common.Address, arg1 [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "approvedHashes", arg0, arg1)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ApprovedHashes is a free data retrieval call binding the contract method 0x7d832974.
+//
+// Solidity: function approvedHashes(address , bytes32 ) view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeSession) ApprovedHashes(arg0 common.Address, arg1 [32]byte) (*big.Int, error) {
+	return _IGnosisSafe.Contract.ApprovedHashes(&_IGnosisSafe.CallOpts, arg0, arg1)
+}
+
+// ApprovedHashes is a free data retrieval call binding the contract method 0x7d832974.
+//
+// Solidity: function approvedHashes(address , bytes32 ) view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeCallerSession) ApprovedHashes(arg0 common.Address, arg1 [32]byte) (*big.Int, error) {
+	return _IGnosisSafe.Contract.ApprovedHashes(&_IGnosisSafe.CallOpts, arg0, arg1)
+}
+
+// CheckNSignatures is a free data retrieval call binding the contract method 0x12fb68e0.
+//
+// Solidity: function checkNSignatures(bytes32 dataHash, bytes data, bytes signatures, uint256 requiredSignatures) view returns()
+func (_IGnosisSafe *IGnosisSafeCaller) CheckNSignatures(opts *bind.CallOpts, dataHash [32]byte, data []byte, signatures []byte, requiredSignatures *big.Int) error {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "checkNSignatures", dataHash, data, signatures, requiredSignatures)
+
+	if err != nil {
+		return err
+	}
+
+	return err
+
+}
+
+// CheckNSignatures is a free data retrieval call binding the contract method 0x12fb68e0.
+//
+// Solidity: function checkNSignatures(bytes32 dataHash, bytes data, bytes signatures, uint256 requiredSignatures) view returns()
+func (_IGnosisSafe *IGnosisSafeSession) CheckNSignatures(dataHash [32]byte, data []byte, signatures []byte, requiredSignatures *big.Int) error {
+	return _IGnosisSafe.Contract.CheckNSignatures(&_IGnosisSafe.CallOpts, dataHash, data, signatures, requiredSignatures)
+}
+
+// CheckNSignatures is a free data retrieval call binding the contract method 0x12fb68e0.
+//
+// Solidity: function checkNSignatures(bytes32 dataHash, bytes data, bytes signatures, uint256 requiredSignatures) view returns()
+func (_IGnosisSafe *IGnosisSafeCallerSession) CheckNSignatures(dataHash [32]byte, data []byte, signatures []byte, requiredSignatures *big.Int) error {
+	return _IGnosisSafe.Contract.CheckNSignatures(&_IGnosisSafe.CallOpts, dataHash, data, signatures, requiredSignatures)
+}
+
+// CheckSignatures is a free data retrieval call binding the contract method 0x934f3a11.
+//
+// Solidity: function checkSignatures(bytes32 dataHash, bytes data, bytes signatures) view returns()
+func (_IGnosisSafe *IGnosisSafeCaller) CheckSignatures(opts *bind.CallOpts, dataHash [32]byte, data []byte, signatures []byte) error {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "checkSignatures", dataHash, data, signatures)
+
+	if err != nil {
+		return err
+	}
+
+	return err
+
+}
+
+// CheckSignatures is a free data retrieval call binding the contract method 0x934f3a11.
+//
+// Solidity: function checkSignatures(bytes32 dataHash, bytes data, bytes signatures) view returns()
+func (_IGnosisSafe *IGnosisSafeSession) CheckSignatures(dataHash [32]byte, data []byte, signatures []byte) error {
+	return _IGnosisSafe.Contract.CheckSignatures(&_IGnosisSafe.CallOpts, dataHash, data, signatures)
+}
+
+// CheckSignatures is a free data retrieval call binding the contract method 0x934f3a11.
+//
+// Solidity: function checkSignatures(bytes32 dataHash, bytes data, bytes signatures) view returns()
+func (_IGnosisSafe *IGnosisSafeCallerSession) CheckSignatures(dataHash [32]byte, data []byte, signatures []byte) error {
+	return _IGnosisSafe.Contract.CheckSignatures(&_IGnosisSafe.CallOpts, dataHash, data, signatures)
+}
+
+// DomainSeparator is a free data retrieval call binding the contract method 0xf698da25.
+//
+// Solidity: function domainSeparator() view returns(bytes32)
+func (_IGnosisSafe *IGnosisSafeCaller) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "domainSeparator")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DomainSeparator is a free data retrieval call binding the contract method 0xf698da25.
+//
+// Solidity: function domainSeparator() view returns(bytes32)
+func (_IGnosisSafe *IGnosisSafeSession) DomainSeparator() ([32]byte, error) {
+	return _IGnosisSafe.Contract.DomainSeparator(&_IGnosisSafe.CallOpts)
+}
+
+// DomainSeparator is a free data retrieval call binding the contract method 0xf698da25.
+//
+// Solidity: function domainSeparator() view returns(bytes32)
+func (_IGnosisSafe *IGnosisSafeCallerSession) DomainSeparator() ([32]byte, error) {
+	return _IGnosisSafe.Contract.DomainSeparator(&_IGnosisSafe.CallOpts)
+}
+
+// EncodeTransactionData is a free data retrieval call binding the contract method 0xe86637db.
+//
+// Solidity: function encodeTransactionData(address to, uint256 value, bytes data, uint8 operation, uint256 safeTxGas, uint256 baseGas, uint256 gasPrice, address gasToken, address refundReceiver, uint256 _nonce) view returns(bytes)
+func (_IGnosisSafe *IGnosisSafeCaller) EncodeTransactionData(opts *bind.CallOpts, to common.Address, value *big.Int, data []byte, operation uint8, safeTxGas *big.Int, baseGas *big.Int, gasPrice *big.Int, gasToken common.Address, refundReceiver common.Address, _nonce *big.Int) ([]byte, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "encodeTransactionData", to, value, data, operation, safeTxGas, baseGas, gasPrice, gasToken, refundReceiver, _nonce)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// EncodeTransactionData is a free data retrieval call binding the contract method 0xe86637db.
+//
+// Solidity: function encodeTransactionData(address to, uint256 value, bytes data, uint8 operation, uint256 safeTxGas, uint256 baseGas, uint256 gasPrice, address gasToken, address refundReceiver, uint256 _nonce) view returns(bytes)
+func (_IGnosisSafe *IGnosisSafeSession) EncodeTransactionData(to common.Address, value *big.Int, data []byte, operation uint8, safeTxGas *big.Int, baseGas *big.Int, gasPrice *big.Int, gasToken common.Address, refundReceiver common.Address, _nonce *big.Int) ([]byte, error) {
+	return _IGnosisSafe.Contract.EncodeTransactionData(&_IGnosisSafe.CallOpts, to, value, data, operation, safeTxGas, baseGas, gasPrice, gasToken, refundReceiver, _nonce)
+}
+
+// EncodeTransactionData is a free data retrieval call binding the contract method 0xe86637db.
+//
+// Solidity: function encodeTransactionData(address to, uint256 value, bytes data, uint8 operation, uint256 safeTxGas, uint256 baseGas, uint256 gasPrice, address gasToken, address refundReceiver, uint256 _nonce) view returns(bytes)
+func (_IGnosisSafe *IGnosisSafeCallerSession) EncodeTransactionData(to common.Address, value *big.Int, data []byte, operation uint8, safeTxGas *big.Int, baseGas *big.Int, gasPrice *big.Int, gasToken common.Address, refundReceiver common.Address, _nonce *big.Int) ([]byte, error) {
+	return _IGnosisSafe.Contract.EncodeTransactionData(&_IGnosisSafe.CallOpts, to, value, data, operation, safeTxGas, baseGas, gasPrice, gasToken, refundReceiver, _nonce)
+}
+
+// GetChainId is a free data retrieval call binding the contract method 0x3408e470.
+//
+// Solidity: function getChainId() view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeCaller) GetChainId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "getChainId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetChainId is a free data retrieval call binding the contract method 0x3408e470.
+//
+// Solidity: function getChainId() view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeSession) GetChainId() (*big.Int, error) {
+	return _IGnosisSafe.Contract.GetChainId(&_IGnosisSafe.CallOpts)
+}
+
+// GetChainId is a free data retrieval call binding the contract method 0x3408e470.
+//
+// Solidity: function getChainId() view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeCallerSession) GetChainId() (*big.Int, error) {
+	return _IGnosisSafe.Contract.GetChainId(&_IGnosisSafe.CallOpts)
+}
+
+// GetModulesPaginated is a free data retrieval call binding the contract method 0xcc2f8452.
+//
+// Solidity: function getModulesPaginated(address start, uint256 pageSize) view returns(address[] array, address next)
+func (_IGnosisSafe *IGnosisSafeCaller) GetModulesPaginated(opts *bind.CallOpts, start common.Address, pageSize *big.Int) (struct {
+	Array []common.Address
+	Next  common.Address
+}, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "getModulesPaginated", start, pageSize)
+
+	outstruct := new(struct {
+		Array []common.Address
+		Next  common.Address
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Array = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Next = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+
+	return *outstruct, err
+
+}
+
+// GetModulesPaginated is a free data retrieval call binding the contract method 0xcc2f8452.
+//
+// Solidity: function getModulesPaginated(address start, uint256 pageSize) view returns(address[] array, address next)
+func (_IGnosisSafe *IGnosisSafeSession) GetModulesPaginated(start common.Address, pageSize *big.Int) (struct {
+	Array []common.Address
+	Next  common.Address
+}, error) {
+	return _IGnosisSafe.Contract.GetModulesPaginated(&_IGnosisSafe.CallOpts, start, pageSize)
+}
+
+// GetModulesPaginated is a free data retrieval call binding the contract method 0xcc2f8452.
+//
+// Solidity: function getModulesPaginated(address start, uint256 pageSize) view returns(address[] array, address next)
+func (_IGnosisSafe *IGnosisSafeCallerSession) GetModulesPaginated(start common.Address, pageSize *big.Int) (struct {
+	Array []common.Address
+	Next  common.Address
+}, error) {
+	return _IGnosisSafe.Contract.GetModulesPaginated(&_IGnosisSafe.CallOpts, start, pageSize)
+}
+
+// GetOwners is a free data retrieval call binding the contract method 0xa0e67e2b.
+//
+// Solidity: function getOwners() view returns(address[])
+func (_IGnosisSafe *IGnosisSafeCaller) GetOwners(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "getOwners")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetOwners is a free data retrieval call binding the contract method 0xa0e67e2b.
+//
+// Solidity: function getOwners() view returns(address[])
+func (_IGnosisSafe *IGnosisSafeSession) GetOwners() ([]common.Address, error) {
+	return _IGnosisSafe.Contract.GetOwners(&_IGnosisSafe.CallOpts)
+}
+
+// GetOwners is a free data retrieval call binding the contract method 0xa0e67e2b.
+//
+// Solidity: function getOwners() view returns(address[])
+func (_IGnosisSafe *IGnosisSafeCallerSession) GetOwners() ([]common.Address, error) {
+	return _IGnosisSafe.Contract.GetOwners(&_IGnosisSafe.CallOpts)
+}
+
+// GetStorageAt is a free data retrieval call binding the contract method 0x5624b25b.
+//
+// Solidity: function getStorageAt(uint256 offset, uint256 length) view returns(bytes)
+func (_IGnosisSafe *IGnosisSafeCaller) GetStorageAt(opts *bind.CallOpts, offset *big.Int, length *big.Int) ([]byte, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "getStorageAt", offset, length)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetStorageAt is a free data retrieval call binding the contract method 0x5624b25b.
+//
+// Solidity: function getStorageAt(uint256 offset, uint256 length) view returns(bytes)
+func (_IGnosisSafe *IGnosisSafeSession) GetStorageAt(offset *big.Int, length *big.Int) ([]byte, error) {
+	return _IGnosisSafe.Contract.GetStorageAt(&_IGnosisSafe.CallOpts, offset, length)
+}
+
+// GetStorageAt is a free data retrieval call binding the contract method 0x5624b25b.
+//
+// Solidity: function getStorageAt(uint256 offset, uint256 length) view returns(bytes)
+func (_IGnosisSafe *IGnosisSafeCallerSession) GetStorageAt(offset *big.Int, length *big.Int) ([]byte, error) {
+	return _IGnosisSafe.Contract.GetStorageAt(&_IGnosisSafe.CallOpts, offset, length)
+}
+
+// GetThreshold is a free data retrieval call binding the contract method 0xe75235b8.
+//
+// Solidity: function getThreshold() view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeCaller) GetThreshold(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "getThreshold")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetThreshold is a free data retrieval call binding the contract method 0xe75235b8.
+//
+// Solidity: function getThreshold() view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeSession) GetThreshold() (*big.Int, error) {
+	return _IGnosisSafe.Contract.GetThreshold(&_IGnosisSafe.CallOpts)
+}
+
+// GetThreshold is a free data retrieval call binding the contract method 0xe75235b8.
+//
+// Solidity: function getThreshold() view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeCallerSession) GetThreshold() (*big.Int, error) {
+	return _IGnosisSafe.Contract.GetThreshold(&_IGnosisSafe.CallOpts)
+}
+
+// GetTransactionHash is a free data retrieval call binding the contract method 0xd8d11f78.
+//
+// Solidity: function getTransactionHash(address to, uint256 value, bytes data, uint8 operation, uint256 safeTxGas, uint256 baseGas, uint256 gasPrice, address gasToken, address refundReceiver, uint256 _nonce) view returns(bytes32)
+func (_IGnosisSafe *IGnosisSafeCaller) GetTransactionHash(opts *bind.CallOpts, to common.Address, value *big.Int, data []byte, operation uint8, safeTxGas *big.Int, baseGas *big.Int, gasPrice *big.Int, gasToken common.Address, refundReceiver common.Address, _nonce *big.Int) ([32]byte, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "getTransactionHash", to, value, data, operation, safeTxGas, baseGas, gasPrice, gasToken, refundReceiver, _nonce)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetTransactionHash is a free data retrieval call binding the contract method 0xd8d11f78.
+//
+// Solidity: function getTransactionHash(address to, uint256 value, bytes data, uint8 operation, uint256 safeTxGas, uint256 baseGas, uint256 gasPrice, address gasToken, address refundReceiver, uint256 _nonce) view returns(bytes32)
+func (_IGnosisSafe *IGnosisSafeSession) GetTransactionHash(to common.Address, value *big.Int, data []byte, operation uint8, safeTxGas *big.Int, baseGas *big.Int, gasPrice *big.Int, gasToken common.Address, refundReceiver common.Address, _nonce *big.Int) ([32]byte, error) {
+	return _IGnosisSafe.Contract.GetTransactionHash(&_IGnosisSafe.CallOpts, to, value, data, operation, safeTxGas, baseGas, gasPrice, gasToken, refundReceiver, _nonce)
+}
+
+// GetTransactionHash is a free data retrieval call binding the contract method 0xd8d11f78.
+//
+// Solidity: function getTransactionHash(address to, uint256 value, bytes data, uint8 operation, uint256 safeTxGas, uint256 baseGas, uint256 gasPrice, address gasToken, address refundReceiver, uint256 _nonce) view returns(bytes32)
+func (_IGnosisSafe *IGnosisSafeCallerSession) GetTransactionHash(to common.Address, value *big.Int, data []byte, operation uint8, safeTxGas *big.Int, baseGas *big.Int, gasPrice *big.Int, gasToken common.Address, refundReceiver common.Address, _nonce *big.Int) ([32]byte, error) {
+	return _IGnosisSafe.Contract.GetTransactionHash(&_IGnosisSafe.CallOpts, to, value, data, operation, safeTxGas, baseGas, gasPrice, gasToken, refundReceiver, _nonce)
+}
+
+// IsModuleEnabled is a free data retrieval call binding the contract method 0x2d9ad53d.
+//
+// Solidity: function isModuleEnabled(address module) view returns(bool)
+func (_IGnosisSafe *IGnosisSafeCaller) IsModuleEnabled(opts *bind.CallOpts, module common.Address) (bool, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "isModuleEnabled", module)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsModuleEnabled is a free data retrieval call binding the contract method 0x2d9ad53d.
+//
+// Solidity: function isModuleEnabled(address module) view returns(bool)
+func (_IGnosisSafe *IGnosisSafeSession) IsModuleEnabled(module common.Address) (bool, error) {
+	return _IGnosisSafe.Contract.IsModuleEnabled(&_IGnosisSafe.CallOpts, module)
+}
+
+// IsModuleEnabled is a free data retrieval call binding the contract method 0x2d9ad53d.
+//
+// Solidity: function isModuleEnabled(address module) view returns(bool)
+func (_IGnosisSafe *IGnosisSafeCallerSession) IsModuleEnabled(module common.Address) (bool, error) {
+	return _IGnosisSafe.Contract.IsModuleEnabled(&_IGnosisSafe.CallOpts, module)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x2f54bf6e.
+//
+// Solidity: function isOwner(address owner) view returns(bool)
+func (_IGnosisSafe *IGnosisSafeCaller) IsOwner(opts *bind.CallOpts, owner common.Address) (bool, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "isOwner", owner)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x2f54bf6e.
+//
+// Solidity: function isOwner(address owner) view returns(bool)
+func (_IGnosisSafe *IGnosisSafeSession) IsOwner(owner common.Address) (bool, error) {
+	return _IGnosisSafe.Contract.IsOwner(&_IGnosisSafe.CallOpts, owner)
+}
+
+// IsOwner is a free data retrieval call binding the contract method 0x2f54bf6e.
+//
+// Solidity: function isOwner(address owner) view returns(bool)
+func (_IGnosisSafe *IGnosisSafeCallerSession) IsOwner(owner common.Address) (bool, error) {
+	return _IGnosisSafe.Contract.IsOwner(&_IGnosisSafe.CallOpts, owner)
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeCaller) Nonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "nonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeSession) Nonce() (*big.Int, error) {
+	return _IGnosisSafe.Contract.Nonce(&_IGnosisSafe.CallOpts)
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeCallerSession) Nonce() (*big.Int, error) {
+	return _IGnosisSafe.Contract.Nonce(&_IGnosisSafe.CallOpts)
+}
+
+// SignedMessages is a free data retrieval call binding the contract method 0x5ae6bd37.
+//
+// Solidity: function signedMessages(bytes32 ) view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeCaller) SignedMessages(opts *bind.CallOpts, arg0 [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _IGnosisSafe.contract.Call(opts, &out, "signedMessages", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SignedMessages is a free data retrieval call binding the contract method 0x5ae6bd37.
+//
+// Solidity: function signedMessages(bytes32 ) view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeSession) SignedMessages(arg0 [32]byte) (*big.Int, error) {
+	return _IGnosisSafe.Contract.SignedMessages(&_IGnosisSafe.CallOpts, arg0)
+}
+
+// SignedMessages is a free data retrieval call binding the contract method 0x5ae6bd37.
+//
+// Solidity: function signedMessages(bytes32 ) view returns(uint256)
+func (_IGnosisSafe *IGnosisSafeCallerSession) SignedMessages(arg0 [32]byte) (*big.Int, error) {
+	return _IGnosisSafe.Contract.SignedMessages(&_IGnosisSafe.CallOpts, arg0)
+}
+
+// AddOwnerWithThreshold is a paid mutator transaction binding the contract method 0x0d582f13.
+//
+// Solidity: function addOwnerWithThreshold(address owner, uint256 _threshold) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) AddOwnerWithThreshold(opts *bind.TransactOpts, owner common.Address, _threshold *big.Int) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "addOwnerWithThreshold", owner, _threshold)
+}
+
+// AddOwnerWithThreshold is a paid mutator transaction binding the contract method 0x0d582f13.
+//
+// Solidity: function addOwnerWithThreshold(address owner, uint256 _threshold) returns()
+func (_IGnosisSafe *IGnosisSafeSession) AddOwnerWithThreshold(owner common.Address, _threshold *big.Int) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.AddOwnerWithThreshold(&_IGnosisSafe.TransactOpts, owner, _threshold)
+}
+
+// AddOwnerWithThreshold is a paid mutator transaction binding the contract method 0x0d582f13.
+//
+// Solidity: function addOwnerWithThreshold(address owner, uint256 _threshold) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) AddOwnerWithThreshold(owner common.Address, _threshold *big.Int) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.AddOwnerWithThreshold(&_IGnosisSafe.TransactOpts, owner, _threshold)
+}
+
+// ApproveHash is a paid mutator transaction binding the contract method 0xd4d9bdcd.
+//
+// Solidity: function approveHash(bytes32 hashToApprove) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) ApproveHash(opts *bind.TransactOpts, hashToApprove [32]byte) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "approveHash", hashToApprove)
+}
+
+// ApproveHash is a paid mutator transaction binding the contract method 0xd4d9bdcd.
+//
+// Solidity: function approveHash(bytes32 hashToApprove) returns()
+func (_IGnosisSafe *IGnosisSafeSession) ApproveHash(hashToApprove [32]byte) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.ApproveHash(&_IGnosisSafe.TransactOpts, hashToApprove)
+}
+
+// ApproveHash is a paid mutator transaction binding the contract method 0xd4d9bdcd.
+//
+// Solidity: function approveHash(bytes32 hashToApprove) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) ApproveHash(hashToApprove [32]byte) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.ApproveHash(&_IGnosisSafe.TransactOpts, hashToApprove)
+}
+
+// ChangeThreshold is a paid mutator transaction binding the contract method 0x694e80c3.
+//
+// Solidity: function changeThreshold(uint256 _threshold) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) ChangeThreshold(opts *bind.TransactOpts, _threshold *big.Int) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "changeThreshold", _threshold)
+}
+
+// ChangeThreshold is a paid mutator transaction binding the contract method 0x694e80c3.
+//
+// Solidity: function changeThreshold(uint256 _threshold) returns()
+func (_IGnosisSafe *IGnosisSafeSession) ChangeThreshold(_threshold *big.Int) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.ChangeThreshold(&_IGnosisSafe.TransactOpts, _threshold)
+}
+
+// ChangeThreshold is a paid mutator transaction binding the contract method 0x694e80c3.
+//
+// Solidity: function changeThreshold(uint256 _threshold) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) ChangeThreshold(_threshold *big.Int) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.ChangeThreshold(&_IGnosisSafe.TransactOpts, _threshold)
+}
+
+// DisableModule is a paid mutator transaction binding the contract method 0xe009cfde.
+//
+// Solidity: function disableModule(address prevModule, address module) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) DisableModule(opts *bind.TransactOpts, prevModule common.Address, module common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "disableModule", prevModule, module)
+}
+
+// DisableModule is a paid mutator transaction binding the contract method 0xe009cfde.
+//
+// Solidity: function disableModule(address prevModule, address module) returns()
+func (_IGnosisSafe *IGnosisSafeSession) DisableModule(prevModule common.Address, module common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.DisableModule(&_IGnosisSafe.TransactOpts, prevModule, module)
+}
+
+// DisableModule is a paid mutator transaction binding the contract method 0xe009cfde.
+//
+// Solidity: function disableModule(address prevModule, address module) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) DisableModule(prevModule common.Address, module common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.DisableModule(&_IGnosisSafe.TransactOpts, prevModule, module)
+}
+
+// EnableModule is a paid mutator transaction binding the contract method 0x610b5925.
+//
+// Solidity: function enableModule(address module) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) EnableModule(opts *bind.TransactOpts, module common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "enableModule", module)
+}
+
+// EnableModule is a paid mutator transaction binding the contract method 0x610b5925.
+//
+// Solidity: function enableModule(address module) returns()
+func (_IGnosisSafe *IGnosisSafeSession) EnableModule(module common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.EnableModule(&_IGnosisSafe.TransactOpts, module)
+}
+
+// EnableModule is a paid mutator transaction binding the contract method 0x610b5925.
+//
+// Solidity: function enableModule(address module) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) EnableModule(module common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.EnableModule(&_IGnosisSafe.TransactOpts, module)
+}
+
+// ExecTransaction is a paid mutator transaction binding the contract method 0x6a761202.
+//
+// Solidity: function execTransaction(address to, uint256 value, bytes data, uint8 operation, uint256 safeTxGas, uint256 baseGas, uint256 gasPrice, address gasToken, address refundReceiver, bytes signatures) payable returns(bool success)
+func (_IGnosisSafe *IGnosisSafeTransactor) ExecTransaction(opts *bind.TransactOpts, to common.Address, value *big.Int, data []byte, operation uint8, safeTxGas *big.Int, baseGas *big.Int, gasPrice *big.Int, gasToken common.Address, refundReceiver common.Address, signatures []byte) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "execTransaction", to, value, data, operation, safeTxGas, baseGas, gasPrice, gasToken, refundReceiver, signatures)
+}
+
+// ExecTransaction is a paid mutator transaction binding the contract method 0x6a761202.
+//
+// Solidity: function execTransaction(address to, uint256 value, bytes data, uint8 operation, uint256 safeTxGas, uint256 baseGas, uint256 gasPrice, address gasToken, address refundReceiver, bytes signatures) payable returns(bool success)
+func (_IGnosisSafe *IGnosisSafeSession) ExecTransaction(to common.Address, value *big.Int, data []byte, operation uint8, safeTxGas *big.Int, baseGas *big.Int, gasPrice *big.Int, gasToken common.Address, refundReceiver common.Address, signatures []byte) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.ExecTransaction(&_IGnosisSafe.TransactOpts, to, value, data, operation, safeTxGas, baseGas, gasPrice, gasToken, refundReceiver, signatures)
+}
+
+// ExecTransaction is a paid mutator transaction binding the contract method 0x6a761202.
+//
+// Solidity: function execTransaction(address to, uint256 value, bytes data, uint8 operation, uint256 safeTxGas, uint256 baseGas, uint256 gasPrice, address gasToken, address refundReceiver, bytes signatures) payable returns(bool success)
+func (_IGnosisSafe *IGnosisSafeTransactorSession) ExecTransaction(to common.Address, value *big.Int, data []byte, operation uint8, safeTxGas *big.Int, baseGas *big.Int, gasPrice *big.Int, gasToken common.Address, refundReceiver common.Address, signatures []byte) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.ExecTransaction(&_IGnosisSafe.TransactOpts, to, value, data, operation, safeTxGas, baseGas, gasPrice, gasToken, refundReceiver, signatures)
+}
+
+// ExecTransactionFromModule is a paid mutator transaction binding the contract method 0x468721a7.
+//
+// Solidity: function execTransactionFromModule(address to, uint256 value, bytes data, uint8 operation) returns(bool success)
+func (_IGnosisSafe *IGnosisSafeTransactor) ExecTransactionFromModule(opts *bind.TransactOpts, to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "execTransactionFromModule", to, value, data, operation)
+}
+
+// ExecTransactionFromModule is a paid mutator transaction binding the contract method 0x468721a7.
+//
+// Solidity: function execTransactionFromModule(address to, uint256 value, bytes data, uint8 operation) returns(bool success)
+func (_IGnosisSafe *IGnosisSafeSession) ExecTransactionFromModule(to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.ExecTransactionFromModule(&_IGnosisSafe.TransactOpts, to, value, data, operation)
+}
+
+// ExecTransactionFromModule is a paid mutator transaction binding the contract method 0x468721a7.
+//
+// Solidity: function execTransactionFromModule(address to, uint256 value, bytes data, uint8 operation) returns(bool success)
+func (_IGnosisSafe *IGnosisSafeTransactorSession) ExecTransactionFromModule(to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.ExecTransactionFromModule(&_IGnosisSafe.TransactOpts, to, value, data, operation)
+}
+
+// ExecTransactionFromModuleReturnData is a paid mutator transaction binding the contract method 0x5229073f.
+//
+// Solidity: function execTransactionFromModuleReturnData(address to, uint256 value, bytes data, uint8 operation) returns(bool success, bytes returnData)
+func (_IGnosisSafe *IGnosisSafeTransactor) ExecTransactionFromModuleReturnData(opts *bind.TransactOpts, to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "execTransactionFromModuleReturnData", to, value, data, operation)
+}
+
+// ExecTransactionFromModuleReturnData is a paid mutator transaction binding the contract method 0x5229073f.
+//
+// Solidity: function execTransactionFromModuleReturnData(address to, uint256 value, bytes data, uint8 operation) returns(bool success, bytes returnData)
+func (_IGnosisSafe *IGnosisSafeSession) ExecTransactionFromModuleReturnData(to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.ExecTransactionFromModuleReturnData(&_IGnosisSafe.TransactOpts, to, value, data, operation)
+}
+
+// ExecTransactionFromModuleReturnData is a paid mutator transaction binding the contract method 0x5229073f.
+//
+// Solidity: function execTransactionFromModuleReturnData(address to, uint256 value, bytes data, uint8 operation) returns(bool success, bytes returnData)
+func (_IGnosisSafe *IGnosisSafeTransactorSession) ExecTransactionFromModuleReturnData(to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.ExecTransactionFromModuleReturnData(&_IGnosisSafe.TransactOpts, to, value, data, operation)
+}
+
+// RemoveOwner is a paid mutator transaction binding the contract method 0xf8dc5dd9.
+//
+// Solidity: function removeOwner(address prevOwner, address owner, uint256 _threshold) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) RemoveOwner(opts *bind.TransactOpts, prevOwner common.Address, owner common.Address, _threshold *big.Int) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "removeOwner", prevOwner, owner, _threshold)
+}
+
+// RemoveOwner is a paid mutator transaction binding the contract method 0xf8dc5dd9.
+//
+// Solidity: function removeOwner(address prevOwner, address owner, uint256 _threshold) returns()
+func (_IGnosisSafe *IGnosisSafeSession) RemoveOwner(prevOwner common.Address, owner common.Address, _threshold *big.Int) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.RemoveOwner(&_IGnosisSafe.TransactOpts, prevOwner, owner, _threshold)
+}
+
+// RemoveOwner is a paid mutator transaction binding the contract method 0xf8dc5dd9.
+//
+// Solidity: function removeOwner(address prevOwner, address owner, uint256 _threshold) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) RemoveOwner(prevOwner common.Address, owner common.Address, _threshold *big.Int) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.RemoveOwner(&_IGnosisSafe.TransactOpts, prevOwner, owner, _threshold)
+}
+
+// RequiredTxGas is a paid mutator transaction binding the contract method 0xc4ca3a9c.
+//
+// Solidity: function requiredTxGas(address to, uint256 value, bytes data, uint8 operation) returns(uint256)
+func (_IGnosisSafe *IGnosisSafeTransactor) RequiredTxGas(opts *bind.TransactOpts, to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "requiredTxGas", to, value, data, operation)
+}
+
+// RequiredTxGas is a paid mutator transaction binding the contract method 0xc4ca3a9c.
+//
+// Solidity: function requiredTxGas(address to, uint256 value, bytes data, uint8 operation) returns(uint256)
+func (_IGnosisSafe *IGnosisSafeSession) RequiredTxGas(to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.RequiredTxGas(&_IGnosisSafe.TransactOpts, to, value, data, operation)
+}
+
+// RequiredTxGas is a paid mutator transaction binding the contract method 0xc4ca3a9c.
+//
+// Solidity: function requiredTxGas(address to, uint256 value, bytes data, uint8 operation) returns(uint256)
+func (_IGnosisSafe *IGnosisSafeTransactorSession) RequiredTxGas(to common.Address, value *big.Int, data []byte, operation uint8) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.RequiredTxGas(&_IGnosisSafe.TransactOpts, to, value, data, operation)
+}
+
+// SetFallbackHandler is a paid mutator transaction binding the contract method 0xf08a0323.
+//
+// Solidity: function setFallbackHandler(address handler) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) SetFallbackHandler(opts *bind.TransactOpts, handler common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "setFallbackHandler", handler)
+}
+
+// SetFallbackHandler is a paid mutator transaction binding the contract method 0xf08a0323.
+//
+// Solidity: function setFallbackHandler(address handler) returns()
+func (_IGnosisSafe *IGnosisSafeSession) SetFallbackHandler(handler common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.SetFallbackHandler(&_IGnosisSafe.TransactOpts, handler)
+}
+
+// SetFallbackHandler is a paid mutator transaction binding the contract method 0xf08a0323.
+//
+// Solidity: function setFallbackHandler(address handler) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) SetFallbackHandler(handler common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.SetFallbackHandler(&_IGnosisSafe.TransactOpts, handler)
+}
+
+// SetGuard is a paid mutator transaction binding the contract method 0xe19a9dd9.
+//
+// Solidity: function setGuard(address guard) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) SetGuard(opts *bind.TransactOpts, guard common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "setGuard", guard)
+}
+
+// SetGuard is a paid mutator transaction binding the contract method 0xe19a9dd9.
+//
+// Solidity: function setGuard(address guard) returns()
+func (_IGnosisSafe *IGnosisSafeSession) SetGuard(guard common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.SetGuard(&_IGnosisSafe.TransactOpts, guard)
+}
+
+// SetGuard is a paid mutator transaction binding the contract method 0xe19a9dd9.
+//
+// Solidity: function setGuard(address guard) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) SetGuard(guard common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.SetGuard(&_IGnosisSafe.TransactOpts, guard)
+}
+
+// Setup is a paid mutator transaction binding the contract method 0xb63e800d.
+//
+// Solidity: function setup(address[] _owners, uint256 _threshold, address to, bytes data, address fallbackHandler, address paymentToken, uint256 payment, address paymentReceiver) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) Setup(opts *bind.TransactOpts, _owners []common.Address, _threshold *big.Int, to common.Address, data []byte, fallbackHandler common.Address, paymentToken common.Address, payment *big.Int, paymentReceiver common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "setup", _owners, _threshold, to, data, fallbackHandler, paymentToken, payment, paymentReceiver)
+}
+
+// Setup is a paid mutator transaction binding the contract method 0xb63e800d.
+//
+// Solidity: function setup(address[] _owners, uint256 _threshold, address to, bytes data, address fallbackHandler, address paymentToken, uint256 payment, address paymentReceiver) returns()
+func (_IGnosisSafe *IGnosisSafeSession) Setup(_owners []common.Address, _threshold *big.Int, to common.Address, data []byte, fallbackHandler common.Address, paymentToken common.Address, payment *big.Int, paymentReceiver common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.Setup(&_IGnosisSafe.TransactOpts, _owners, _threshold, to, data, fallbackHandler, paymentToken, payment, paymentReceiver)
+}
+
+// Setup is a paid mutator transaction binding the contract method 0xb63e800d.
+//
+// Solidity: function setup(address[] _owners, uint256 _threshold, address to, bytes data, address fallbackHandler, address paymentToken, uint256 payment, address paymentReceiver) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) Setup(_owners []common.Address, _threshold *big.Int, to common.Address, data []byte, fallbackHandler common.Address, paymentToken common.Address, payment *big.Int, paymentReceiver common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.Setup(&_IGnosisSafe.TransactOpts, _owners, _threshold, to, data, fallbackHandler, paymentToken, payment, paymentReceiver)
+}
+
+// SimulateAndRevert is a paid mutator transaction binding the contract method 0xb4faba09.
+//
+// Solidity: function simulateAndRevert(address targetContract, bytes calldataPayload) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) SimulateAndRevert(opts *bind.TransactOpts, targetContract common.Address, calldataPayload []byte) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "simulateAndRevert", targetContract, calldataPayload)
+}
+
+// SimulateAndRevert is a paid mutator transaction binding the contract method 0xb4faba09.
+//
+// Solidity: function simulateAndRevert(address targetContract, bytes calldataPayload) returns()
+func (_IGnosisSafe *IGnosisSafeSession) SimulateAndRevert(targetContract common.Address, calldataPayload []byte) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.SimulateAndRevert(&_IGnosisSafe.TransactOpts, targetContract, calldataPayload)
+}
+
+// SimulateAndRevert is a paid mutator transaction binding the contract method 0xb4faba09.
+//
+// Solidity: function simulateAndRevert(address targetContract, bytes calldataPayload) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) SimulateAndRevert(targetContract common.Address, calldataPayload []byte) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.SimulateAndRevert(&_IGnosisSafe.TransactOpts, targetContract, calldataPayload)
+}
+
+// SwapOwner is a paid mutator transaction binding the contract method 0xe318b52b.
+//
+// Solidity: function swapOwner(address prevOwner, address oldOwner, address newOwner) returns()
+func (_IGnosisSafe *IGnosisSafeTransactor) SwapOwner(opts *bind.TransactOpts, prevOwner common.Address, oldOwner common.Address, newOwner common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.contract.Transact(opts, "swapOwner", prevOwner, oldOwner, newOwner)
+}
+
+// SwapOwner is a paid mutator transaction binding the contract method 0xe318b52b.
+//
+// Solidity: function swapOwner(address prevOwner, address oldOwner, address newOwner) returns()
+func (_IGnosisSafe *IGnosisSafeSession) SwapOwner(prevOwner common.Address, oldOwner common.Address, newOwner common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.SwapOwner(&_IGnosisSafe.TransactOpts, prevOwner, oldOwner, newOwner)
+}
+
+// SwapOwner is a paid mutator transaction binding the contract method 0xe318b52b.
+//
+// Solidity: function swapOwner(address prevOwner, address oldOwner, address newOwner) returns()
+func (_IGnosisSafe *IGnosisSafeTransactorSession) SwapOwner(prevOwner common.Address, oldOwner common.Address, newOwner common.Address) (*types.Transaction, error) {
+	return _IGnosisSafe.Contract.SwapOwner(&_IGnosisSafe.TransactOpts, prevOwner, oldOwner, newOwner)
+}
+
+// IGnosisSafeAddedOwnerIterator is returned from FilterAddedOwner and is used to iterate over the raw logs and unpacked data for AddedOwner events raised by the IGnosisSafe contract.
+type IGnosisSafeAddedOwnerIterator struct {
+	Event *IGnosisSafeAddedOwner // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeAddedOwnerIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeAddedOwner)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeAddedOwner)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeAddedOwnerIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeAddedOwnerIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeAddedOwner represents a AddedOwner event raised by the IGnosisSafe contract.
+type IGnosisSafeAddedOwner struct {
+	Owner common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterAddedOwner is a free log retrieval operation binding the contract event 0x9465fa0c962cc76958e6373a993326400c1c94f8be2fe3a952adfa7f60b2ea26.
+//
+// Solidity: event AddedOwner(address owner)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterAddedOwner(opts *bind.FilterOpts) (*IGnosisSafeAddedOwnerIterator, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "AddedOwner")
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeAddedOwnerIterator{contract: _IGnosisSafe.contract, event: "AddedOwner", logs: logs, sub: sub}, nil
+}
+
+// WatchAddedOwner is a free log subscription operation binding the contract event 0x9465fa0c962cc76958e6373a993326400c1c94f8be2fe3a952adfa7f60b2ea26.
+//
+// Solidity: event AddedOwner(address owner)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchAddedOwner(opts *bind.WatchOpts, sink chan<- *IGnosisSafeAddedOwner) (event.Subscription, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "AddedOwner")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeAddedOwner)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "AddedOwner", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAddedOwner is a log parse operation binding the contract event 0x9465fa0c962cc76958e6373a993326400c1c94f8be2fe3a952adfa7f60b2ea26.
+//
+// Solidity: event AddedOwner(address owner)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseAddedOwner(log types.Log) (*IGnosisSafeAddedOwner, error) {
+	event := new(IGnosisSafeAddedOwner)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "AddedOwner", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeApproveHashIterator is returned from FilterApproveHash and is used to iterate over the raw logs and unpacked data for ApproveHash events raised by the IGnosisSafe contract.
+type IGnosisSafeApproveHashIterator struct {
+	Event *IGnosisSafeApproveHash // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeApproveHashIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeApproveHash)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeApproveHash)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeApproveHashIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeApproveHashIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeApproveHash represents a ApproveHash event raised by the IGnosisSafe contract.
+type IGnosisSafeApproveHash struct {
+	ApprovedHash [32]byte
+	Owner        common.Address
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproveHash is a free log retrieval operation binding the contract event 0xf2a0eb156472d1440255b0d7c1e19cc07115d1051fe605b0dce69acfec884d9c.
+//
+// Solidity: event ApproveHash(bytes32 indexed approvedHash, address indexed owner)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterApproveHash(opts *bind.FilterOpts, approvedHash [][32]byte, owner []common.Address) (*IGnosisSafeApproveHashIterator, error) {
+
+	var approvedHashRule []interface{}
+	for _, approvedHashItem := range approvedHash {
+		approvedHashRule = append(approvedHashRule, approvedHashItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "ApproveHash", approvedHashRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeApproveHashIterator{contract: _IGnosisSafe.contract, event: "ApproveHash", logs: logs, sub: sub}, nil
+}
+
+// WatchApproveHash is a free log subscription operation binding the contract event 0xf2a0eb156472d1440255b0d7c1e19cc07115d1051fe605b0dce69acfec884d9c.
+//
+// Solidity: event ApproveHash(bytes32 indexed approvedHash, address indexed owner)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchApproveHash(opts *bind.WatchOpts, sink chan<- *IGnosisSafeApproveHash, approvedHash [][32]byte, owner []common.Address) (event.Subscription, error) {
+
+	var approvedHashRule []interface{}
+	for _, approvedHashItem := range approvedHash {
+		approvedHashRule = append(approvedHashRule, approvedHashItem)
+	}
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "ApproveHash", approvedHashRule, ownerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeApproveHash)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "ApproveHash", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproveHash is a log parse operation binding the contract event 0xf2a0eb156472d1440255b0d7c1e19cc07115d1051fe605b0dce69acfec884d9c.
+//
+// Solidity: event ApproveHash(bytes32 indexed approvedHash, address indexed owner)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseApproveHash(log types.Log) (*IGnosisSafeApproveHash, error) {
+	event := new(IGnosisSafeApproveHash)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "ApproveHash", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeChangedFallbackHandlerIterator is returned from FilterChangedFallbackHandler and is used to iterate over the raw logs and unpacked data for ChangedFallbackHandler events raised by the IGnosisSafe contract.
+type IGnosisSafeChangedFallbackHandlerIterator struct {
+	Event *IGnosisSafeChangedFallbackHandler // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeChangedFallbackHandlerIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeChangedFallbackHandler)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeChangedFallbackHandler)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeChangedFallbackHandlerIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeChangedFallbackHandlerIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeChangedFallbackHandler represents a ChangedFallbackHandler event raised by the IGnosisSafe contract.
+type IGnosisSafeChangedFallbackHandler struct {
+	Handler common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangedFallbackHandler is a free log retrieval operation binding the contract event 0x5ac6c46c93c8d0e53714ba3b53db3e7c046da994313d7ed0d192028bc7c228b0.
+//
+// Solidity: event ChangedFallbackHandler(address handler)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterChangedFallbackHandler(opts *bind.FilterOpts) (*IGnosisSafeChangedFallbackHandlerIterator, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "ChangedFallbackHandler")
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeChangedFallbackHandlerIterator{contract: _IGnosisSafe.contract, event: "ChangedFallbackHandler", logs: logs, sub: sub}, nil
+}
+
+// WatchChangedFallbackHandler is a free log subscription operation binding the contract event 0x5ac6c46c93c8d0e53714ba3b53db3e7c046da994313d7ed0d192028bc7c228b0.
+//
+// Solidity: event ChangedFallbackHandler(address handler)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchChangedFallbackHandler(opts *bind.WatchOpts, sink chan<- *IGnosisSafeChangedFallbackHandler) (event.Subscription, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "ChangedFallbackHandler")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeChangedFallbackHandler)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "ChangedFallbackHandler", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangedFallbackHandler is a log parse operation binding the contract event 0x5ac6c46c93c8d0e53714ba3b53db3e7c046da994313d7ed0d192028bc7c228b0.
+//
+// Solidity: event ChangedFallbackHandler(address handler)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseChangedFallbackHandler(log types.Log) (*IGnosisSafeChangedFallbackHandler, error) {
+	event := new(IGnosisSafeChangedFallbackHandler)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "ChangedFallbackHandler", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeChangedGuardIterator is returned from FilterChangedGuard and is used to iterate over the raw logs and unpacked data for ChangedGuard events raised by the IGnosisSafe contract.
+type IGnosisSafeChangedGuardIterator struct {
+	Event *IGnosisSafeChangedGuard // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeChangedGuardIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeChangedGuard)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeChangedGuard)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeChangedGuardIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeChangedGuardIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeChangedGuard represents a ChangedGuard event raised by the IGnosisSafe contract.
+type IGnosisSafeChangedGuard struct {
+	Guard common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangedGuard is a free log retrieval operation binding the contract event 0x1151116914515bc0891ff9047a6cb32cf902546f83066499bcf8ba33d2353fa2.
+//
+// Solidity: event ChangedGuard(address guard)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterChangedGuard(opts *bind.FilterOpts) (*IGnosisSafeChangedGuardIterator, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "ChangedGuard")
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeChangedGuardIterator{contract: _IGnosisSafe.contract, event: "ChangedGuard", logs: logs, sub: sub}, nil
+}
+
+// WatchChangedGuard is a free log subscription operation binding the contract event 0x1151116914515bc0891ff9047a6cb32cf902546f83066499bcf8ba33d2353fa2.
+//
+// Solidity: event ChangedGuard(address guard)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchChangedGuard(opts *bind.WatchOpts, sink chan<- *IGnosisSafeChangedGuard) (event.Subscription, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "ChangedGuard")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeChangedGuard)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "ChangedGuard", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangedGuard is a log parse operation binding the contract event 0x1151116914515bc0891ff9047a6cb32cf902546f83066499bcf8ba33d2353fa2.
+//
+// Solidity: event ChangedGuard(address guard)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseChangedGuard(log types.Log) (*IGnosisSafeChangedGuard, error) {
+	event := new(IGnosisSafeChangedGuard)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "ChangedGuard", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeChangedThresholdIterator is returned from FilterChangedThreshold and is used to iterate over the raw logs and unpacked data for ChangedThreshold events raised by the IGnosisSafe contract.
+type IGnosisSafeChangedThresholdIterator struct {
+	Event *IGnosisSafeChangedThreshold // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeChangedThresholdIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeChangedThreshold)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeChangedThreshold)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeChangedThresholdIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeChangedThresholdIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeChangedThreshold represents a ChangedThreshold event raised by the IGnosisSafe contract.
+type IGnosisSafeChangedThreshold struct {
+	Threshold *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterChangedThreshold is a free log retrieval operation binding the contract event 0x610f7ff2b304ae8903c3de74c60c6ab1f7d6226b3f52c5161905bb5ad4039c93.
+//
+// Solidity: event ChangedThreshold(uint256 threshold)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterChangedThreshold(opts *bind.FilterOpts) (*IGnosisSafeChangedThresholdIterator, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "ChangedThreshold")
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeChangedThresholdIterator{contract: _IGnosisSafe.contract, event: "ChangedThreshold", logs: logs, sub: sub}, nil
+}
+
+// WatchChangedThreshold is a free log subscription operation binding the contract event 0x610f7ff2b304ae8903c3de74c60c6ab1f7d6226b3f52c5161905bb5ad4039c93.
+//
+// Solidity: event ChangedThreshold(uint256 threshold)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchChangedThreshold(opts *bind.WatchOpts, sink chan<- *IGnosisSafeChangedThreshold) (event.Subscription, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "ChangedThreshold")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeChangedThreshold)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "ChangedThreshold", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseChangedThreshold is a log parse operation binding the contract event 0x610f7ff2b304ae8903c3de74c60c6ab1f7d6226b3f52c5161905bb5ad4039c93.
+//
+// Solidity: event ChangedThreshold(uint256 threshold)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseChangedThreshold(log types.Log) (*IGnosisSafeChangedThreshold, error) {
+	event := new(IGnosisSafeChangedThreshold)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "ChangedThreshold", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeDisabledModuleIterator is returned from FilterDisabledModule and is used to iterate over the raw logs and unpacked data for DisabledModule events raised by the IGnosisSafe contract.
+type IGnosisSafeDisabledModuleIterator struct {
+	Event *IGnosisSafeDisabledModule // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeDisabledModuleIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeDisabledModule)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeDisabledModule)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeDisabledModuleIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeDisabledModuleIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeDisabledModule represents a DisabledModule event raised by the IGnosisSafe contract.
+type IGnosisSafeDisabledModule struct {
+	Module common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterDisabledModule is a free log retrieval operation binding the contract event 0xaab4fa2b463f581b2b32cb3b7e3b704b9ce37cc209b5fb4d77e593ace4054276.
+//
+// Solidity: event DisabledModule(address module)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterDisabledModule(opts *bind.FilterOpts) (*IGnosisSafeDisabledModuleIterator, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "DisabledModule")
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeDisabledModuleIterator{contract: _IGnosisSafe.contract, event: "DisabledModule", logs: logs, sub: sub}, nil
+}
+
+// WatchDisabledModule is a free log subscription operation binding the contract event 0xaab4fa2b463f581b2b32cb3b7e3b704b9ce37cc209b5fb4d77e593ace4054276.
+//
+// Solidity: event DisabledModule(address module)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchDisabledModule(opts *bind.WatchOpts, sink chan<- *IGnosisSafeDisabledModule) (event.Subscription, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "DisabledModule")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeDisabledModule)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "DisabledModule", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDisabledModule is a log parse operation binding the contract event 0xaab4fa2b463f581b2b32cb3b7e3b704b9ce37cc209b5fb4d77e593ace4054276.
+//
+// Solidity: event DisabledModule(address module)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseDisabledModule(log types.Log) (*IGnosisSafeDisabledModule, error) {
+	event := new(IGnosisSafeDisabledModule)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "DisabledModule", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeEnabledModuleIterator is returned from FilterEnabledModule and is used to iterate over the raw logs and unpacked data for EnabledModule events raised by the IGnosisSafe contract.
+type IGnosisSafeEnabledModuleIterator struct {
+	Event *IGnosisSafeEnabledModule // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeEnabledModuleIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeEnabledModule)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeEnabledModule)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeEnabledModuleIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeEnabledModuleIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeEnabledModule represents a EnabledModule event raised by the IGnosisSafe contract.
+type IGnosisSafeEnabledModule struct {
+	Module common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterEnabledModule is a free log retrieval operation binding the contract event 0xecdf3a3effea5783a3c4c2140e677577666428d44ed9d474a0b3a4c9943f8440.
+//
+// Solidity: event EnabledModule(address module)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterEnabledModule(opts *bind.FilterOpts) (*IGnosisSafeEnabledModuleIterator, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "EnabledModule")
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeEnabledModuleIterator{contract: _IGnosisSafe.contract, event: "EnabledModule", logs: logs, sub: sub}, nil
+}
+
+// WatchEnabledModule is a free log subscription operation binding the contract event 0xecdf3a3effea5783a3c4c2140e677577666428d44ed9d474a0b3a4c9943f8440.
+//
+// Solidity: event EnabledModule(address module)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchEnabledModule(opts *bind.WatchOpts, sink chan<- *IGnosisSafeEnabledModule) (event.Subscription, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "EnabledModule")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeEnabledModule)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "EnabledModule", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEnabledModule is a log parse operation binding the contract event 0xecdf3a3effea5783a3c4c2140e677577666428d44ed9d474a0b3a4c9943f8440.
+//
+// Solidity: event EnabledModule(address module)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseEnabledModule(log types.Log) (*IGnosisSafeEnabledModule, error) {
+	event := new(IGnosisSafeEnabledModule)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "EnabledModule", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeExecutionFailureIterator is returned from FilterExecutionFailure and is used to iterate over the raw logs and unpacked data for ExecutionFailure events raised by the IGnosisSafe contract.
+type IGnosisSafeExecutionFailureIterator struct {
+	Event *IGnosisSafeExecutionFailure // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeExecutionFailureIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeExecutionFailure)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeExecutionFailure)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeExecutionFailureIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeExecutionFailureIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeExecutionFailure represents a ExecutionFailure event raised by the IGnosisSafe contract.
+type IGnosisSafeExecutionFailure struct {
+	TxHash  [32]byte
+	Payment *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecutionFailure is a free log retrieval operation binding the contract event 0x23428b18acfb3ea64b08dc0c1d296ea9c09702c09083ca5272e64d115b687d23.
+//
+// Solidity: event ExecutionFailure(bytes32 txHash, uint256 payment)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterExecutionFailure(opts *bind.FilterOpts) (*IGnosisSafeExecutionFailureIterator, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "ExecutionFailure")
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeExecutionFailureIterator{contract: _IGnosisSafe.contract, event: "ExecutionFailure", logs: logs, sub: sub}, nil
+}
+
+// WatchExecutionFailure is a free log subscription operation binding the contract event 0x23428b18acfb3ea64b08dc0c1d296ea9c09702c09083ca5272e64d115b687d23.
+//
+// Solidity: event ExecutionFailure(bytes32 txHash, uint256 payment)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchExecutionFailure(opts *bind.WatchOpts, sink chan<- *IGnosisSafeExecutionFailure) (event.Subscription, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "ExecutionFailure")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeExecutionFailure)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "ExecutionFailure", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecutionFailure is a log parse operation binding the contract event 0x23428b18acfb3ea64b08dc0c1d296ea9c09702c09083ca5272e64d115b687d23.
+//
+// Solidity: event ExecutionFailure(bytes32 txHash, uint256 payment)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseExecutionFailure(log types.Log) (*IGnosisSafeExecutionFailure, error) {
+	event := new(IGnosisSafeExecutionFailure)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "ExecutionFailure", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeExecutionFromModuleFailureIterator is returned from FilterExecutionFromModuleFailure and is used to iterate over the raw logs and unpacked data for ExecutionFromModuleFailure events raised by the IGnosisSafe contract.
+type IGnosisSafeExecutionFromModuleFailureIterator struct {
+	Event *IGnosisSafeExecutionFromModuleFailure // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeExecutionFromModuleFailureIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeExecutionFromModuleFailure)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeExecutionFromModuleFailure)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeExecutionFromModuleFailureIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeExecutionFromModuleFailureIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeExecutionFromModuleFailure represents a ExecutionFromModuleFailure event raised by the IGnosisSafe contract.
+type IGnosisSafeExecutionFromModuleFailure struct {
+	Module common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecutionFromModuleFailure is a free log retrieval operation binding the contract event 0xacd2c8702804128fdb0db2bb49f6d127dd0181c13fd45dbfe16de0930e2bd375.
+//
+// Solidity: event ExecutionFromModuleFailure(address indexed module)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterExecutionFromModuleFailure(opts *bind.FilterOpts, module []common.Address) (*IGnosisSafeExecutionFromModuleFailureIterator, error) {
+
+	var moduleRule []interface{}
+	for _, moduleItem := range module {
+		moduleRule = append(moduleRule, moduleItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "ExecutionFromModuleFailure", moduleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeExecutionFromModuleFailureIterator{contract: _IGnosisSafe.contract, event: "ExecutionFromModuleFailure", logs: logs, sub: sub}, nil
+}
+
+// WatchExecutionFromModuleFailure is a free log subscription operation binding the contract event 0xacd2c8702804128fdb0db2bb49f6d127dd0181c13fd45dbfe16de0930e2bd375.
+//
+// Solidity: event ExecutionFromModuleFailure(address indexed module)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchExecutionFromModuleFailure(opts *bind.WatchOpts, sink chan<- *IGnosisSafeExecutionFromModuleFailure, module []common.Address) (event.Subscription, error) {
+
+	var moduleRule []interface{}
+	for _, moduleItem := range module {
+		moduleRule = append(moduleRule, moduleItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "ExecutionFromModuleFailure", moduleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeExecutionFromModuleFailure)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "ExecutionFromModuleFailure", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecutionFromModuleFailure is a log parse operation binding the contract event 0xacd2c8702804128fdb0db2bb49f6d127dd0181c13fd45dbfe16de0930e2bd375.
+//
+// Solidity: event ExecutionFromModuleFailure(address indexed module)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseExecutionFromModuleFailure(log types.Log) (*IGnosisSafeExecutionFromModuleFailure, error) {
+	event := new(IGnosisSafeExecutionFromModuleFailure)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "ExecutionFromModuleFailure", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeExecutionFromModuleSuccessIterator is returned from FilterExecutionFromModuleSuccess and is used to iterate over the raw logs and unpacked data for ExecutionFromModuleSuccess events raised by the IGnosisSafe contract.
+type IGnosisSafeExecutionFromModuleSuccessIterator struct {
+	Event *IGnosisSafeExecutionFromModuleSuccess // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeExecutionFromModuleSuccessIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeExecutionFromModuleSuccess)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeExecutionFromModuleSuccess)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeExecutionFromModuleSuccessIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeExecutionFromModuleSuccessIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeExecutionFromModuleSuccess represents a ExecutionFromModuleSuccess event raised by the IGnosisSafe contract.
+type IGnosisSafeExecutionFromModuleSuccess struct {
+	Module common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecutionFromModuleSuccess is a free log retrieval operation binding the contract event 0x6895c13664aa4f67288b25d7a21d7aaa34916e355fb9b6fae0a139a9085becb8.
+//
+// Solidity: event ExecutionFromModuleSuccess(address indexed module)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterExecutionFromModuleSuccess(opts *bind.FilterOpts, module []common.Address) (*IGnosisSafeExecutionFromModuleSuccessIterator, error) {
+
+	var moduleRule []interface{}
+	for _, moduleItem := range module {
+		moduleRule = append(moduleRule, moduleItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "ExecutionFromModuleSuccess", moduleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeExecutionFromModuleSuccessIterator{contract: _IGnosisSafe.contract, event: "ExecutionFromModuleSuccess", logs: logs, sub: sub}, nil
+}
+
+// WatchExecutionFromModuleSuccess is a free log subscription operation binding the contract event 0x6895c13664aa4f67288b25d7a21d7aaa34916e355fb9b6fae0a139a9085becb8.
+//
+// Solidity: event ExecutionFromModuleSuccess(address indexed module)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchExecutionFromModuleSuccess(opts *bind.WatchOpts, sink chan<- *IGnosisSafeExecutionFromModuleSuccess, module []common.Address) (event.Subscription, error) {
+
+	var moduleRule []interface{}
+	for _, moduleItem := range module {
+		moduleRule = append(moduleRule, moduleItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "ExecutionFromModuleSuccess", moduleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeExecutionFromModuleSuccess)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "ExecutionFromModuleSuccess", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecutionFromModuleSuccess is a log parse operation binding the contract event 0x6895c13664aa4f67288b25d7a21d7aaa34916e355fb9b6fae0a139a9085becb8.
+//
+// Solidity: event ExecutionFromModuleSuccess(address indexed module)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseExecutionFromModuleSuccess(log types.Log) (*IGnosisSafeExecutionFromModuleSuccess, error) {
+	event := new(IGnosisSafeExecutionFromModuleSuccess)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "ExecutionFromModuleSuccess", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeExecutionSuccessIterator is returned from FilterExecutionSuccess and is used to iterate over the raw logs and unpacked data for ExecutionSuccess events raised by the IGnosisSafe contract.
+type IGnosisSafeExecutionSuccessIterator struct {
+	Event *IGnosisSafeExecutionSuccess // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeExecutionSuccessIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeExecutionSuccess)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeExecutionSuccess)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeExecutionSuccessIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeExecutionSuccessIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeExecutionSuccess represents a ExecutionSuccess event raised by the IGnosisSafe contract.
+type IGnosisSafeExecutionSuccess struct {
+	TxHash  [32]byte
+	Payment *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecutionSuccess is a free log retrieval operation binding the contract event 0x442e715f626346e8c54381002da614f62bee8d27386535b2521ec8540898556e.
+//
+// Solidity: event ExecutionSuccess(bytes32 txHash, uint256 payment)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterExecutionSuccess(opts *bind.FilterOpts) (*IGnosisSafeExecutionSuccessIterator, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "ExecutionSuccess")
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeExecutionSuccessIterator{contract: _IGnosisSafe.contract, event: "ExecutionSuccess", logs: logs, sub: sub}, nil
+}
+
+// WatchExecutionSuccess is a free log subscription operation binding the contract event 0x442e715f626346e8c54381002da614f62bee8d27386535b2521ec8540898556e.
+//
+// Solidity: event ExecutionSuccess(bytes32 txHash, uint256 payment)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchExecutionSuccess(opts *bind.WatchOpts, sink chan<- *IGnosisSafeExecutionSuccess) (event.Subscription, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "ExecutionSuccess")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeExecutionSuccess)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "ExecutionSuccess", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecutionSuccess is a log parse operation binding the contract event 0x442e715f626346e8c54381002da614f62bee8d27386535b2521ec8540898556e.
+//
+// Solidity: event ExecutionSuccess(bytes32 txHash, uint256 payment)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseExecutionSuccess(log types.Log) (*IGnosisSafeExecutionSuccess, error) {
+	event := new(IGnosisSafeExecutionSuccess)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "ExecutionSuccess", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeRemovedOwnerIterator is returned from FilterRemovedOwner and is used to iterate over the raw logs and unpacked data for RemovedOwner events raised by the IGnosisSafe contract.
+type IGnosisSafeRemovedOwnerIterator struct {
+	Event *IGnosisSafeRemovedOwner // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeRemovedOwnerIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeRemovedOwner)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeRemovedOwner)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeRemovedOwnerIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeRemovedOwnerIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeRemovedOwner represents a RemovedOwner event raised by the IGnosisSafe contract.
+type IGnosisSafeRemovedOwner struct {
+	Owner common.Address
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterRemovedOwner is a free log retrieval operation binding the contract event 0xf8d49fc529812e9a7c5c50e69c20f0dccc0db8fa95c98bc58cc9a4f1c1299eaf.
+//
+// Solidity: event RemovedOwner(address owner)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterRemovedOwner(opts *bind.FilterOpts) (*IGnosisSafeRemovedOwnerIterator, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "RemovedOwner")
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeRemovedOwnerIterator{contract: _IGnosisSafe.contract, event: "RemovedOwner", logs: logs, sub: sub}, nil
+}
+
+// WatchRemovedOwner is a free log subscription operation binding the contract event 0xf8d49fc529812e9a7c5c50e69c20f0dccc0db8fa95c98bc58cc9a4f1c1299eaf.
+//
+// Solidity: event RemovedOwner(address owner)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchRemovedOwner(opts *bind.WatchOpts, sink chan<- *IGnosisSafeRemovedOwner) (event.Subscription, error) {
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "RemovedOwner")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeRemovedOwner)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "RemovedOwner", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRemovedOwner is a log parse operation binding the contract event 0xf8d49fc529812e9a7c5c50e69c20f0dccc0db8fa95c98bc58cc9a4f1c1299eaf.
+//
+// Solidity: event RemovedOwner(address owner)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseRemovedOwner(log types.Log) (*IGnosisSafeRemovedOwner, error) {
+	event := new(IGnosisSafeRemovedOwner)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "RemovedOwner", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeSafeReceivedIterator is returned from FilterSafeReceived and is used to iterate over the raw logs and unpacked data for SafeReceived events raised by the IGnosisSafe contract.
+type IGnosisSafeSafeReceivedIterator struct {
+	Event *IGnosisSafeSafeReceived // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeSafeReceivedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeSafeReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeSafeReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeSafeReceivedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeSafeReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeSafeReceived represents a SafeReceived event raised by the IGnosisSafe contract.
+type IGnosisSafeSafeReceived struct {
+	Sender common.Address
+	Value  *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterSafeReceived is a free log retrieval operation binding the contract event 0x3d0ce9bfc3ed7d6862dbb28b2dea94561fe714a1b4d019aa8af39730d1ad7c3d.
+//
+// Solidity: event SafeReceived(address indexed sender, uint256 value)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterSafeReceived(opts *bind.FilterOpts, sender []common.Address) (*IGnosisSafeSafeReceivedIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "SafeReceived", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeSafeReceivedIterator{contract: _IGnosisSafe.contract, event: "SafeReceived", logs: logs, sub: sub}, nil
+}
+
+// WatchSafeReceived is a free log subscription operation binding the contract event 0x3d0ce9bfc3ed7d6862dbb28b2dea94561fe714a1b4d019aa8af39730d1ad7c3d.
+//
+// Solidity: event SafeReceived(address indexed sender, uint256 value)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchSafeReceived(opts *bind.WatchOpts, sink chan<- *IGnosisSafeSafeReceived, sender []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "SafeReceived", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeSafeReceived)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "SafeReceived", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSafeReceived is a log parse operation binding the contract event 0x3d0ce9bfc3ed7d6862dbb28b2dea94561fe714a1b4d019aa8af39730d1ad7c3d.
+//
+// Solidity: event SafeReceived(address indexed sender, uint256 value)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseSafeReceived(log types.Log) (*IGnosisSafeSafeReceived, error) {
+	event := new(IGnosisSafeSafeReceived)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "SafeReceived", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeSafeSetupIterator is returned from FilterSafeSetup and is used to iterate over the raw logs and unpacked data for SafeSetup events raised by the IGnosisSafe contract.
+type IGnosisSafeSafeSetupIterator struct {
+	Event *IGnosisSafeSafeSetup // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeSafeSetupIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeSafeSetup)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeSafeSetup)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeSafeSetupIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeSafeSetupIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeSafeSetup represents a SafeSetup event raised by the IGnosisSafe contract.
+type IGnosisSafeSafeSetup struct {
+	Initiator       common.Address
+	Owners          []common.Address
+	Threshold       *big.Int
+	Initializer     common.Address
+	FallbackHandler common.Address
+	Raw             types.Log // Blockchain specific contextual infos
+}
+
+// FilterSafeSetup is a free log retrieval operation binding the contract event 0x141df868a6331af528e38c83b7aa03edc19be66e37ae67f9285bf4f8e3c6a1a8.
+//
+// Solidity: event SafeSetup(address indexed initiator, address[] owners, uint256 threshold, address initializer, address fallbackHandler)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterSafeSetup(opts *bind.FilterOpts, initiator []common.Address) (*IGnosisSafeSafeSetupIterator, error) {
+
+	var initiatorRule []interface{}
+	for _, initiatorItem := range initiator {
+		initiatorRule = append(initiatorRule, initiatorItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "SafeSetup", initiatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeSafeSetupIterator{contract: _IGnosisSafe.contract, event: "SafeSetup", logs: logs, sub: sub}, nil
+}
+
+// WatchSafeSetup is a free log subscription operation binding the contract event 0x141df868a6331af528e38c83b7aa03edc19be66e37ae67f9285bf4f8e3c6a1a8.
+//
+// Solidity: event SafeSetup(address indexed initiator, address[] owners, uint256 threshold, address initializer, address fallbackHandler)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchSafeSetup(opts *bind.WatchOpts, sink chan<- *IGnosisSafeSafeSetup, initiator []common.Address) (event.Subscription, error) {
+
+	var initiatorRule []interface{}
+	for _, initiatorItem := range initiator {
+		initiatorRule = append(initiatorRule, initiatorItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "SafeSetup", initiatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeSafeSetup)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "SafeSetup", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSafeSetup is a log parse operation binding the contract event 0x141df868a6331af528e38c83b7aa03edc19be66e37ae67f9285bf4f8e3c6a1a8.
+//
+// Solidity: event SafeSetup(address indexed initiator, address[] owners, uint256 threshold, address initializer, address fallbackHandler)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseSafeSetup(log types.Log) (*IGnosisSafeSafeSetup, error) {
+	event := new(IGnosisSafeSafeSetup)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "SafeSetup", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IGnosisSafeSignMsgIterator is returned from FilterSignMsg and is used to iterate over the raw logs and unpacked data for SignMsg events raised by the IGnosisSafe contract.
+type IGnosisSafeSignMsgIterator struct {
+	Event *IGnosisSafeSignMsg // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IGnosisSafeSignMsgIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IGnosisSafeSignMsg)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IGnosisSafeSignMsg)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IGnosisSafeSignMsgIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IGnosisSafeSignMsgIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IGnosisSafeSignMsg represents a SignMsg event raised by the IGnosisSafe contract.
+type IGnosisSafeSignMsg struct {
+	MsgHash [32]byte
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSignMsg is a free log retrieval operation binding the contract event 0xe7f4675038f4f6034dfcbbb24c4dc08e4ebf10eb9d257d3d02c0f38d122ac6e4.
+//
+// Solidity: event SignMsg(bytes32 indexed msgHash)
+func (_IGnosisSafe *IGnosisSafeFilterer) FilterSignMsg(opts *bind.FilterOpts, msgHash [][32]byte) (*IGnosisSafeSignMsgIterator, error) {
+
+	var msgHashRule []interface{}
+	for _, msgHashItem := range msgHash {
+		msgHashRule = append(msgHashRule, msgHashItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.FilterLogs(opts, "SignMsg", msgHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IGnosisSafeSignMsgIterator{contract: _IGnosisSafe.contract, event: "SignMsg", logs: logs, sub: sub}, nil
+}
+
+// WatchSignMsg is a free log subscription operation binding the contract event 0xe7f4675038f4f6034dfcbbb24c4dc08e4ebf10eb9d257d3d02c0f38d122ac6e4.
+//
+// Solidity: event SignMsg(bytes32 indexed msgHash)
+func (_IGnosisSafe *IGnosisSafeFilterer) WatchSignMsg(opts *bind.WatchOpts, sink chan<- *IGnosisSafeSignMsg, msgHash [][32]byte) (event.Subscription, error) {
+
+	var msgHashRule []interface{}
+	for _, msgHashItem := range msgHash {
+		msgHashRule = append(msgHashRule, msgHashItem)
+	}
+
+	logs, sub, err := _IGnosisSafe.contract.WatchLogs(opts, "SignMsg", msgHashRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IGnosisSafeSignMsg)
+				if err := _IGnosisSafe.contract.UnpackLog(event, "SignMsg", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSignMsg is a log parse operation binding the contract event 0xe7f4675038f4f6034dfcbbb24c4dc08e4ebf10eb9d257d3d02c0f38d122ac6e4.
+//
+// Solidity: event SignMsg(bytes32 indexed msgHash)
+func (_IGnosisSafe *IGnosisSafeFilterer) ParseSignMsg(log types.Log) (*IGnosisSafeSignMsg, error) {
+	event := new(IGnosisSafeSignMsg)
+	if err := _IGnosisSafe.contract.UnpackLog(event, "SignMsg", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,3203 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// SmartEscrowMetaData contains all meta data concerning the SmartEscrow contract.
+var SmartEscrowMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_benefactor\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_beneficiary\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_benefactorOwner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_beneficiaryOwner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_escrowOwner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_cliffStart\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_end\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_vestingPeriodSeconds\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_initialTokens\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_vestingEventTokens\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"AddressIsZeroAddress\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"cliffStartTimestamp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"endTimestamp\",\"type\":\"uint256\"}],\"name\":\"CliffStartTimeAfterEndTime\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"cliffStartTimestamp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startTime\",\"type\":\"uint256\"}],\"name\":\"CliffStartTimeInvalid\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ContractIsNotTerminated\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"ContractIsTerminated\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"startTimestamp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"endTimestamp\",\"type\":\"uint256\"}],\"name\":\"StartTimeAfterEndTime\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"vestingPeriodSeconds\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"startTimestamp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"endTimestamp\",\"type\":\"uint256\"}],\"name\":\"UnevenVestingPeriod\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"VestingEventTokensIsZero\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"vestingPeriodSeconds\",\"type\":\"uint256\"}],\"name\":\"VestingPeriodExceedsContractDuration\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"VestingPeriodIsZeroSeconds\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"oldBenefactor\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newBenefactor\",\"type\":\"address\"}],\"name\":\"BenefactorUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"oldBeneficiary\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newBeneficiary\",\"type\":\"address\"}],\"name\":\"BeneficiaryUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"ContractResumed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"ContractTerminated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"DefaultAdminDelayChangeCanceled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint48\",\"name\":\"newDelay\",\"type\":\"uint48\"},{\"indexed\":false,\"internalType\":\"uint48\",\"name\":\"effectSchedule\",\"type\":\"uint48\"}],\"name\":\"DefaultAdminDelayChangeScheduled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[],\"name\":\"DefaultAdminTransferCanceled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint48\",\"name\":\"acceptSchedule\",\"type\":\"uint48\"}],\"name\":\"DefaultAdminTransferScheduled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"previousAdminRole\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"newAdminRole\",\"type\":\"bytes32\"}],\"name\":\"RoleAdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beneficiary\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensReleased\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"benefactor\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensWithdrawn\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"BENEFACTOR_OWNER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"BENEFICIARY_OWNER_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"DEFAULT_ADMIN_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"OP_TOKEN\",\"outputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"TERMINATOR_ROLE\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"acceptDefaultAdminTransfer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"beginDefaultAdminTransfer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"benefactor\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"beneficiary\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"cancelDefaultAdminTransfer\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint48\",\"name\":\"newDelay\",\"type\":\"uint48\"}],\"name\":\"changeDefaultAdminDelay\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"cliffStart\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"contractTerminated\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"defaultAdmin\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"defaultAdminDelay\",\"outputs\":[{\"internalType\":\"uint48\",\"name\":\"\",\"type\":\"uint48\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"defaultAdminDelayIncreaseWait\",\"outputs\":[{\"internalType\":\"uint48\",\"name\":\"\",\"type\":\"uint48\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"end\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"}],\"name\":\"getRoleAdmin\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"grantRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"hasRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialTokens\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pendingDefaultAdmin\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"},{\"internalType\":\"uint48\",\"name\":\"schedule\",\"type\":\"uint48\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pendingDefaultAdminDelay\",\"outputs\":[{\"internalType\":\"uint48\",\"name\":\"newDelay\",\"type\":\"uint48\"},{\"internalType\":\"uint48\",\"name\":\"schedule\",\"type\":\"uint48\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"releasable\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"release\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"released\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"renounceRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"resume\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"role\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"revokeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"rollbackDefaultAdminDelay\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"start\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"terminate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_newBenefactor\",\"type\":\"address\"}],\"name\":\"updateBenefactor\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_newBeneficiary\",\"type\":\"address\"}],\"name\":\"updateBeneficiary\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"}],\"name\":\"vestedAmount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"vestingEventTokens\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"vestingPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdrawUnvestedTokens\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// SmartEscrowABI is the input ABI used to generate the binding from.
+// Deprecated: Use SmartEscrowMetaData.ABI instead.
+var SmartEscrowABI = SmartEscrowMetaData.ABI
+
+// SmartEscrow is an auto generated Go binding around an Ethereum contract.
+type SmartEscrow struct {
+	SmartEscrowCaller     // Read-only binding to the contract
+	SmartEscrowTransactor // Write-only binding to the contract
+	SmartEscrowFilterer   // Log filterer for contract events
+}
+
+// SmartEscrowCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SmartEscrowCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SmartEscrowTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SmartEscrowTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SmartEscrowFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SmartEscrowFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SmartEscrowSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SmartEscrowSession struct {
+	Contract     *SmartEscrow      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SmartEscrowCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SmartEscrowCallerSession struct {
+	Contract *SmartEscrowCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// SmartEscrowTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SmartEscrowTransactorSession struct {
+	Contract     *SmartEscrowTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// SmartEscrowRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SmartEscrowRaw struct {
+	Contract *SmartEscrow // Generic contract binding to access the raw methods on
+}
+
+// SmartEscrowCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SmartEscrowCallerRaw struct {
+	Contract *SmartEscrowCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SmartEscrowTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SmartEscrowTransactorRaw struct {
+	Contract *SmartEscrowTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSmartEscrow creates a new instance of SmartEscrow, bound to a specific deployed contract.
+func NewSmartEscrow(address common.Address, backend bind.ContractBackend) (*SmartEscrow, error) {
+	contract, err := bindSmartEscrow(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrow{SmartEscrowCaller: SmartEscrowCaller{contract: contract}, SmartEscrowTransactor: SmartEscrowTransactor{contract: contract}, SmartEscrowFilterer: SmartEscrowFilterer{contract: contract}}, nil
+}
+
+// NewSmartEscrowCaller creates a new read-only instance of SmartEscrow, bound to a specific deployed contract.
+func NewSmartEscrowCaller(address common.Address, caller bind.ContractCaller) (*SmartEscrowCaller, error) {
+	contract, err := bindSmartEscrow(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowCaller{contract: contract}, nil
+}
+
+// NewSmartEscrowTransactor creates a new write-only instance of SmartEscrow, bound to a specific deployed contract.
+func NewSmartEscrowTransactor(address common.Address, transactor bind.ContractTransactor) (*SmartEscrowTransactor, error) {
+	contract, err := bindSmartEscrow(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowTransactor{contract: contract}, nil
+}
+
+// NewSmartEscrowFilterer creates a new log filterer instance of SmartEscrow, bound to a specific deployed contract.
+func NewSmartEscrowFilterer(address common.Address, filterer bind.ContractFilterer) (*SmartEscrowFilterer, error) {
+	contract, err := bindSmartEscrow(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowFilterer{contract: contract}, nil
+}
+
+// bindSmartEscrow binds a generic wrapper to an already deployed contract.
+func bindSmartEscrow(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SmartEscrowMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SmartEscrow *SmartEscrowRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SmartEscrow.Contract.SmartEscrowCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SmartEscrow *SmartEscrowRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.SmartEscrowTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SmartEscrow *SmartEscrowRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.SmartEscrowTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SmartEscrow *SmartEscrowCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SmartEscrow.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SmartEscrow *SmartEscrowTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SmartEscrow *SmartEscrowTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.contract.Transact(opts, method, params...)
+}
+
+// BENEFACTOROWNERROLE is a free data retrieval call binding the contract method 0x7ca40101.
+//
+// Solidity: function BENEFACTOR_OWNER_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowCaller) BENEFACTOROWNERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "BENEFACTOR_OWNER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BENEFACTOROWNERROLE is a free data retrieval call binding the contract method 0x7ca40101.
+//
+// Solidity: function BENEFACTOR_OWNER_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowSession) BENEFACTOROWNERROLE() ([32]byte, error) {
+	return _SmartEscrow.Contract.BENEFACTOROWNERROLE(&_SmartEscrow.CallOpts)
+}
+
+// BENEFACTOROWNERROLE is a free data retrieval call binding the contract method 0x7ca40101.
+//
+// Solidity: function BENEFACTOR_OWNER_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowCallerSession) BENEFACTOROWNERROLE() ([32]byte, error) {
+	return _SmartEscrow.Contract.BENEFACTOROWNERROLE(&_SmartEscrow.CallOpts)
+}
+
+// BENEFICIARYOWNERROLE is a free data retrieval call binding the contract method 0x6efd06c1.
+//
+// Solidity: function BENEFICIARY_OWNER_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowCaller) BENEFICIARYOWNERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "BENEFICIARY_OWNER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BENEFICIARYOWNERROLE is a free data retrieval call binding the contract method 0x6efd06c1.
+//
+// Solidity: function BENEFICIARY_OWNER_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowSession) BENEFICIARYOWNERROLE() ([32]byte, error) {
+	return _SmartEscrow.Contract.BENEFICIARYOWNERROLE(&_SmartEscrow.CallOpts)
+}
+
+// BENEFICIARYOWNERROLE is a free data retrieval call binding the contract method 0x6efd06c1.
+//
+// Solidity: function BENEFICIARY_OWNER_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowCallerSession) BENEFICIARYOWNERROLE() ([32]byte, error) {
+	return _SmartEscrow.Contract.BENEFICIARYOWNERROLE(&_SmartEscrow.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _SmartEscrow.Contract.DEFAULTADMINROLE(&_SmartEscrow.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _SmartEscrow.Contract.DEFAULTADMINROLE(&_SmartEscrow.CallOpts)
+}
+
+// OPTOKEN is a free data retrieval call binding the contract method 0x224d7e59.
+//
+// Solidity: function OP_TOKEN() view returns(address)
+func (_SmartEscrow *SmartEscrowCaller) OPTOKEN(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "OP_TOKEN")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OPTOKEN is a free data retrieval call binding the contract method 0x224d7e59.
+//
+// Solidity: function OP_TOKEN() view returns(address)
+func (_SmartEscrow *SmartEscrowSession) OPTOKEN() (common.Address, error) {
+	return _SmartEscrow.Contract.OPTOKEN(&_SmartEscrow.CallOpts)
+}
+
+// OPTOKEN is a free data retrieval call binding the contract method 0x224d7e59.
+//
+// Solidity: function OP_TOKEN() view returns(address)
+func (_SmartEscrow *SmartEscrowCallerSession) OPTOKEN() (common.Address, error) {
+	return _SmartEscrow.Contract.OPTOKEN(&_SmartEscrow.CallOpts)
+}
+
+// TERMINATORROLE is a free data retrieval call binding the contract method 0x2a711752.
+//
+// Solidity: function TERMINATOR_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowCaller) TERMINATORROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "TERMINATOR_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// TERMINATORROLE is a free data retrieval call binding the contract method 0x2a711752.
+//
+// Solidity: function TERMINATOR_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowSession) TERMINATORROLE() ([32]byte, error) {
+	return _SmartEscrow.Contract.TERMINATORROLE(&_SmartEscrow.CallOpts)
+}
+
+// TERMINATORROLE is a free data retrieval call binding the contract method 0x2a711752.
+//
+// Solidity: function TERMINATOR_ROLE() view returns(bytes32)
+func (_SmartEscrow *SmartEscrowCallerSession) TERMINATORROLE() ([32]byte, error) {
+	return _SmartEscrow.Contract.TERMINATORROLE(&_SmartEscrow.CallOpts)
+}
+
+// Benefactor is a free data retrieval call binding the contract method 0x8a81694c.
+//
+// Solidity: function benefactor() view returns(address)
+func (_SmartEscrow *SmartEscrowCaller) Benefactor(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "benefactor")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Benefactor is a free data retrieval call binding the contract method 0x8a81694c.
+//
+// Solidity: function benefactor() view returns(address)
+func (_SmartEscrow *SmartEscrowSession) Benefactor() (common.Address, error) {
+	return _SmartEscrow.Contract.Benefactor(&_SmartEscrow.CallOpts)
+}
+
+// Benefactor is a free data retrieval call binding the contract method 0x8a81694c.
+//
+// Solidity: function benefactor() view returns(address)
+func (_SmartEscrow *SmartEscrowCallerSession) Benefactor() (common.Address, error) {
+	return _SmartEscrow.Contract.Benefactor(&_SmartEscrow.CallOpts)
+}
+
+// Beneficiary is a free data retrieval call binding the contract method 0x38af3eed.
+//
+// Solidity: function beneficiary() view returns(address)
+func (_SmartEscrow *SmartEscrowCaller) Beneficiary(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "beneficiary")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Beneficiary is a free data retrieval call binding the contract method 0x38af3eed.
+//
+// Solidity: function beneficiary() view returns(address)
+func (_SmartEscrow *SmartEscrowSession) Beneficiary() (common.Address, error) {
+	return _SmartEscrow.Contract.Beneficiary(&_SmartEscrow.CallOpts)
+}
+
+// Beneficiary is a free data retrieval call binding the contract method 0x38af3eed.
+//
+// Solidity: function beneficiary() view returns(address)
+func (_SmartEscrow *SmartEscrowCallerSession) Beneficiary() (common.Address, error) {
+	return _SmartEscrow.Contract.Beneficiary(&_SmartEscrow.CallOpts)
+}
+
+// CliffStart is a free data retrieval call binding the contract method 0xb297c551.
+//
+// Solidity: function cliffStart() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCaller) CliffStart(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "cliffStart")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CliffStart is a free data retrieval call binding the contract method 0xb297c551.
+//
+// Solidity: function cliffStart() view returns(uint256)
+func (_SmartEscrow *SmartEscrowSession) CliffStart() (*big.Int, error) {
+	return _SmartEscrow.Contract.CliffStart(&_SmartEscrow.CallOpts)
+}
+
+// CliffStart is a free data retrieval call binding the contract method 0xb297c551.
+//
+// Solidity: function cliffStart() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCallerSession) CliffStart() (*big.Int, error) {
+	return _SmartEscrow.Contract.CliffStart(&_SmartEscrow.CallOpts)
+}
+
+// ContractTerminated is a free data retrieval call binding the contract method 0x1a2c2a2b.
+//
+// Solidity: function contractTerminated() view returns(bool)
+func (_SmartEscrow *SmartEscrowCaller) ContractTerminated(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "contractTerminated")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// ContractTerminated is a free data retrieval call binding the contract method 0x1a2c2a2b.
+//
+// Solidity: function contractTerminated() view returns(bool)
+func (_SmartEscrow *SmartEscrowSession) ContractTerminated() (bool, error) {
+	return _SmartEscrow.Contract.ContractTerminated(&_SmartEscrow.CallOpts)
+}
+
+// ContractTerminated is a free data retrieval call binding the contract method 0x1a2c2a2b.
+//
+// Solidity: function contractTerminated() view returns(bool)
+func (_SmartEscrow *SmartEscrowCallerSession) ContractTerminated() (bool, error) {
+	return _SmartEscrow.Contract.ContractTerminated(&_SmartEscrow.CallOpts)
+}
+
+// DefaultAdmin is a free data retrieval call binding the contract method 0x84ef8ffc.
+//
+// Solidity: function defaultAdmin() view returns(address)
+func (_SmartEscrow *SmartEscrowCaller) DefaultAdmin(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "defaultAdmin")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DefaultAdmin is a free data retrieval call binding the contract method 0x84ef8ffc.
+//
+// Solidity: function defaultAdmin() view returns(address)
+func (_SmartEscrow *SmartEscrowSession) DefaultAdmin() (common.Address, error) {
+	return _SmartEscrow.Contract.DefaultAdmin(&_SmartEscrow.CallOpts)
+}
+
+// DefaultAdmin is a free data retrieval call binding the contract method 0x84ef8ffc.
+//
+// Solidity: function defaultAdmin() view returns(address)
+func (_SmartEscrow *SmartEscrowCallerSession) DefaultAdmin() (common.Address, error) {
+	return _SmartEscrow.Contract.DefaultAdmin(&_SmartEscrow.CallOpts)
+}
+
+// DefaultAdminDelay is a free data retrieval call binding the contract method 0xcc8463c8.
+//
+// Solidity: function defaultAdminDelay() view returns(uint48)
+func (_SmartEscrow *SmartEscrowCaller) DefaultAdminDelay(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "defaultAdminDelay")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DefaultAdminDelay is a free data retrieval call binding the contract method 0xcc8463c8.
+//
+// Solidity: function defaultAdminDelay() view returns(uint48)
+func (_SmartEscrow *SmartEscrowSession) DefaultAdminDelay() (*big.Int, error) {
+	return _SmartEscrow.Contract.DefaultAdminDelay(&_SmartEscrow.CallOpts)
+}
+
+// DefaultAdminDelay is a free data retrieval call binding the contract method 0xcc8463c8.
+//
+// Solidity: function defaultAdminDelay() view returns(uint48)
+func (_SmartEscrow *SmartEscrowCallerSession) DefaultAdminDelay() (*big.Int, error) {
+	return _SmartEscrow.Contract.DefaultAdminDelay(&_SmartEscrow.CallOpts)
+}
+
+// DefaultAdminDelayIncreaseWait is a free data retrieval call binding the contract method 0x022d63fb.
+//
+// Solidity: function defaultAdminDelayIncreaseWait() view returns(uint48)
+func (_SmartEscrow *SmartEscrowCaller) DefaultAdminDelayIncreaseWait(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "defaultAdminDelayIncreaseWait")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DefaultAdminDelayIncreaseWait is a free data retrieval call binding the contract method 0x022d63fb.
+//
+// Solidity: function defaultAdminDelayIncreaseWait() view returns(uint48)
+func (_SmartEscrow *SmartEscrowSession) DefaultAdminDelayIncreaseWait() (*big.Int, error) {
+	return _SmartEscrow.Contract.DefaultAdminDelayIncreaseWait(&_SmartEscrow.CallOpts)
+}
+
+// DefaultAdminDelayIncreaseWait is a free data retrieval call binding the contract method 0x022d63fb.
+//
+// Solidity: function defaultAdminDelayIncreaseWait() view returns(uint48)
+func (_SmartEscrow *SmartEscrowCallerSession) DefaultAdminDelayIncreaseWait() (*big.Int, error) {
+	return _SmartEscrow.Contract.DefaultAdminDelayIncreaseWait(&_SmartEscrow.CallOpts)
+}
+
+// End is a free data retrieval call binding the contract method 0xefbe1c1c.
+//
+// Solidity: function end() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCaller) End(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "end")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// End is a free data retrieval call binding the contract method 0xefbe1c1c.
+//
+// Solidity: function end() view returns(uint256)
+func (_SmartEscrow *SmartEscrowSession) End() (*big.Int, error) {
+	return _SmartEscrow.Contract.End(&_SmartEscrow.CallOpts)
+}
+
+// End is a free data retrieval call binding the contract method 0xefbe1c1c.
+//
+// Solidity: function end() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCallerSession) End() (*big.Int, error) {
+	return _SmartEscrow.Contract.End(&_SmartEscrow.CallOpts)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_SmartEscrow *SmartEscrowCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_SmartEscrow *SmartEscrowSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _SmartEscrow.Contract.GetRoleAdmin(&_SmartEscrow.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_SmartEscrow *SmartEscrowCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _SmartEscrow.Contract.GetRoleAdmin(&_SmartEscrow.CallOpts, role)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_SmartEscrow *SmartEscrowCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_SmartEscrow *SmartEscrowSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _SmartEscrow.Contract.HasRole(&_SmartEscrow.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_SmartEscrow *SmartEscrowCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _SmartEscrow.Contract.HasRole(&_SmartEscrow.CallOpts, role, account)
+}
+
+// InitialTokens is a free data retrieval call binding the contract method 0x50bfeadc.
+//
+// Solidity: function initialTokens() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCaller) InitialTokens(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "initialTokens")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// InitialTokens is a free data retrieval call binding the contract method 0x50bfeadc.
+//
+// Solidity: function initialTokens() view returns(uint256)
+func (_SmartEscrow *SmartEscrowSession) InitialTokens() (*big.Int, error) {
+	return _SmartEscrow.Contract.InitialTokens(&_SmartEscrow.CallOpts)
+}
+
+// InitialTokens is a free data retrieval call binding the contract method 0x50bfeadc.
+//
+// Solidity: function initialTokens() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCallerSession) InitialTokens() (*big.Int, error) {
+	return _SmartEscrow.Contract.InitialTokens(&_SmartEscrow.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_SmartEscrow *SmartEscrowCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_SmartEscrow *SmartEscrowSession) Owner() (common.Address, error) {
+	return _SmartEscrow.Contract.Owner(&_SmartEscrow.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_SmartEscrow *SmartEscrowCallerSession) Owner() (common.Address, error) {
+	return _SmartEscrow.Contract.Owner(&_SmartEscrow.CallOpts)
+}
+
+// PendingDefaultAdmin is a free data retrieval call binding the contract method 0xcf6eefb7.
+//
+// Solidity: function pendingDefaultAdmin() view returns(address newAdmin, uint48 schedule)
+func (_SmartEscrow *SmartEscrowCaller) PendingDefaultAdmin(opts *bind.CallOpts) (struct {
+	NewAdmin common.Address
+	Schedule *big.Int
+}, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "pendingDefaultAdmin")
+
+	outstruct := new(struct {
+		NewAdmin common.Address
+		Schedule *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.NewAdmin = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Schedule = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// PendingDefaultAdmin is a free data retrieval call binding the contract method 0xcf6eefb7.
+//
+// Solidity: function pendingDefaultAdmin() view returns(address newAdmin, uint48 schedule)
+func (_SmartEscrow *SmartEscrowSession) PendingDefaultAdmin() (struct {
+	NewAdmin common.Address
+	Schedule *big.Int
+}, error) {
+	return _SmartEscrow.Contract.PendingDefaultAdmin(&_SmartEscrow.CallOpts)
+}
+
+// PendingDefaultAdmin is a free data retrieval call binding the contract method 0xcf6eefb7.
+//
+// Solidity: function pendingDefaultAdmin() view returns(address newAdmin, uint48 schedule)
+func (_SmartEscrow *SmartEscrowCallerSession) PendingDefaultAdmin() (struct {
+	NewAdmin common.Address
+	Schedule *big.Int
+}, error) {
+	return _SmartEscrow.Contract.PendingDefaultAdmin(&_SmartEscrow.CallOpts)
+}
+
+// PendingDefaultAdminDelay is a free data retrieval call binding the contract method 0xa1eda53c.
+//
+// Solidity: function pendingDefaultAdminDelay() view returns(uint48 newDelay, uint48 schedule)
+func (_SmartEscrow *SmartEscrowCaller) PendingDefaultAdminDelay(opts *bind.CallOpts) (struct {
+	NewDelay *big.Int
+	Schedule *big.Int
+}, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "pendingDefaultAdminDelay")
+
+	outstruct := new(struct {
+		NewDelay *big.Int
+		Schedule *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.NewDelay = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Schedule = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// PendingDefaultAdminDelay is a free data retrieval call binding the contract method 0xa1eda53c.
+//
+// Solidity: function pendingDefaultAdminDelay() view returns(uint48 newDelay, uint48 schedule)
+func (_SmartEscrow *SmartEscrowSession) PendingDefaultAdminDelay() (struct {
+	NewDelay *big.Int
+	Schedule *big.Int
+}, error) {
+	return _SmartEscrow.Contract.PendingDefaultAdminDelay(&_SmartEscrow.CallOpts)
+}
+
+// PendingDefaultAdminDelay is a free data retrieval call binding the contract method 0xa1eda53c.
+//
+// Solidity: function pendingDefaultAdminDelay() view returns(uint48 newDelay, uint48 schedule)
+func (_SmartEscrow *SmartEscrowCallerSession) PendingDefaultAdminDelay() (struct {
+	NewDelay *big.Int
+	Schedule *big.Int
+}, error) {
+	return _SmartEscrow.Contract.PendingDefaultAdminDelay(&_SmartEscrow.CallOpts)
+}
+
+// Releasable is a free data retrieval call binding the contract method 0xfbccedae.
+//
+// Solidity: function releasable() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCaller) Releasable(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "releasable")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Releasable is a free data retrieval call binding the contract method 0xfbccedae.
+//
+// Solidity: function releasable() view returns(uint256)
+func (_SmartEscrow *SmartEscrowSession) Releasable() (*big.Int, error) {
+	return _SmartEscrow.Contract.Releasable(&_SmartEscrow.CallOpts)
+}
+
+// Releasable is a free data retrieval call binding the contract method 0xfbccedae.
+//
+// Solidity: function releasable() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCallerSession) Releasable() (*big.Int, error) {
+	return _SmartEscrow.Contract.Releasable(&_SmartEscrow.CallOpts)
+}
+
+// Released is a free data retrieval call binding the contract method 0x96132521.
+//
+// Solidity: function released() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCaller) Released(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "released")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Released is a free data retrieval call binding the contract method 0x96132521.
+//
+// Solidity: function released() view returns(uint256)
+func (_SmartEscrow *SmartEscrowSession) Released() (*big.Int, error) {
+	return _SmartEscrow.Contract.Released(&_SmartEscrow.CallOpts)
+}
+
+// Released is a free data retrieval call binding the contract method 0x96132521.
+//
+// Solidity: function released() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCallerSession) Released() (*big.Int, error) {
+	return _SmartEscrow.Contract.Released(&_SmartEscrow.CallOpts)
+}
+
+// Start is a free data retrieval call binding the contract method 0xbe9a6555.
+//
+// Solidity: function start() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCaller) Start(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "start")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Start is a free data retrieval call binding the contract method 0xbe9a6555.
+//
+// Solidity: function start() view returns(uint256)
+func (_SmartEscrow *SmartEscrowSession) Start() (*big.Int, error) {
+	return _SmartEscrow.Contract.Start(&_SmartEscrow.CallOpts)
+}
+
+// Start is a free data retrieval call binding the contract method 0xbe9a6555.
+//
+// Solidity: function start() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCallerSession) Start() (*big.Int, error) {
+	return _SmartEscrow.Contract.Start(&_SmartEscrow.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_SmartEscrow *SmartEscrowCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_SmartEscrow *SmartEscrowSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _SmartEscrow.Contract.SupportsInterface(&_SmartEscrow.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_SmartEscrow *SmartEscrowCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _SmartEscrow.Contract.SupportsInterface(&_SmartEscrow.CallOpts, interfaceId)
+}
+
+// VestedAmount is a free data retrieval call binding the contract method 0x1bfce853.
+//
+// Solidity: function vestedAmount(uint256 _timestamp) view returns(uint256)
+func (_SmartEscrow *SmartEscrowCaller) VestedAmount(opts *bind.CallOpts, _timestamp *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "vestedAmount", _timestamp)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VestedAmount is a free data retrieval call binding the contract method 0x1bfce853.
+//
+// Solidity: function vestedAmount(uint256 _timestamp) view returns(uint256)
+func (_SmartEscrow *SmartEscrowSession) VestedAmount(_timestamp *big.Int) (*big.Int, error) {
+	return _SmartEscrow.Contract.VestedAmount(&_SmartEscrow.CallOpts, _timestamp)
+}
+
+// VestedAmount is a free data retrieval call binding the contract method 0x1bfce853.
+//
+// Solidity: function vestedAmount(uint256 _timestamp) view returns(uint256)
+func (_SmartEscrow *SmartEscrowCallerSession) VestedAmount(_timestamp *big.Int) (*big.Int, error) {
+	return _SmartEscrow.Contract.VestedAmount(&_SmartEscrow.CallOpts, _timestamp)
+}
+
+// VestingEventTokens is a free data retrieval call binding the contract method 0x677caf81.
+//
+// Solidity: function vestingEventTokens() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCaller) VestingEventTokens(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "vestingEventTokens")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VestingEventTokens is a free data retrieval call binding the contract method 0x677caf81.
+//
+// Solidity: function vestingEventTokens() view returns(uint256)
+func (_SmartEscrow *SmartEscrowSession) VestingEventTokens() (*big.Int, error) {
+	return _SmartEscrow.Contract.VestingEventTokens(&_SmartEscrow.CallOpts)
+}
+
+// VestingEventTokens is a free data retrieval call binding the contract method 0x677caf81.
+//
+// Solidity: function vestingEventTokens() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCallerSession) VestingEventTokens() (*big.Int, error) {
+	return _SmartEscrow.Contract.VestingEventTokens(&_SmartEscrow.CallOpts)
+}
+
+// VestingPeriod is a free data retrieval call binding the contract method 0x7313ee5a.
+//
+// Solidity: function vestingPeriod() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCaller) VestingPeriod(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SmartEscrow.contract.Call(opts, &out, "vestingPeriod")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VestingPeriod is a free data retrieval call binding the contract method 0x7313ee5a.
+//
+// Solidity: function vestingPeriod() view returns(uint256)
+func (_SmartEscrow *SmartEscrowSession) VestingPeriod() (*big.Int, error) {
+	return _SmartEscrow.Contract.VestingPeriod(&_SmartEscrow.CallOpts)
+}
+
+// VestingPeriod is a free data retrieval call binding the contract method 0x7313ee5a.
+//
+// Solidity: function vestingPeriod() view returns(uint256)
+func (_SmartEscrow *SmartEscrowCallerSession) VestingPeriod() (*big.Int, error) {
+	return _SmartEscrow.Contract.VestingPeriod(&_SmartEscrow.CallOpts)
+}
+
+// AcceptDefaultAdminTransfer is a paid mutator transaction binding the contract method 0xcefc1429.
+//
+// Solidity: function acceptDefaultAdminTransfer() returns()
+func (_SmartEscrow *SmartEscrowTransactor) AcceptDefaultAdminTransfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "acceptDefaultAdminTransfer")
+}
+
+// AcceptDefaultAdminTransfer is a paid mutator transaction binding the contract method 0xcefc1429.
+//
+// Solidity: function acceptDefaultAdminTransfer() returns()
+func (_SmartEscrow *SmartEscrowSession) AcceptDefaultAdminTransfer() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.AcceptDefaultAdminTransfer(&_SmartEscrow.TransactOpts)
+}
+
+// AcceptDefaultAdminTransfer is a paid mutator transaction binding the contract method 0xcefc1429.
+//
+// Solidity: function acceptDefaultAdminTransfer() returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) AcceptDefaultAdminTransfer() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.AcceptDefaultAdminTransfer(&_SmartEscrow.TransactOpts)
+}
+
+// BeginDefaultAdminTransfer is a paid mutator transaction binding the contract method 0x634e93da.
+//
+// Solidity: function beginDefaultAdminTransfer(address newAdmin) returns()
+func (_SmartEscrow *SmartEscrowTransactor) BeginDefaultAdminTransfer(opts *bind.TransactOpts, newAdmin common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "beginDefaultAdminTransfer", newAdmin)
+}
+
+// BeginDefaultAdminTransfer is a paid mutator transaction binding the contract method 0x634e93da.
+//
+// Solidity: function beginDefaultAdminTransfer(address newAdmin) returns()
+func (_SmartEscrow *SmartEscrowSession) BeginDefaultAdminTransfer(newAdmin common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.BeginDefaultAdminTransfer(&_SmartEscrow.TransactOpts, newAdmin)
+}
+
+// BeginDefaultAdminTransfer is a paid mutator transaction binding the contract method 0x634e93da.
+//
+// Solidity: function beginDefaultAdminTransfer(address newAdmin) returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) BeginDefaultAdminTransfer(newAdmin common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.BeginDefaultAdminTransfer(&_SmartEscrow.TransactOpts, newAdmin)
+}
+
+// CancelDefaultAdminTransfer is a paid mutator transaction binding the contract method 0xd602b9fd.
+//
+// Solidity: function cancelDefaultAdminTransfer() returns()
+func (_SmartEscrow *SmartEscrowTransactor) CancelDefaultAdminTransfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "cancelDefaultAdminTransfer")
+}
+
+// CancelDefaultAdminTransfer is a paid mutator transaction binding the contract method 0xd602b9fd.
+//
+// Solidity: function cancelDefaultAdminTransfer() returns()
+func (_SmartEscrow *SmartEscrowSession) CancelDefaultAdminTransfer() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.CancelDefaultAdminTransfer(&_SmartEscrow.TransactOpts)
+}
+
+// CancelDefaultAdminTransfer is a paid mutator transaction binding the contract method 0xd602b9fd.
+//
+// Solidity: function cancelDefaultAdminTransfer() returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) CancelDefaultAdminTransfer() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.CancelDefaultAdminTransfer(&_SmartEscrow.TransactOpts)
+}
+
+// ChangeDefaultAdminDelay is a paid mutator transaction binding the contract method 0x649a5ec7.
+//
+// Solidity: function changeDefaultAdminDelay(uint48 newDelay) returns()
+func (_SmartEscrow *SmartEscrowTransactor) ChangeDefaultAdminDelay(opts *bind.TransactOpts, newDelay *big.Int) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "changeDefaultAdminDelay", newDelay)
+}
+
+// ChangeDefaultAdminDelay is a paid mutator transaction binding the contract method 0x649a5ec7.
+//
+// Solidity: function changeDefaultAdminDelay(uint48 newDelay) returns()
+func (_SmartEscrow *SmartEscrowSession) ChangeDefaultAdminDelay(newDelay *big.Int) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.ChangeDefaultAdminDelay(&_SmartEscrow.TransactOpts, newDelay)
+}
+
+// ChangeDefaultAdminDelay is a paid mutator transaction binding the contract method 0x649a5ec7.
+//
+// Solidity: function changeDefaultAdminDelay(uint48 newDelay) returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) ChangeDefaultAdminDelay(newDelay *big.Int) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.ChangeDefaultAdminDelay(&_SmartEscrow.TransactOpts, newDelay)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_SmartEscrow *SmartEscrowTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_SmartEscrow *SmartEscrowSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.GrantRole(&_SmartEscrow.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.GrantRole(&_SmartEscrow.TransactOpts, role, account)
+}
+
+// Release is a paid mutator transaction binding the contract method 0x86d1a69f.
+//
+// Solidity: function release() returns()
+func (_SmartEscrow *SmartEscrowTransactor) Release(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "release")
+}
+
+// Release is a paid mutator transaction binding the contract method 0x86d1a69f.
+//
+// Solidity: function release() returns()
+func (_SmartEscrow *SmartEscrowSession) Release() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.Release(&_SmartEscrow.TransactOpts)
+}
+
+// Release is a paid mutator transaction binding the contract method 0x86d1a69f.
+//
+// Solidity: function release() returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) Release() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.Release(&_SmartEscrow.TransactOpts)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_SmartEscrow *SmartEscrowTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "renounceRole", role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_SmartEscrow *SmartEscrowSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.RenounceRole(&_SmartEscrow.TransactOpts, role, account)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address account) returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) RenounceRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.RenounceRole(&_SmartEscrow.TransactOpts, role, account)
+}
+
+// Resume is a paid mutator transaction binding the contract method 0x046f7da2.
+//
+// Solidity: function resume() returns()
+func (_SmartEscrow *SmartEscrowTransactor) Resume(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "resume")
+}
+
+// Resume is a paid mutator transaction binding the contract method 0x046f7da2.
+//
+// Solidity: function resume() returns()
+func (_SmartEscrow *SmartEscrowSession) Resume() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.Resume(&_SmartEscrow.TransactOpts)
+}
+
+// Resume is a paid mutator transaction binding the contract method 0x046f7da2.
+//
+// Solidity: function resume() returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) Resume() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.Resume(&_SmartEscrow.TransactOpts)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_SmartEscrow *SmartEscrowTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_SmartEscrow *SmartEscrowSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.RevokeRole(&_SmartEscrow.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.RevokeRole(&_SmartEscrow.TransactOpts, role, account)
+}
+
+// RollbackDefaultAdminDelay is a paid mutator transaction binding the contract method 0x0aa6220b.
+//
+// Solidity: function rollbackDefaultAdminDelay() returns()
+func (_SmartEscrow *SmartEscrowTransactor) RollbackDefaultAdminDelay(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "rollbackDefaultAdminDelay")
+}
+
+// RollbackDefaultAdminDelay is a paid mutator transaction binding the contract method 0x0aa6220b.
+//
+// Solidity: function rollbackDefaultAdminDelay() returns()
+func (_SmartEscrow *SmartEscrowSession) RollbackDefaultAdminDelay() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.RollbackDefaultAdminDelay(&_SmartEscrow.TransactOpts)
+}
+
+// RollbackDefaultAdminDelay is a paid mutator transaction binding the contract method 0x0aa6220b.
+//
+// Solidity: function rollbackDefaultAdminDelay() returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) RollbackDefaultAdminDelay() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.RollbackDefaultAdminDelay(&_SmartEscrow.TransactOpts)
+}
+
+// Terminate is a paid mutator transaction binding the contract method 0x0c08bf88.
+//
+// Solidity: function terminate() returns()
+func (_SmartEscrow *SmartEscrowTransactor) Terminate(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "terminate")
+}
+
+// Terminate is a paid mutator transaction binding the contract method 0x0c08bf88.
+//
+// Solidity: function terminate() returns()
+func (_SmartEscrow *SmartEscrowSession) Terminate() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.Terminate(&_SmartEscrow.TransactOpts)
+}
+
+// Terminate is a paid mutator transaction binding the contract method 0x0c08bf88.
+//
+// Solidity: function terminate() returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) Terminate() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.Terminate(&_SmartEscrow.TransactOpts)
+}
+
+// UpdateBenefactor is a paid mutator transaction binding the contract method 0x50ad2555.
+//
+// Solidity: function updateBenefactor(address _newBenefactor) returns()
+func (_SmartEscrow *SmartEscrowTransactor) UpdateBenefactor(opts *bind.TransactOpts, _newBenefactor common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "updateBenefactor", _newBenefactor)
+}
+
+// UpdateBenefactor is a paid mutator transaction binding the contract method 0x50ad2555.
+//
+// Solidity: function updateBenefactor(address _newBenefactor) returns()
+func (_SmartEscrow *SmartEscrowSession) UpdateBenefactor(_newBenefactor common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.UpdateBenefactor(&_SmartEscrow.TransactOpts, _newBenefactor)
+}
+
+// UpdateBenefactor is a paid mutator transaction binding the contract method 0x50ad2555.
+//
+// Solidity: function updateBenefactor(address _newBenefactor) returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) UpdateBenefactor(_newBenefactor common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.UpdateBenefactor(&_SmartEscrow.TransactOpts, _newBenefactor)
+}
+
+// UpdateBeneficiary is a paid mutator transaction binding the contract method 0x0aaffd2a.
+//
+// Solidity: function updateBeneficiary(address _newBeneficiary) returns()
+func (_SmartEscrow *SmartEscrowTransactor) UpdateBeneficiary(opts *bind.TransactOpts, _newBeneficiary common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "updateBeneficiary", _newBeneficiary)
+}
+
+// UpdateBeneficiary is a paid mutator transaction binding the contract method 0x0aaffd2a.
+//
+// Solidity: function updateBeneficiary(address _newBeneficiary) returns()
+func (_SmartEscrow *SmartEscrowSession) UpdateBeneficiary(_newBeneficiary common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.UpdateBeneficiary(&_SmartEscrow.TransactOpts, _newBeneficiary)
+}
+
+// UpdateBeneficiary is a paid mutator transaction binding the contract method 0x0aaffd2a.
+//
+// Solidity: function updateBeneficiary(address _newBeneficiary) returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) UpdateBeneficiary(_newBeneficiary common.Address) (*types.Transaction, error) {
+	return _SmartEscrow.Contract.UpdateBeneficiary(&_SmartEscrow.TransactOpts, _newBeneficiary)
+}
+
+// WithdrawUnvestedTokens is a paid mutator transaction binding the contract method 0x2806e3d6.
+//
+// Solidity: function withdrawUnvestedTokens() returns()
+func (_SmartEscrow *SmartEscrowTransactor) WithdrawUnvestedTokens(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SmartEscrow.contract.Transact(opts, "withdrawUnvestedTokens")
+}
+
+// WithdrawUnvestedTokens is a paid mutator transaction binding the contract method 0x2806e3d6.
+//
+// Solidity: function withdrawUnvestedTokens() returns()
+func (_SmartEscrow *SmartEscrowSession) WithdrawUnvestedTokens() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.WithdrawUnvestedTokens(&_SmartEscrow.TransactOpts)
+}
+
+// WithdrawUnvestedTokens is a paid mutator transaction binding the contract method 0x2806e3d6.
+//
+// Solidity: function withdrawUnvestedTokens() returns()
+func (_SmartEscrow *SmartEscrowTransactorSession) WithdrawUnvestedTokens() (*types.Transaction, error) {
+	return _SmartEscrow.Contract.WithdrawUnvestedTokens(&_SmartEscrow.TransactOpts)
+}
+
+// SmartEscrowBenefactorUpdatedIterator is returned from FilterBenefactorUpdated and is used to iterate over the raw logs and unpacked data for BenefactorUpdated events raised by the SmartEscrow contract.
+type SmartEscrowBenefactorUpdatedIterator struct {
+	Event *SmartEscrowBenefactorUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowBenefactorUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowBenefactorUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowBenefactorUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowBenefactorUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowBenefactorUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowBenefactorUpdated represents a BenefactorUpdated event raised by the SmartEscrow contract.
+type SmartEscrowBenefactorUpdated struct {
+	OldBenefactor common.Address
+	NewBenefactor common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterBenefactorUpdated is a free log retrieval operation binding the contract event 0xd487b35f979307e581e6e2d4b6aa87dbddf2f124cef02b50e3792f4c31c76c7a.
+//
+// Solidity: event BenefactorUpdated(address indexed oldBenefactor, address indexed newBenefactor)
+func (_SmartEscrow *SmartEscrowFilterer) FilterBenefactorUpdated(opts *bind.FilterOpts, oldBenefactor []common.Address, newBenefactor []common.Address) (*SmartEscrowBenefactorUpdatedIterator, error) {
+
+	var oldBenefactorRule []interface{}
+	for _, oldBenefactorItem := range oldBenefactor {
+		oldBenefactorRule = append(oldBenefactorRule, oldBenefactorItem)
+	}
+	var newBenefactorRule []interface{}
+	for _, newBenefactorItem := range newBenefactor {
+		newBenefactorRule = append(newBenefactorRule, newBenefactorItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "BenefactorUpdated", oldBenefactorRule, newBenefactorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowBenefactorUpdatedIterator{contract: _SmartEscrow.contract, event: "BenefactorUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchBenefactorUpdated is a free log subscription operation binding the contract event 0xd487b35f979307e581e6e2d4b6aa87dbddf2f124cef02b50e3792f4c31c76c7a.
+//
+// Solidity: event BenefactorUpdated(address indexed oldBenefactor, address indexed newBenefactor)
+func (_SmartEscrow *SmartEscrowFilterer) WatchBenefactorUpdated(opts *bind.WatchOpts, sink chan<- *SmartEscrowBenefactorUpdated, oldBenefactor []common.Address, newBenefactor []common.Address) (event.Subscription, error) {
+
+	var oldBenefactorRule []interface{}
+	for _, oldBenefactorItem := range oldBenefactor {
+		oldBenefactorRule = append(oldBenefactorRule, oldBenefactorItem)
+	}
+	var newBenefactorRule []interface{}
+	for _, newBenefactorItem := range newBenefactor {
+		newBenefactorRule = append(newBenefactorRule, newBenefactorItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "BenefactorUpdated", oldBenefactorRule, newBenefactorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowBenefactorUpdated)
+				if err := _SmartEscrow.contract.UnpackLog(event, "BenefactorUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBenefactorUpdated is a log parse operation binding the contract event 0xd487b35f979307e581e6e2d4b6aa87dbddf2f124cef02b50e3792f4c31c76c7a.
+//
+// Solidity: event BenefactorUpdated(address indexed oldBenefactor, address indexed newBenefactor)
+func (_SmartEscrow *SmartEscrowFilterer) ParseBenefactorUpdated(log types.Log) (*SmartEscrowBenefactorUpdated, error) {
+	event := new(SmartEscrowBenefactorUpdated)
+	if err := _SmartEscrow.contract.UnpackLog(event, "BenefactorUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowBeneficiaryUpdatedIterator is returned from FilterBeneficiaryUpdated and is used to iterate over the raw logs and unpacked data for BeneficiaryUpdated events raised by the SmartEscrow contract.
+type SmartEscrowBeneficiaryUpdatedIterator struct {
+	Event *SmartEscrowBeneficiaryUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowBeneficiaryUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowBeneficiaryUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowBeneficiaryUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowBeneficiaryUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowBeneficiaryUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowBeneficiaryUpdated represents a BeneficiaryUpdated event raised by the SmartEscrow contract.
+type SmartEscrowBeneficiaryUpdated struct {
+	OldBeneficiary common.Address
+	NewBeneficiary common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeneficiaryUpdated is a free log retrieval operation binding the contract event 0xe72eaf6addaa195f3c83095031dd08f3a96808dcf047babed1fe4e4f69d6c622.
+//
+// Solidity: event BeneficiaryUpdated(address indexed oldBeneficiary, address indexed newBeneficiary)
+func (_SmartEscrow *SmartEscrowFilterer) FilterBeneficiaryUpdated(opts *bind.FilterOpts, oldBeneficiary []common.Address, newBeneficiary []common.Address) (*SmartEscrowBeneficiaryUpdatedIterator, error) {
+
+	var oldBeneficiaryRule []interface{}
+	for _, oldBeneficiaryItem := range oldBeneficiary {
+		oldBeneficiaryRule = append(oldBeneficiaryRule, oldBeneficiaryItem)
+	}
+	var newBeneficiaryRule []interface{}
+	for _, newBeneficiaryItem := range newBeneficiary {
+		newBeneficiaryRule = append(newBeneficiaryRule, newBeneficiaryItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "BeneficiaryUpdated", oldBeneficiaryRule, newBeneficiaryRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowBeneficiaryUpdatedIterator{contract: _SmartEscrow.contract, event: "BeneficiaryUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchBeneficiaryUpdated is a free log subscription operation binding the contract event 0xe72eaf6addaa195f3c83095031dd08f3a96808dcf047babed1fe4e4f69d6c622.
+//
+// Solidity: event BeneficiaryUpdated(address indexed oldBeneficiary, address indexed newBeneficiary)
+func (_SmartEscrow *SmartEscrowFilterer) WatchBeneficiaryUpdated(opts *bind.WatchOpts, sink chan<- *SmartEscrowBeneficiaryUpdated, oldBeneficiary []common.Address, newBeneficiary []common.Address) (event.Subscription, error) {
+
+	var oldBeneficiaryRule []interface{}
+	for _, oldBeneficiaryItem := range oldBeneficiary {
+		oldBeneficiaryRule = append(oldBeneficiaryRule, oldBeneficiaryItem)
+	}
+	var newBeneficiaryRule []interface{}
+	for _, newBeneficiaryItem := range newBeneficiary {
+		newBeneficiaryRule = append(newBeneficiaryRule, newBeneficiaryItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "BeneficiaryUpdated", oldBeneficiaryRule, newBeneficiaryRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowBeneficiaryUpdated)
+				if err := _SmartEscrow.contract.UnpackLog(event, "BeneficiaryUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeneficiaryUpdated is a log parse operation binding the contract event 0xe72eaf6addaa195f3c83095031dd08f3a96808dcf047babed1fe4e4f69d6c622.
+//
+// Solidity: event BeneficiaryUpdated(address indexed oldBeneficiary, address indexed newBeneficiary)
+func (_SmartEscrow *SmartEscrowFilterer) ParseBeneficiaryUpdated(log types.Log) (*SmartEscrowBeneficiaryUpdated, error) {
+	event := new(SmartEscrowBeneficiaryUpdated)
+	if err := _SmartEscrow.contract.UnpackLog(event, "BeneficiaryUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowContractResumedIterator is returned from FilterContractResumed and is used to iterate over the raw logs and unpacked data for ContractResumed events raised by the SmartEscrow contract.
+type SmartEscrowContractResumedIterator struct {
+	Event *SmartEscrowContractResumed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowContractResumedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowContractResumed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowContractResumed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowContractResumedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowContractResumedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowContractResumed represents a ContractResumed event raised by the SmartEscrow contract.
+type SmartEscrowContractResumed struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterContractResumed is a free log retrieval operation binding the contract event 0xab5f6dacf93a267a93a533de8a56370de8341bbd8102017307e7be375c3dda6a.
+//
+// Solidity: event ContractResumed()
+func (_SmartEscrow *SmartEscrowFilterer) FilterContractResumed(opts *bind.FilterOpts) (*SmartEscrowContractResumedIterator, error) {
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "ContractResumed")
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowContractResumedIterator{contract: _SmartEscrow.contract, event: "ContractResumed", logs: logs, sub: sub}, nil
+}
+
+// WatchContractResumed is a free log subscription operation binding the contract event 0xab5f6dacf93a267a93a533de8a56370de8341bbd8102017307e7be375c3dda6a.
+//
+// Solidity: event ContractResumed()
+func (_SmartEscrow *SmartEscrowFilterer) WatchContractResumed(opts *bind.WatchOpts, sink chan<- *SmartEscrowContractResumed) (event.Subscription, error) {
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "ContractResumed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowContractResumed)
+				if err := _SmartEscrow.contract.UnpackLog(event, "ContractResumed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContractResumed is a log parse operation binding the contract event 0xab5f6dacf93a267a93a533de8a56370de8341bbd8102017307e7be375c3dda6a.
+//
+// Solidity: event ContractResumed()
+func (_SmartEscrow *SmartEscrowFilterer) ParseContractResumed(log types.Log) (*SmartEscrowContractResumed, error) {
+	event := new(SmartEscrowContractResumed)
+	if err := _SmartEscrow.contract.UnpackLog(event, "ContractResumed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowContractTerminatedIterator is returned from FilterContractTerminated and is used to iterate over the raw logs and unpacked data for ContractTerminated events raised by the SmartEscrow contract.
+type SmartEscrowContractTerminatedIterator struct {
+	Event *SmartEscrowContractTerminated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowContractTerminatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowContractTerminated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowContractTerminated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowContractTerminatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowContractTerminatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowContractTerminated represents a ContractTerminated event raised by the SmartEscrow contract.
+type SmartEscrowContractTerminated struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterContractTerminated is a free log retrieval operation binding the contract event 0x6d0d90585834980bd0e5603341ff50b06349c11e0bf9241d03f6d065f12a262b.
+//
+// Solidity: event ContractTerminated()
+func (_SmartEscrow *SmartEscrowFilterer) FilterContractTerminated(opts *bind.FilterOpts) (*SmartEscrowContractTerminatedIterator, error) {
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "ContractTerminated")
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowContractTerminatedIterator{contract: _SmartEscrow.contract, event: "ContractTerminated", logs: logs, sub: sub}, nil
+}
+
+// WatchContractTerminated is a free log subscription operation binding the contract event 0x6d0d90585834980bd0e5603341ff50b06349c11e0bf9241d03f6d065f12a262b.
+//
+// Solidity: event ContractTerminated()
+func (_SmartEscrow *SmartEscrowFilterer) WatchContractTerminated(opts *bind.WatchOpts, sink chan<- *SmartEscrowContractTerminated) (event.Subscription, error) {
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "ContractTerminated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowContractTerminated)
+				if err := _SmartEscrow.contract.UnpackLog(event, "ContractTerminated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContractTerminated is a log parse operation binding the contract event 0x6d0d90585834980bd0e5603341ff50b06349c11e0bf9241d03f6d065f12a262b.
+//
+// Solidity: event ContractTerminated()
+func (_SmartEscrow *SmartEscrowFilterer) ParseContractTerminated(log types.Log) (*SmartEscrowContractTerminated, error) {
+	event := new(SmartEscrowContractTerminated)
+	if err := _SmartEscrow.contract.UnpackLog(event, "ContractTerminated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowDefaultAdminDelayChangeCanceledIterator is returned from FilterDefaultAdminDelayChangeCanceled and is used to iterate over the raw logs and unpacked data for DefaultAdminDelayChangeCanceled events raised by the SmartEscrow contract.
+type SmartEscrowDefaultAdminDelayChangeCanceledIterator struct {
+	Event *SmartEscrowDefaultAdminDelayChangeCanceled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowDefaultAdminDelayChangeCanceledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowDefaultAdminDelayChangeCanceled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowDefaultAdminDelayChangeCanceled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowDefaultAdminDelayChangeCanceledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowDefaultAdminDelayChangeCanceledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowDefaultAdminDelayChangeCanceled represents a DefaultAdminDelayChangeCanceled event raised by the SmartEscrow contract.
+type SmartEscrowDefaultAdminDelayChangeCanceled struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterDefaultAdminDelayChangeCanceled is a free log retrieval operation binding the contract event 0x2b1fa2edafe6f7b9e97c1a9e0c3660e645beb2dcaa2d45bdbf9beaf5472e1ec5.
+//
+// Solidity: event DefaultAdminDelayChangeCanceled()
+func (_SmartEscrow *SmartEscrowFilterer) FilterDefaultAdminDelayChangeCanceled(opts *bind.FilterOpts) (*SmartEscrowDefaultAdminDelayChangeCanceledIterator, error) {
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "DefaultAdminDelayChangeCanceled")
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowDefaultAdminDelayChangeCanceledIterator{contract: _SmartEscrow.contract, event: "DefaultAdminDelayChangeCanceled", logs: logs, sub: sub}, nil
+}
+
+// WatchDefaultAdminDelayChangeCanceled is a free log subscription operation binding the contract event 0x2b1fa2edafe6f7b9e97c1a9e0c3660e645beb2dcaa2d45bdbf9beaf5472e1ec5.
+//
+// Solidity: event DefaultAdminDelayChangeCanceled()
+func (_SmartEscrow *SmartEscrowFilterer) WatchDefaultAdminDelayChangeCanceled(opts *bind.WatchOpts, sink chan<- *SmartEscrowDefaultAdminDelayChangeCanceled) (event.Subscription, error) {
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "DefaultAdminDelayChangeCanceled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowDefaultAdminDelayChangeCanceled)
+				if err := _SmartEscrow.contract.UnpackLog(event, "DefaultAdminDelayChangeCanceled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDefaultAdminDelayChangeCanceled is a log parse operation binding the contract event 0x2b1fa2edafe6f7b9e97c1a9e0c3660e645beb2dcaa2d45bdbf9beaf5472e1ec5.
+//
+// Solidity: event DefaultAdminDelayChangeCanceled()
+func (_SmartEscrow *SmartEscrowFilterer) ParseDefaultAdminDelayChangeCanceled(log types.Log) (*SmartEscrowDefaultAdminDelayChangeCanceled, error) {
+	event := new(SmartEscrowDefaultAdminDelayChangeCanceled)
+	if err := _SmartEscrow.contract.UnpackLog(event, "DefaultAdminDelayChangeCanceled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowDefaultAdminDelayChangeScheduledIterator is returned from FilterDefaultAdminDelayChangeScheduled and is used to iterate over the raw logs and unpacked data for DefaultAdminDelayChangeScheduled events raised by the SmartEscrow contract.
+type SmartEscrowDefaultAdminDelayChangeScheduledIterator struct {
+	Event *SmartEscrowDefaultAdminDelayChangeScheduled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowDefaultAdminDelayChangeScheduledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowDefaultAdminDelayChangeScheduled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowDefaultAdminDelayChangeScheduled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowDefaultAdminDelayChangeScheduledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowDefaultAdminDelayChangeScheduledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowDefaultAdminDelayChangeScheduled represents a DefaultAdminDelayChangeScheduled event raised by the SmartEscrow contract.
+type SmartEscrowDefaultAdminDelayChangeScheduled struct {
+	NewDelay       *big.Int
+	EffectSchedule *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterDefaultAdminDelayChangeScheduled is a free log retrieval operation binding the contract event 0xf1038c18cf84a56e432fdbfaf746924b7ea511dfe03a6506a0ceba4888788d9b.
+//
+// Solidity: event DefaultAdminDelayChangeScheduled(uint48 newDelay, uint48 effectSchedule)
+func (_SmartEscrow *SmartEscrowFilterer) FilterDefaultAdminDelayChangeScheduled(opts *bind.FilterOpts) (*SmartEscrowDefaultAdminDelayChangeScheduledIterator, error) {
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "DefaultAdminDelayChangeScheduled")
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowDefaultAdminDelayChangeScheduledIterator{contract: _SmartEscrow.contract, event: "DefaultAdminDelayChangeScheduled", logs: logs, sub: sub}, nil
+}
+
+// WatchDefaultAdminDelayChangeScheduled is a free log subscription operation binding the contract event 0xf1038c18cf84a56e432fdbfaf746924b7ea511dfe03a6506a0ceba4888788d9b.
+//
+// Solidity: event DefaultAdminDelayChangeScheduled(uint48 newDelay, uint48 effectSchedule)
+func (_SmartEscrow *SmartEscrowFilterer) WatchDefaultAdminDelayChangeScheduled(opts *bind.WatchOpts, sink chan<- *SmartEscrowDefaultAdminDelayChangeScheduled) (event.Subscription, error) {
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "DefaultAdminDelayChangeScheduled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowDefaultAdminDelayChangeScheduled)
+				if err := _SmartEscrow.contract.UnpackLog(event, "DefaultAdminDelayChangeScheduled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDefaultAdminDelayChangeScheduled is a log parse operation binding the contract event 0xf1038c18cf84a56e432fdbfaf746924b7ea511dfe03a6506a0ceba4888788d9b.
+//
+// Solidity: event DefaultAdminDelayChangeScheduled(uint48 newDelay, uint48 effectSchedule)
+func (_SmartEscrow *SmartEscrowFilterer) ParseDefaultAdminDelayChangeScheduled(log types.Log) (*SmartEscrowDefaultAdminDelayChangeScheduled, error) {
+	event := new(SmartEscrowDefaultAdminDelayChangeScheduled)
+	if err := _SmartEscrow.contract.UnpackLog(event, "DefaultAdminDelayChangeScheduled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowDefaultAdminTransferCanceledIterator is returned from FilterDefaultAdminTransferCanceled and is used to iterate over the raw logs and unpacked data for DefaultAdminTransferCanceled events raised by the SmartEscrow contract.
+type SmartEscrowDefaultAdminTransferCanceledIterator struct {
+	Event *SmartEscrowDefaultAdminTransferCanceled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowDefaultAdminTransferCanceledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowDefaultAdminTransferCanceled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowDefaultAdminTransferCanceled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowDefaultAdminTransferCanceledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowDefaultAdminTransferCanceledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowDefaultAdminTransferCanceled represents a DefaultAdminTransferCanceled event raised by the SmartEscrow contract.
+type SmartEscrowDefaultAdminTransferCanceled struct {
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterDefaultAdminTransferCanceled is a free log retrieval operation binding the contract event 0x8886ebfc4259abdbc16601dd8fb5678e54878f47b3c34836cfc51154a9605109.
+//
+// Solidity: event DefaultAdminTransferCanceled()
+func (_SmartEscrow *SmartEscrowFilterer) FilterDefaultAdminTransferCanceled(opts *bind.FilterOpts) (*SmartEscrowDefaultAdminTransferCanceledIterator, error) {
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "DefaultAdminTransferCanceled")
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowDefaultAdminTransferCanceledIterator{contract: _SmartEscrow.contract, event: "DefaultAdminTransferCanceled", logs: logs, sub: sub}, nil
+}
+
+// WatchDefaultAdminTransferCanceled is a free log subscription operation binding the contract event 0x8886ebfc4259abdbc16601dd8fb5678e54878f47b3c34836cfc51154a9605109.
+//
+// Solidity: event DefaultAdminTransferCanceled()
+func (_SmartEscrow *SmartEscrowFilterer) WatchDefaultAdminTransferCanceled(opts *bind.WatchOpts, sink chan<- *SmartEscrowDefaultAdminTransferCanceled) (event.Subscription, error) {
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "DefaultAdminTransferCanceled")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowDefaultAdminTransferCanceled)
+				if err := _SmartEscrow.contract.UnpackLog(event, "DefaultAdminTransferCanceled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDefaultAdminTransferCanceled is a log parse operation binding the contract event 0x8886ebfc4259abdbc16601dd8fb5678e54878f47b3c34836cfc51154a9605109.
+//
+// Solidity: event DefaultAdminTransferCanceled()
+func (_SmartEscrow *SmartEscrowFilterer) ParseDefaultAdminTransferCanceled(log types.Log) (*SmartEscrowDefaultAdminTransferCanceled, error) {
+	event := new(SmartEscrowDefaultAdminTransferCanceled)
+	if err := _SmartEscrow.contract.UnpackLog(event, "DefaultAdminTransferCanceled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowDefaultAdminTransferScheduledIterator is returned from FilterDefaultAdminTransferScheduled and is used to iterate over the raw logs and unpacked data for DefaultAdminTransferScheduled events raised by the SmartEscrow contract.
+type SmartEscrowDefaultAdminTransferScheduledIterator struct {
+	Event *SmartEscrowDefaultAdminTransferScheduled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowDefaultAdminTransferScheduledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowDefaultAdminTransferScheduled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowDefaultAdminTransferScheduled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowDefaultAdminTransferScheduledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowDefaultAdminTransferScheduledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowDefaultAdminTransferScheduled represents a DefaultAdminTransferScheduled event raised by the SmartEscrow contract.
+type SmartEscrowDefaultAdminTransferScheduled struct {
+	NewAdmin       common.Address
+	AcceptSchedule *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterDefaultAdminTransferScheduled is a free log retrieval operation binding the contract event 0x3377dc44241e779dd06afab5b788a35ca5f3b778836e2990bdb26a2a4b2e5ed6.
+//
+// Solidity: event DefaultAdminTransferScheduled(address indexed newAdmin, uint48 acceptSchedule)
+func (_SmartEscrow *SmartEscrowFilterer) FilterDefaultAdminTransferScheduled(opts *bind.FilterOpts, newAdmin []common.Address) (*SmartEscrowDefaultAdminTransferScheduledIterator, error) {
+
+	var newAdminRule []interface{}
+	for _, newAdminItem := range newAdmin {
+		newAdminRule = append(newAdminRule, newAdminItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "DefaultAdminTransferScheduled", newAdminRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowDefaultAdminTransferScheduledIterator{contract: _SmartEscrow.contract, event: "DefaultAdminTransferScheduled", logs: logs, sub: sub}, nil
+}
+
+// WatchDefaultAdminTransferScheduled is a free log subscription operation binding the contract event 0x3377dc44241e779dd06afab5b788a35ca5f3b778836e2990bdb26a2a4b2e5ed6.
+//
+// Solidity: event DefaultAdminTransferScheduled(address indexed newAdmin, uint48 acceptSchedule)
+func (_SmartEscrow *SmartEscrowFilterer) WatchDefaultAdminTransferScheduled(opts *bind.WatchOpts, sink chan<- *SmartEscrowDefaultAdminTransferScheduled, newAdmin []common.Address) (event.Subscription, error) {
+
+	var newAdminRule []interface{}
+	for _, newAdminItem := range newAdmin {
+		newAdminRule = append(newAdminRule, newAdminItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "DefaultAdminTransferScheduled", newAdminRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowDefaultAdminTransferScheduled)
+				if err := _SmartEscrow.contract.UnpackLog(event, "DefaultAdminTransferScheduled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDefaultAdminTransferScheduled is a log parse operation binding the contract event 0x3377dc44241e779dd06afab5b788a35ca5f3b778836e2990bdb26a2a4b2e5ed6.
+//
+// Solidity: event DefaultAdminTransferScheduled(address indexed newAdmin, uint48 acceptSchedule)
+func (_SmartEscrow *SmartEscrowFilterer) ParseDefaultAdminTransferScheduled(log types.Log) (*SmartEscrowDefaultAdminTransferScheduled, error) {
+	event := new(SmartEscrowDefaultAdminTransferScheduled)
+	if err := _SmartEscrow.contract.UnpackLog(event, "DefaultAdminTransferScheduled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the SmartEscrow contract.
+type SmartEscrowRoleAdminChangedIterator struct {
+	Event *SmartEscrowRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowRoleAdminChanged represents a RoleAdminChanged event raised by the SmartEscrow contract.
+type SmartEscrowRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_SmartEscrow *SmartEscrowFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*SmartEscrowRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowRoleAdminChangedIterator{contract: _SmartEscrow.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_SmartEscrow *SmartEscrowFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *SmartEscrowRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowRoleAdminChanged)
+				if err := _SmartEscrow.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_SmartEscrow *SmartEscrowFilterer) ParseRoleAdminChanged(log types.Log) (*SmartEscrowRoleAdminChanged, error) {
+	event := new(SmartEscrowRoleAdminChanged)
+	if err := _SmartEscrow.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the SmartEscrow contract.
+type SmartEscrowRoleGrantedIterator struct {
+	Event *SmartEscrowRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowRoleGranted represents a RoleGranted event raised by the SmartEscrow contract.
+type SmartEscrowRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SmartEscrow *SmartEscrowFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*SmartEscrowRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowRoleGrantedIterator{contract: _SmartEscrow.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SmartEscrow *SmartEscrowFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *SmartEscrowRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowRoleGranted)
+				if err := _SmartEscrow.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SmartEscrow *SmartEscrowFilterer) ParseRoleGranted(log types.Log) (*SmartEscrowRoleGranted, error) {
+	event := new(SmartEscrowRoleGranted)
+	if err := _SmartEscrow.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the SmartEscrow contract.
+type SmartEscrowRoleRevokedIterator struct {
+	Event *SmartEscrowRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowRoleRevoked represents a RoleRevoked event raised by the SmartEscrow contract.
+type SmartEscrowRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SmartEscrow *SmartEscrowFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*SmartEscrowRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowRoleRevokedIterator{contract: _SmartEscrow.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SmartEscrow *SmartEscrowFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *SmartEscrowRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowRoleRevoked)
+				if err := _SmartEscrow.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_SmartEscrow *SmartEscrowFilterer) ParseRoleRevoked(log types.Log) (*SmartEscrowRoleRevoked, error) {
+	event := new(SmartEscrowRoleRevoked)
+	if err := _SmartEscrow.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowTokensReleasedIterator is returned from FilterTokensReleased and is used to iterate over the raw logs and unpacked data for TokensReleased events raised by the SmartEscrow contract.
+type SmartEscrowTokensReleasedIterator struct {
+	Event *SmartEscrowTokensReleased // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowTokensReleasedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowTokensReleased)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowTokensReleased)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowTokensReleasedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowTokensReleasedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowTokensReleased represents a TokensReleased event raised by the SmartEscrow contract.
+type SmartEscrowTokensReleased struct {
+	Beneficiary common.Address
+	Amount      *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokensReleased is a free log retrieval operation binding the contract event 0xc7798891864187665ac6dd119286e44ec13f014527aeeb2b8eb3fd413df93179.
+//
+// Solidity: event TokensReleased(address indexed beneficiary, uint256 amount)
+func (_SmartEscrow *SmartEscrowFilterer) FilterTokensReleased(opts *bind.FilterOpts, beneficiary []common.Address) (*SmartEscrowTokensReleasedIterator, error) {
+
+	var beneficiaryRule []interface{}
+	for _, beneficiaryItem := range beneficiary {
+		beneficiaryRule = append(beneficiaryRule, beneficiaryItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "TokensReleased", beneficiaryRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowTokensReleasedIterator{contract: _SmartEscrow.contract, event: "TokensReleased", logs: logs, sub: sub}, nil
+}
+
+// WatchTokensReleased is a free log subscription operation binding the contract event 0xc7798891864187665ac6dd119286e44ec13f014527aeeb2b8eb3fd413df93179.
+//
+// Solidity: event TokensReleased(address indexed beneficiary, uint256 amount)
+func (_SmartEscrow *SmartEscrowFilterer) WatchTokensReleased(opts *bind.WatchOpts, sink chan<- *SmartEscrowTokensReleased, beneficiary []common.Address) (event.Subscription, error) {
+
+	var beneficiaryRule []interface{}
+	for _, beneficiaryItem := range beneficiary {
+		beneficiaryRule = append(beneficiaryRule, beneficiaryItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "TokensReleased", beneficiaryRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowTokensReleased)
+				if err := _SmartEscrow.contract.UnpackLog(event, "TokensReleased", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokensReleased is a log parse operation binding the contract event 0xc7798891864187665ac6dd119286e44ec13f014527aeeb2b8eb3fd413df93179.
+//
+// Solidity: event TokensReleased(address indexed beneficiary, uint256 amount)
+func (_SmartEscrow *SmartEscrowFilterer) ParseTokensReleased(log types.Log) (*SmartEscrowTokensReleased, error) {
+	event := new(SmartEscrowTokensReleased)
+	if err := _SmartEscrow.contract.UnpackLog(event, "TokensReleased", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// SmartEscrowTokensWithdrawnIterator is returned from FilterTokensWithdrawn and is used to iterate over the raw logs and unpacked data for TokensWithdrawn events raised by the SmartEscrow contract.
+type SmartEscrowTokensWithdrawnIterator struct {
+	Event *SmartEscrowTokensWithdrawn // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *SmartEscrowTokensWithdrawnIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(SmartEscrowTokensWithdrawn)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(SmartEscrowTokensWithdrawn)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *SmartEscrowTokensWithdrawnIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *SmartEscrowTokensWithdrawnIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// SmartEscrowTokensWithdrawn represents a TokensWithdrawn event raised by the SmartEscrow contract.
+type SmartEscrowTokensWithdrawn struct {
+	Benefactor common.Address
+	Amount     *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokensWithdrawn is a free log retrieval operation binding the contract event 0x6352c5382c4a4578e712449ca65e83cdb392d045dfcf1cad9615189db2da244b.
+//
+// Solidity: event TokensWithdrawn(address indexed benefactor, uint256 amount)
+func (_SmartEscrow *SmartEscrowFilterer) FilterTokensWithdrawn(opts *bind.FilterOpts, benefactor []common.Address) (*SmartEscrowTokensWithdrawnIterator, error) {
+
+	var benefactorRule []interface{}
+	for _, benefactorItem := range benefactor {
+		benefactorRule = append(benefactorRule, benefactorItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.FilterLogs(opts, "TokensWithdrawn", benefactorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &SmartEscrowTokensWithdrawnIterator{contract: _SmartEscrow.contract, event: "TokensWithdrawn", logs: logs, sub: sub}, nil
+}
+
+// WatchTokensWithdrawn is a free log subscription operation binding the contract event 0x6352c5382c4a4578e712449ca65e83cdb392d045dfcf1cad9615189db2da244b.
+//
+// Solidity: event TokensWithdrawn(address indexed benefactor, uint256 amount)
+func (_SmartEscrow *SmartEscrowFilterer) WatchTokensWithdrawn(opts *bind.WatchOpts, sink chan<- *SmartEscrowTokensWithdrawn, benefactor []common.Address) (event.Subscription, error) {
+
+	var benefactorRule []interface{}
+	for _, benefactorItem := range benefactor {
+		benefactorRule = append(benefactorRule, benefactorItem)
+	}
+
+	logs, sub, err := _SmartEscrow.contract.WatchLogs(opts, "TokensWithdrawn", benefactorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(SmartEscrowTokensWithdrawn)
+				if err := _SmartEscrow.contract.UnpackLog(event, "TokensWithdrawn", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokensWithdrawn is a log parse operation binding the contract event 0x6352c5382c4a4578e712449ca65e83cdb392d045dfcf1cad9615189db2da244b.
+//
+// Solidity: event TokensWithdrawn(address indexed benefactor, uint256 amount)
+func (_SmartEscrow *SmartEscrowFilterer) ParseTokensWithdrawn(log types.Log) (*SmartEscrowTokensWithdrawn, error) {
+	event := new(SmartEscrowTokensWithdrawn)
+	if err := _SmartEscrow.contract.UnpackLog(event, "TokensWithdrawn", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
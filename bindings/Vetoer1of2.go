@@ -0,0 +1,440 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Vetoer1of2MetaData contains all meta data concerning the Vetoer1of2 contract.
+var Vetoer1of2MetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"opSigner_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"otherSigner_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"initiator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"target\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"InitiatorCantBeZeroAddress\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OpSignerCantBeZeroAddress\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"OtherSignerCantBeZeroAddress\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"SenderIsNotWhitelistedSigner\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"TargetCantBeZeroAddress\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"caller\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"result\",\"type\":\"bytes\"}],\"name\":\"VetoCallExecuted\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"delayedVetoable\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"opSigner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"otherSigner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"veto\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// Vetoer1of2ABI is the input ABI used to generate the binding from.
+// Deprecated: Use Vetoer1of2MetaData.ABI instead.
+var Vetoer1of2ABI = Vetoer1of2MetaData.ABI
+
+// Vetoer1of2 is an auto generated Go binding around an Ethereum contract.
+type Vetoer1of2 struct {
+	Vetoer1of2Caller     // Read-only binding to the contract
+	Vetoer1of2Transactor // Write-only binding to the contract
+	Vetoer1of2Filterer   // Log filterer for contract events
+}
+
+// Vetoer1of2Caller is an auto generated read-only Go binding around an Ethereum contract.
+type Vetoer1of2Caller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Vetoer1of2Transactor is an auto generated write-only Go binding around an Ethereum contract.
+type Vetoer1of2Transactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Vetoer1of2Filterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Vetoer1of2Filterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Vetoer1of2Session is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Vetoer1of2Session struct {
+	Contract     *Vetoer1of2       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// Vetoer1of2CallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Vetoer1of2CallerSession struct {
+	Contract *Vetoer1of2Caller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// Vetoer1of2TransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Vetoer1of2TransactorSession struct {
+	Contract     *Vetoer1of2Transactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// Vetoer1of2Raw is an auto generated low-level Go binding around an Ethereum contract.
+type Vetoer1of2Raw struct {
+	Contract *Vetoer1of2 // Generic contract binding to access the raw methods on
+}
+
+// Vetoer1of2CallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Vetoer1of2CallerRaw struct {
+	Contract *Vetoer1of2Caller // Generic read-only contract binding to access the raw methods on
+}
+
+// Vetoer1of2TransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Vetoer1of2TransactorRaw struct {
+	Contract *Vetoer1of2Transactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVetoer1of2 creates a new instance of Vetoer1of2, bound to a specific deployed contract.
+func NewVetoer1of2(address common.Address, backend bind.ContractBackend) (*Vetoer1of2, error) {
+	contract, err := bindVetoer1of2(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Vetoer1of2{Vetoer1of2Caller: Vetoer1of2Caller{contract: contract}, Vetoer1of2Transactor: Vetoer1of2Transactor{contract: contract}, Vetoer1of2Filterer: Vetoer1of2Filterer{contract: contract}}, nil
+}
+
+// NewVetoer1of2Caller creates a new read-only instance of Vetoer1of2, bound to a specific deployed contract.
+func NewVetoer1of2Caller(address common.Address, caller bind.ContractCaller) (*Vetoer1of2Caller, error) {
+	contract, err := bindVetoer1of2(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Vetoer1of2Caller{contract: contract}, nil
+}
+
+// NewVetoer1of2Transactor creates a new write-only instance of Vetoer1of2, bound to a specific deployed contract.
+func NewVetoer1of2Transactor(address common.Address, transactor bind.ContractTransactor) (*Vetoer1of2Transactor, error) {
+	contract, err := bindVetoer1of2(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Vetoer1of2Transactor{contract: contract}, nil
+}
+
+// NewVetoer1of2Filterer creates a new log filterer instance of Vetoer1of2, bound to a specific deployed contract.
+func NewVetoer1of2Filterer(address common.Address, filterer bind.ContractFilterer) (*Vetoer1of2Filterer, error) {
+	contract, err := bindVetoer1of2(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Vetoer1of2Filterer{contract: contract}, nil
+}
+
+// bindVetoer1of2 binds a generic wrapper to an already deployed contract.
+func bindVetoer1of2(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Vetoer1of2MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Vetoer1of2 *Vetoer1of2Raw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Vetoer1of2.Contract.Vetoer1of2Caller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Vetoer1of2 *Vetoer1of2Raw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Vetoer1of2.Contract.Vetoer1of2Transactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Vetoer1of2 *Vetoer1of2Raw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Vetoer1of2.Contract.Vetoer1of2Transactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Vetoer1of2 *Vetoer1of2CallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Vetoer1of2.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Vetoer1of2 *Vetoer1of2TransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Vetoer1of2.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Vetoer1of2 *Vetoer1of2TransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Vetoer1of2.Contract.contract.Transact(opts, method, params...)
+}
+
+// DelayedVetoable is a free data retrieval call binding the contract method 0x97eb0c80.
+//
+// Solidity: function delayedVetoable() view returns(address)
+func (_Vetoer1of2 *Vetoer1of2Caller) DelayedVetoable(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Vetoer1of2.contract.Call(opts, &out, "delayedVetoable")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DelayedVetoable is a free data retrieval call binding the contract method 0x97eb0c80.
+//
+// Solidity: function delayedVetoable() view returns(address)
+func (_Vetoer1of2 *Vetoer1of2Session) DelayedVetoable() (common.Address, error) {
+	return _Vetoer1of2.Contract.DelayedVetoable(&_Vetoer1of2.CallOpts)
+}
+
+// DelayedVetoable is a free data retrieval call binding the contract method 0x97eb0c80.
+//
+// Solidity: function delayedVetoable() view returns(address)
+func (_Vetoer1of2 *Vetoer1of2CallerSession) DelayedVetoable() (common.Address, error) {
+	return _Vetoer1of2.Contract.DelayedVetoable(&_Vetoer1of2.CallOpts)
+}
+
+// OpSigner is a free data retrieval call binding the contract method 0xb73f79c3.
+//
+// Solidity: function opSigner() view returns(address)
+func (_Vetoer1of2 *Vetoer1of2Caller) OpSigner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Vetoer1of2.contract.Call(opts, &out, "opSigner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OpSigner is a free data retrieval call binding the contract method 0xb73f79c3.
+//
+// Solidity: function opSigner() view returns(address)
+func (_Vetoer1of2 *Vetoer1of2Session) OpSigner() (common.Address, error) {
+	return _Vetoer1of2.Contract.OpSigner(&_Vetoer1of2.CallOpts)
+}
+
+// OpSigner is a free data retrieval call binding the contract method 0xb73f79c3.
+//
+// Solidity: function opSigner() view returns(address)
+func (_Vetoer1of2 *Vetoer1of2CallerSession) OpSigner() (common.Address, error) {
+	return _Vetoer1of2.Contract.OpSigner(&_Vetoer1of2.CallOpts)
+}
+
+// OtherSigner is a free data retrieval call binding the contract method 0xa1bd2de0.
+//
+// Solidity: function otherSigner() view returns(address)
+func (_Vetoer1of2 *Vetoer1of2Caller) OtherSigner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Vetoer1of2.contract.Call(opts, &out, "otherSigner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OtherSigner is a free data retrieval call binding the contract method 0xa1bd2de0.
+//
+// Solidity: function otherSigner() view returns(address)
+func (_Vetoer1of2 *Vetoer1of2Session) OtherSigner() (common.Address, error) {
+	return _Vetoer1of2.Contract.OtherSigner(&_Vetoer1of2.CallOpts)
+}
+
+// OtherSigner is a free data retrieval call binding the contract method 0xa1bd2de0.
+//
+// Solidity: function otherSigner() view returns(address)
+func (_Vetoer1of2 *Vetoer1of2CallerSession) OtherSigner() (common.Address, error) {
+	return _Vetoer1of2.Contract.OtherSigner(&_Vetoer1of2.CallOpts)
+}
+
+// Veto is a paid mutator transaction binding the contract method 0xef9b78c6.
+//
+// Solidity: function veto() returns()
+func (_Vetoer1of2 *Vetoer1of2Transactor) Veto(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Vetoer1of2.contract.Transact(opts, "veto")
+}
+
+// Veto is a paid mutator transaction binding the contract method 0xef9b78c6.
+//
+// Solidity: function veto() returns()
+func (_Vetoer1of2 *Vetoer1of2Session) Veto() (*types.Transaction, error) {
+	return _Vetoer1of2.Contract.Veto(&_Vetoer1of2.TransactOpts)
+}
+
+// Veto is a paid mutator transaction binding the contract method 0xef9b78c6.
+//
+// Solidity: function veto() returns()
+func (_Vetoer1of2 *Vetoer1of2TransactorSession) Veto() (*types.Transaction, error) {
+	return _Vetoer1of2.Contract.Veto(&_Vetoer1of2.TransactOpts)
+}
+
+// Vetoer1of2VetoCallExecutedIterator is returned from FilterVetoCallExecuted and is used to iterate over the raw logs and unpacked data for VetoCallExecuted events raised by the Vetoer1of2 contract.
+type Vetoer1of2VetoCallExecutedIterator struct {
+	Event *Vetoer1of2VetoCallExecuted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *Vetoer1of2VetoCallExecutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(Vetoer1of2VetoCallExecuted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(Vetoer1of2VetoCallExecuted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *Vetoer1of2VetoCallExecutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *Vetoer1of2VetoCallExecutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// Vetoer1of2VetoCallExecuted represents a VetoCallExecuted event raised by the Vetoer1of2 contract.
+type Vetoer1of2VetoCallExecuted struct {
+	Caller common.Address
+	Result []byte
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterVetoCallExecuted is a free log retrieval operation binding the contract event 0xedd2998d49f78caca1adb253f5407aae3e5a3b5405df6c5f509d827a42bb0c00.
+//
+// Solidity: event VetoCallExecuted(address indexed caller, bytes result)
+func (_Vetoer1of2 *Vetoer1of2Filterer) FilterVetoCallExecuted(opts *bind.FilterOpts, caller []common.Address) (*Vetoer1of2VetoCallExecutedIterator, error) {
+
+	var callerRule []interface{}
+	for _, callerItem := range caller {
+		callerRule = append(callerRule, callerItem)
+	}
+
+	logs, sub, err := _Vetoer1of2.contract.FilterLogs(opts, "VetoCallExecuted", callerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &Vetoer1of2VetoCallExecutedIterator{contract: _Vetoer1of2.contract, event: "VetoCallExecuted", logs: logs, sub: sub}, nil
+}
+
+// WatchVetoCallExecuted is a free log subscription operation binding the contract event 0xedd2998d49f78caca1adb253f5407aae3e5a3b5405df6c5f509d827a42bb0c00.
+//
+// Solidity: event VetoCallExecuted(address indexed caller, bytes result)
+func (_Vetoer1of2 *Vetoer1of2Filterer) WatchVetoCallExecuted(opts *bind.WatchOpts, sink chan<- *Vetoer1of2VetoCallExecuted, caller []common.Address) (event.Subscription, error) {
+
+	var callerRule []interface{}
+	for _, callerItem := range caller {
+		callerRule = append(callerRule, callerItem)
+	}
+
+	logs, sub, err := _Vetoer1of2.contract.WatchLogs(opts, "VetoCallExecuted", callerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(Vetoer1of2VetoCallExecuted)
+				if err := _Vetoer1of2.contract.UnpackLog(event, "VetoCallExecuted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVetoCallExecuted is a log parse operation binding the contract event 0xedd2998d49f78caca1adb253f5407aae3e5a3b5405df6c5f509d827a42bb0c00.
+//
+// Solidity: event VetoCallExecuted(address indexed caller, bytes result)
+func (_Vetoer1of2 *Vetoer1of2Filterer) ParseVetoCallExecuted(log types.Log) (*Vetoer1of2VetoCallExecuted, error) {
+	event := new(Vetoer1of2VetoCallExecuted)
+	if err := _Vetoer1of2.contract.UnpackLog(event, "VetoCallExecuted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
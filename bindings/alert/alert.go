@@ -0,0 +1,240 @@
+// Package alert watches a BalanceTracker and raises alerts when refills or
+// profit sends fail, or when system addresses run low on funds.
+package alert
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/watch"
+)
+
+// Severity is the urgency of an alert.
+type Severity string
+
+const (
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// Alert is raised by a Rule.
+type Alert struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	// Subject is the address the alert is about.
+	Subject common.Address `json:"subject"`
+	Message string         `json:"message"`
+	Time    time.Time      `json:"time"`
+	// TxHash is the transaction that triggered the alert, if any.
+	TxHash *common.Hash `json:"txHash,omitempty"`
+}
+
+// key identifies repeated alerts.
+func (a *Alert) key() string {
+	return a.Rule + ":" + a.Subject.Hex()
+}
+
+// Backend is the chain access needed by the Engine. Watching events requires
+// a websocket or IPC connection.
+type Backend interface {
+	bind.ContractBackend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+}
+
+// Config configures an Engine.
+type Config struct {
+	// BalanceTracker is the address of the BalanceTracker contract.
+	BalanceTracker common.Address
+	// PollInterval is the time between two checks of the StateRules.
+	PollInterval time.Duration
+	// RepeatInterval is the time during which an alert raised again by the
+	// same rule for the same subject is suppressed.
+	RepeatInterval time.Duration
+}
+
+// Engine evaluates rules against a BalanceTracker and sends the alerts they
+// raise to every sink.
+type Engine struct {
+	cfg      Config
+	backend  Backend
+	contract *bindings.BalanceTracker
+	rules    []Rule
+	sinks    []Sink
+	log      log.Logger
+	now      func() time.Time
+
+	// sent is when each alert was last sent, to suppress repeats.
+	sent map[string]time.Time
+}
+
+// New creates an Engine.
+func New(cfg Config, backend Backend, rules []Rule, sinks []Sink, logger log.Logger) (*Engine, error) {
+	for _, rule := range rules {
+		_, isEvent := rule.(EventRule)
+		_, isState := rule.(StateRule)
+		if !isEvent && !isState {
+			return nil, fmt.Errorf("alert: rule %s is neither an event nor a state rule", rule.Name())
+		}
+	}
+	contract, err := bindings.NewBalanceTracker(cfg.BalanceTracker, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Engine{
+		cfg:      cfg,
+		backend:  backend,
+		contract: contract,
+		rules:    rules,
+		sinks:    sinks,
+		log:      logger,
+		now:      time.Now,
+		sent:     make(map[string]time.Time),
+	}, nil
+}
+
+// Run watches the BalanceTracker events and checks the state every
+// PollInterval until ctx is cancelled or watching the events fails.
+func (e *Engine) Run(ctx context.Context) error {
+	processedSrc, err := watch.EventSource(e.cfg.BalanceTracker, bindings.BalanceTrackerMetaData, "ProcessedFunds", e.contract.ParseProcessedFunds)
+	if err != nil {
+		return err
+	}
+	processedWatcher, err := watch.New(watch.DefaultConfig, e.backend, processedSrc, e.log.New("event", "ProcessedFunds"))
+	if err != nil {
+		return err
+	}
+	profitSrc, err := watch.EventSource(e.cfg.BalanceTracker, bindings.BalanceTrackerMetaData, "SentProfit", e.contract.ParseSentProfit)
+	if err != nil {
+		return err
+	}
+	profitWatcher, err := watch.New(watch.DefaultConfig, e.backend, profitSrc, e.log.New("event", "SentProfit"))
+	if err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	processed := make(chan *bindings.BalanceTrackerProcessedFunds)
+	g.Go(func() error { return processedWatcher.Run(ctx, processed) })
+	profit := make(chan *bindings.BalanceTrackerSentProfit)
+	g.Go(func() error { return profitWatcher.Run(ctx, profit) })
+	g.Go(func() error { return e.loop(ctx, processed, profit) })
+	return g.Wait()
+}
+
+// loop evaluates the rules against the watched events and the state every
+// PollInterval until ctx is cancelled.
+func (e *Engine) loop(ctx context.Context, processed <-chan *bindings.BalanceTrackerProcessedFunds, profit <-chan *bindings.BalanceTrackerSentProfit) error {
+	ticker := time.NewTicker(e.cfg.PollInterval)
+	defer ticker.Stop()
+	e.check(ctx)
+	for {
+		select {
+		case ev := <-processed:
+			e.processedFunds(ctx, ev)
+		case ev := <-profit:
+			e.sentProfit(ctx, ev)
+		case <-ticker.C:
+			e.check(ctx)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (e *Engine) processedFunds(ctx context.Context, ev *bindings.BalanceTrackerProcessedFunds) {
+	at := e.blockTime(ctx, ev.Raw)
+	for _, rule := range e.rules {
+		if rule, ok := rule.(EventRule); ok {
+			e.send(ctx, rule.ProcessedFunds(ev, at))
+		}
+	}
+}
+
+func (e *Engine) sentProfit(ctx context.Context, ev *bindings.BalanceTrackerSentProfit) {
+	at := e.blockTime(ctx, ev.Raw)
+	for _, rule := range e.rules {
+		if rule, ok := rule.(EventRule); ok {
+			e.send(ctx, rule.SentProfit(ev, at))
+		}
+	}
+}
+
+// blockTime returns the timestamp of the block of an event, or the current
+// time if it cannot be fetched.
+func (e *Engine) blockTime(ctx context.Context, l types.Log) time.Time {
+	if l.Removed {
+		// The block is no longer canonical and may be gone.
+		return e.now()
+	}
+	header, err := e.backend.HeaderByHash(ctx, l.BlockHash)
+	if err != nil {
+		e.log.Warn("Failed to fetch block of event, using the current time", "block", l.BlockNumber, "tx", l.TxHash, "err", err)
+		return e.now()
+	}
+	return time.Unix(int64(header.Time), 0)
+}
+
+// check evaluates the StateRules against the current state.
+func (e *Engine) check(ctx context.Context) {
+	state, err := e.state(ctx)
+	if err != nil {
+		e.log.Error("Failed to read BalanceTracker state", "err", err)
+		return
+	}
+	now := e.now()
+	for _, rule := range e.rules {
+		if rule, ok := rule.(StateRule); ok {
+			e.send(ctx, rule.Check(state, now))
+		}
+	}
+}
+
+func (e *Engine) state(ctx context.Context) (*State, error) {
+	targets, err := e.contract.Targets(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, err
+	}
+	balance, err := e.backend.BalanceAt(ctx, e.cfg.BalanceTracker, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch BalanceTracker balance: %w", err)
+	}
+	state := &State{Balance: balance}
+	for _, target := range targets {
+		balance, err := e.backend.BalanceAt(ctx, target.SystemAddress, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch balance of %s: %w", target.SystemAddress, err)
+		}
+		state.Targets = append(state.Targets, Target{
+			SystemAddress: target.SystemAddress,
+			TargetBalance: target.TargetBalance,
+			Balance:       balance,
+		})
+	}
+	return state, nil
+}
+
+// send delivers the alerts not sent within the RepeatInterval to every sink.
+func (e *Engine) send(ctx context.Context, alerts []Alert) {
+	for _, a := range alerts {
+		if last, ok := e.sent[a.key()]; ok && a.Time.Sub(last) < e.cfg.RepeatInterval {
+			continue
+		}
+		e.sent[a.key()] = a.Time
+		e.log.Warn("Alert raised", "rule", a.Rule, "subject", a.Subject, "message", a.Message)
+		for _, sink := range e.sinks {
+			if err := sink.Send(ctx, a); err != nil {
+				e.log.Error("Failed to send alert", "rule", a.Rule, "err", err)
+			}
+		}
+	}
+}
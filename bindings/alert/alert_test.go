@@ -0,0 +1,188 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+// fakeBackend answers the BalanceTracker calls of the engine from in-memory
+// state. Calls outside of that panic on the nil embedded backend.
+type fakeBackend struct {
+	bind.ContractBackend
+	balances        map[common.Address]int64
+	systemAddresses []common.Address
+	targetBalances  []int64
+	blockTimes      map[common.Hash]uint64
+}
+
+func (b *fakeBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (b *fakeBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	parsed, err := bindings.BalanceTrackerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name == "MAX_SYSTEM_ADDRESS_COUNT" {
+		return method.Outputs.Pack(big.NewInt(20))
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	i := int(args[0].(*big.Int).Int64())
+	if i >= len(b.systemAddresses) {
+		return nil, errors.New("execution reverted: out-of-bounds access of an array or bytesN")
+	}
+	if method.Name == "systemAddresses" {
+		return method.Outputs.Pack(b.systemAddresses[i])
+	}
+	return method.Outputs.Pack(big.NewInt(b.targetBalances[i]))
+}
+
+func (b *fakeBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return &types.Header{Time: b.blockTimes[hash]}, nil
+}
+
+func (b *fakeBackend) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return big.NewInt(b.balances[account]), nil
+}
+
+// webhook is a mock webhook receiver.
+type webhook struct {
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func (w *webhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	var a Alert
+	if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.mu.Lock()
+	w.alerts = append(w.alerts, a)
+	w.mu.Unlock()
+}
+
+func (w *webhook) received() []Alert {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]Alert(nil), w.alerts...)
+}
+
+func TestEngine(t *testing.T) {
+	tracker := common.HexToAddress("0xb7")
+	batcher := common.HexToAddress("0xba")
+	backend := &fakeBackend{
+		balances:        map[common.Address]int64{tracker: 10, batcher: 20},
+		systemAddresses: []common.Address{batcher},
+		targetBalances:  []int64{100},
+	}
+	hook := new(webhook)
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	rules := []Rule{
+		&FailureCount{Window: time.Hour, Threshold: 1},
+		&BelowTarget{Percent: 50},
+		&InsufficientBalance{BalanceTracker: tracker},
+	}
+	e, err := New(Config{BalanceTracker: tracker, RepeatInterval: time.Hour}, backend, rules, []Sink{NewWebhookSink(server.URL, server.Client())}, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1_700_000_000, 0)
+	e.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	e.check(ctx)
+	got := hook.received()
+	if len(got) != 2 || got[0].Rule != "below_target" || got[0].Subject != batcher || got[1].Rule != "insufficient_balance" || got[1].Subject != tracker {
+		t.Fatalf("unexpected alerts: %+v", got)
+	}
+
+	// Repeats are suppressed until the RepeatInterval passed.
+	e.check(ctx)
+	if n := len(hook.received()); n != 2 {
+		t.Fatalf("got %d alerts, want repeats to be suppressed", n)
+	}
+	now = now.Add(time.Hour)
+	e.check(ctx)
+	if n := len(hook.received()); n != 4 {
+		t.Fatalf("got %d alerts, want 4 once the repeat interval passed", n)
+	}
+
+	// Event rules are evaluated at the time of the block of the event.
+	refill := failedRefill(batcher)
+	refill.Raw.BlockHash = common.HexToHash("0xb1")
+	backend.blockTimes = map[common.Hash]uint64{refill.Raw.BlockHash: 1_600_000_000}
+	e.processedFunds(ctx, refill)
+	got = hook.received()
+	if len(got) != 5 || got[4].Rule != "failure_count" || got[4].TxHash == nil || got[4].Time.Unix() != 1_600_000_000 {
+		t.Fatalf("unexpected alerts: %+v", got)
+	}
+}
+
+func TestWebhookSinkError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		http.Error(rw, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewWebhookSink(server.URL, server.Client()).Send(context.Background(), Alert{Rule: "test"})
+	if err == nil || !strings.Contains(err.Error(), "503") {
+		t.Fatalf("expected a 503 error, got %v", err)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "alerts.jsonl")
+	for i := 0; i < 2; i++ {
+		sink, err := NewFileSink(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := sink.Send(context.Background(), Alert{Rule: "test", Severity: SeverityWarning}); err != nil {
+			t.Fatal(err)
+		}
+		if err := sink.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want the file to be appended to", len(lines))
+	}
+	var a Alert
+	if err := json.Unmarshal([]byte(lines[1]), &a); err != nil || a.Rule != "test" {
+		t.Fatalf("unexpected line %q: %v", lines[1], err)
+	}
+}
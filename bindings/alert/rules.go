@@ -0,0 +1,196 @@
+package alert
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+// Rule is an alerting rule. Every rule implements EventRule, StateRule or
+// both.
+type Rule interface {
+	// Name identifies the rule in the alerts it raises.
+	Name() string
+}
+
+// EventRule raises alerts from BalanceTracker events. at is the timestamp of
+// the block of the event. Events removed by a reorg are passed again with
+// their Raw.Removed flag set.
+type EventRule interface {
+	Rule
+	ProcessedFunds(ev *bindings.BalanceTrackerProcessedFunds, at time.Time) []Alert
+	SentProfit(ev *bindings.BalanceTrackerSentProfit, at time.Time) []Alert
+}
+
+// StateRule raises alerts from the balances of the BalanceTracker and its
+// system addresses.
+type StateRule interface {
+	Rule
+	Check(s *State, now time.Time) []Alert
+}
+
+// State is the state of a BalanceTracker checked by the StateRules.
+type State struct {
+	// Balance is the balance of the BalanceTracker.
+	Balance *big.Int
+	Targets []Target
+}
+
+// Target is a system address funded by the BalanceTracker.
+type Target struct {
+	SystemAddress common.Address
+	TargetBalance *big.Int
+	Balance       *big.Int
+}
+
+// Shortfall is the amount the system address lacks to reach its target
+// balance.
+func (t *Target) Shortfall() *big.Int {
+	if t.Balance.Cmp(t.TargetBalance) >= 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Sub(t.TargetBalance, t.Balance)
+}
+
+// FailureCount alerts when refills of a system address, or sends to the
+// profit wallet, failed at least Threshold times within Window of block time.
+// Failures removed by a reorg are no longer counted.
+type FailureCount struct {
+	Window    time.Duration
+	Threshold int
+
+	failures map[common.Address][]failure
+}
+
+// failure is a failed refill or profit send.
+type failure struct {
+	tx    common.Hash
+	index uint
+	at    time.Time
+}
+
+// Name implements Rule.
+func (r *FailureCount) Name() string {
+	return "failure_count"
+}
+
+// ProcessedFunds implements EventRule.
+func (r *FailureCount) ProcessedFunds(ev *bindings.BalanceTrackerProcessedFunds, at time.Time) []Alert {
+	// A zero BalanceNeeded with an unset success flag means no refill was
+	// needed, not that it failed.
+	if ev.Success || ev.BalanceNeeded.Sign() == 0 {
+		return nil
+	}
+	return r.record(ev.SystemAddress, "refill", ev.Raw, at)
+}
+
+// SentProfit implements EventRule.
+func (r *FailureCount) SentProfit(ev *bindings.BalanceTrackerSentProfit, at time.Time) []Alert {
+	if ev.Success {
+		return nil
+	}
+	return r.record(ev.ProfitWallet, "profit send", ev.Raw, at)
+}
+
+func (r *FailureCount) record(subject common.Address, what string, l types.Log, at time.Time) []Alert {
+	if r.failures == nil {
+		r.failures = make(map[common.Address][]failure)
+	}
+	var failures []failure
+	for _, f := range r.failures[subject] {
+		if l.Removed && f.tx == l.TxHash && f.index == l.Index {
+			continue
+		}
+		if at.Sub(f.at) <= r.Window {
+			failures = append(failures, f)
+		}
+	}
+	if l.Removed {
+		r.failures[subject] = failures
+		return nil
+	}
+	failures = append(failures, failure{tx: l.TxHash, index: l.Index, at: at})
+	r.failures[subject] = failures
+	if len(failures) < r.Threshold {
+		return nil
+	}
+	return []Alert{{
+		Rule:     r.Name(),
+		Severity: SeverityCritical,
+		Subject:  subject,
+		Message:  fmt.Sprintf("%d failed %ss to %s within %v", len(failures), what, subject, r.Window),
+		Time:     at,
+		TxHash:   &l.TxHash,
+	}}
+}
+
+// BelowTarget alerts when a system address holds less than Percent of its
+// target balance.
+type BelowTarget struct {
+	Percent uint64
+}
+
+// Name implements Rule.
+func (r *BelowTarget) Name() string {
+	return "below_target"
+}
+
+// Check implements StateRule.
+func (r *BelowTarget) Check(s *State, now time.Time) []Alert {
+	var alerts []Alert
+	for _, t := range s.Targets {
+		threshold := new(big.Int).Mul(t.TargetBalance, new(big.Int).SetUint64(r.Percent))
+		if new(big.Int).Mul(t.Balance, big.NewInt(100)).Cmp(threshold) >= 0 {
+			continue
+		}
+		alerts = append(alerts, Alert{
+			Rule:     r.Name(),
+			Severity: SeverityWarning,
+			Subject:  t.SystemAddress,
+			Message:  fmt.Sprintf("%s holds %s ETH, below %d%% of its %s ETH target", t.SystemAddress, formatEther(t.Balance), r.Percent, formatEther(t.TargetBalance)),
+			Time:     now,
+		})
+	}
+	return alerts
+}
+
+// InsufficientBalance alerts when the BalanceTracker cannot cover the
+// aggregate shortfall of its system addresses.
+type InsufficientBalance struct {
+	// BalanceTracker is the subject of the alerts.
+	BalanceTracker common.Address
+}
+
+// Name implements Rule.
+func (r *InsufficientBalance) Name() string {
+	return "insufficient_balance"
+}
+
+// Check implements StateRule.
+func (r *InsufficientBalance) Check(s *State, now time.Time) []Alert {
+	shortfall := new(big.Int)
+	for i := range s.Targets {
+		shortfall.Add(shortfall, s.Targets[i].Shortfall())
+	}
+	if s.Balance.Cmp(shortfall) >= 0 {
+		return nil
+	}
+	return []Alert{{
+		Rule:     r.Name(),
+		Severity: SeverityCritical,
+		Subject:  r.BalanceTracker,
+		Message:  fmt.Sprintf("BalanceTracker holds %s ETH, short of the %s ETH its system addresses need", formatEther(s.Balance), formatEther(shortfall)),
+		Time:     now,
+	}}
+}
+
+// formatEther formats a wei amount in ether.
+func formatEther(wei *big.Int) string {
+	return new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether)).Text('f', 6)
+}
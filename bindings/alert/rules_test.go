@@ -0,0 +1,100 @@
+package alert
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+func failedRefill(systemAddress common.Address) *bindings.BalanceTrackerProcessedFunds {
+	return &bindings.BalanceTrackerProcessedFunds{SystemAddress: systemAddress, BalanceNeeded: big.NewInt(10), BalanceSent: big.NewInt(10)}
+}
+
+func TestFailureCount(t *testing.T) {
+	batcher := common.HexToAddress("0xba")
+	rule := &FailureCount{Window: time.Hour, Threshold: 2}
+	start := time.Unix(1_700_000_000, 0)
+
+	if alerts := rule.ProcessedFunds(failedRefill(batcher), start); len(alerts) != 0 {
+		t.Fatalf("alert raised below threshold: %+v", alerts)
+	}
+	// A check that needed no refill is not a failure.
+	skipped := &bindings.BalanceTrackerProcessedFunds{SystemAddress: batcher, BalanceNeeded: new(big.Int), BalanceSent: new(big.Int)}
+	if alerts := rule.ProcessedFunds(skipped, start.Add(time.Minute)); len(alerts) != 0 {
+		t.Fatalf("alert raised for a skipped refill: %+v", alerts)
+	}
+	// The first failure left the window.
+	if alerts := rule.ProcessedFunds(failedRefill(batcher), start.Add(2*time.Hour)); len(alerts) != 0 {
+		t.Fatalf("alert raised for failures outside the window: %+v", alerts)
+	}
+	alerts := rule.ProcessedFunds(failedRefill(batcher), start.Add(150*time.Minute))
+	if len(alerts) != 1 || alerts[0].Subject != batcher || alerts[0].Severity != SeverityCritical {
+		t.Fatalf("unexpected alerts: %+v", alerts)
+	}
+
+	profitWallet := common.HexToAddress("0xcc")
+	failedSend := &bindings.BalanceTrackerSentProfit{ProfitWallet: profitWallet, BalanceSent: big.NewInt(1)}
+	rule.SentProfit(failedSend, start)
+	if alerts := rule.SentProfit(failedSend, start); len(alerts) != 1 || alerts[0].Subject != profitWallet {
+		t.Fatalf("unexpected alerts: %+v", alerts)
+	}
+}
+
+func TestFailureCountRemoved(t *testing.T) {
+	batcher := common.HexToAddress("0xba")
+	rule := &FailureCount{Window: time.Hour, Threshold: 2}
+	start := time.Unix(1_700_000_000, 0)
+
+	first := failedRefill(batcher)
+	first.Raw.TxHash = common.HexToHash("0x01")
+	rule.ProcessedFunds(first, start)
+	// A reorg removed the first failure, the next one is alone in the window.
+	removed := *first
+	removed.Raw.Removed = true
+	if alerts := rule.ProcessedFunds(&removed, start); len(alerts) != 0 {
+		t.Fatalf("alert raised for a removed failure: %+v", alerts)
+	}
+	second := failedRefill(batcher)
+	second.Raw.TxHash = common.HexToHash("0x02")
+	if alerts := rule.ProcessedFunds(second, start.Add(time.Minute)); len(alerts) != 0 {
+		t.Fatalf("removed failure still counted: %+v", alerts)
+	}
+	third := failedRefill(batcher)
+	third.Raw.TxHash = common.HexToHash("0x03")
+	if alerts := rule.ProcessedFunds(third, start.Add(2*time.Minute)); len(alerts) != 1 || alerts[0].Time != start.Add(2*time.Minute) {
+		t.Fatalf("unexpected alerts: %+v", alerts)
+	}
+}
+
+func TestStateRules(t *testing.T) {
+	tracker := common.HexToAddress("0xb7")
+	batcher := common.HexToAddress("0xba")
+	proposer := common.HexToAddress("0xbb")
+	state := &State{
+		Balance: big.NewInt(50),
+		Targets: []Target{
+			{SystemAddress: batcher, TargetBalance: big.NewInt(100), Balance: big.NewInt(40)},
+			{SystemAddress: proposer, TargetBalance: big.NewInt(100), Balance: big.NewInt(60)},
+		},
+	}
+	now := time.Unix(1_700_000_000, 0)
+
+	alerts := (&BelowTarget{Percent: 50}).Check(state, now)
+	if len(alerts) != 1 || alerts[0].Subject != batcher {
+		t.Fatalf("unexpected below target alerts: %+v", alerts)
+	}
+
+	// The system addresses lack 60 + 40 wei.
+	rule := &InsufficientBalance{BalanceTracker: tracker}
+	if alerts := rule.Check(state, now); len(alerts) != 1 || alerts[0].Subject != tracker {
+		t.Fatalf("unexpected insufficient balance alerts: %+v", alerts)
+	}
+	state.Balance = big.NewInt(100)
+	if alerts := rule.Check(state, now); len(alerts) != 0 {
+		t.Fatalf("alert raised although the shortfall is covered: %+v", alerts)
+	}
+}
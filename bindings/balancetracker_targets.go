@@ -0,0 +1,44 @@
+package bindings
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BalanceTrackerTarget is a system address funded by the BalanceTracker.
+type BalanceTrackerTarget struct {
+	SystemAddress common.Address
+	TargetBalance *big.Int
+}
+
+// Targets reads the system addresses and their target balances. The contract
+// exposes no array length, so entries are read until the read reverts with an
+// out of bounds index or MAX_SYSTEM_ADDRESS_COUNT is reached. Errors that are
+// no revert, like transport errors, are returned.
+func (_BalanceTracker *BalanceTrackerCaller) Targets(opts *bind.CallOpts) ([]BalanceTrackerTarget, error) {
+	count, err := _BalanceTracker.MAXSYSTEMADDRESSCOUNT(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch MAX_SYSTEM_ADDRESS_COUNT: %w", err)
+	}
+	var targets []BalanceTrackerTarget
+	for i := int64(0); i < count.Int64(); i++ {
+		addr, err := _BalanceTracker.SystemAddresses(opts, big.NewInt(i))
+		var revert *RevertError
+		if errors.As(DecodeRevert(err), &revert) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch systemAddresses(%d): %w", i, err)
+		}
+		target, err := _BalanceTracker.TargetBalances(opts, big.NewInt(i))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch targetBalances(%d): %w", i, err)
+		}
+		targets = append(targets, BalanceTrackerTarget{SystemAddress: addr, TargetBalance: target})
+	}
+	return targets, nil
+}
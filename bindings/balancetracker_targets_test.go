@@ -0,0 +1,96 @@
+package bindings
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// fakeBalanceTracker answers BalanceTracker calls from in-memory arrays and
+// reverts on out of bounds reads like the contract does.
+type fakeBalanceTracker struct {
+	systemAddresses []common.Address
+	targetBalances  []*big.Int
+	// err fails the reads of the arrays without a revert.
+	err error
+}
+
+func (f *fakeBalanceTracker) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (f *fakeBalanceTracker) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	parsed, err := BalanceTrackerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name == "MAX_SYSTEM_ADDRESS_COUNT" {
+		return method.Outputs.Pack(big.NewInt(20))
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	i := int(args[0].(*big.Int).Int64())
+	if i >= len(f.systemAddresses) {
+		// Panic(0x32), an out of bounds array access.
+		data := append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], common.BigToHash(big.NewInt(0x32)).Bytes()...)
+		return nil, &dataError{data: hexutil.Encode(data)}
+	}
+	switch method.Name {
+	case "systemAddresses":
+		return method.Outputs.Pack(f.systemAddresses[i])
+	case "targetBalances":
+		return method.Outputs.Pack(f.targetBalances[i])
+	}
+	return nil, errors.New("unexpected call " + method.Name)
+}
+
+func TestBalanceTrackerTargets(t *testing.T) {
+	fake := &fakeBalanceTracker{
+		systemAddresses: []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")},
+		targetBalances:  []*big.Int{big.NewInt(100), big.NewInt(200)},
+	}
+	caller, err := NewBalanceTrackerCaller(common.HexToAddress("0xb7"), fake)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	targets, err := caller.Targets(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(targets) != 2 {
+		t.Fatalf("got %d targets, want 2", len(targets))
+	}
+	for i, target := range targets {
+		if target.SystemAddress != fake.systemAddresses[i] || target.TargetBalance.Cmp(fake.targetBalances[i]) != 0 {
+			t.Fatalf("target %d = %+v", i, target)
+		}
+	}
+}
+
+func TestBalanceTrackerTargetsNodeError(t *testing.T) {
+	nodeErr := errors.New("connection refused")
+	fake := &fakeBalanceTracker{err: nodeErr}
+	caller, err := NewBalanceTrackerCaller(common.HexToAddress("0xb7"), fake)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := caller.Targets(nil); !errors.Is(err, nodeErr) {
+		t.Fatalf("expected the node error, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+// Command balance-tracker-alerter raises alerts when BalanceTracker refills or
+// profit sends fail, or when system addresses run low on funds.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings/alert"
+)
+
+func main() {
+	var (
+		rpcURL             = flag.String("rpc", "", "L2 websocket or IPC endpoint")
+		balanceTracker     = flag.String("balance-tracker", "", "address of the BalanceTracker contract")
+		pollInterval       = flag.Duration("poll-interval", time.Minute, "time between two checks of the balances")
+		repeatInterval     = flag.Duration("repeat-interval", time.Hour, "time during which a repeated alert is suppressed")
+		failureWindow      = flag.Duration("failure-window", time.Hour, "window failed refills and profit sends are counted in")
+		failureThreshold   = flag.Int("failure-threshold", 1, "number of failures within the window that raises an alert")
+		belowTargetPercent = flag.Uint64("below-target-percent", 50, "percentage of its target balance a system address may fall to before an alert is raised, 0 to disable")
+		webhookURL         = flag.String("webhook", "", "URL to post alerts to as JSON")
+		alertFile          = flag.String("file", "", "file to append alerts to as JSON lines")
+		stdout             = flag.Bool("stdout", true, "write alerts to stdout as JSON lines")
+	)
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+	if !common.IsHexAddress(*balanceTracker) {
+		log.Crit("Invalid -balance-tracker address", "address", *balanceTracker)
+	}
+	tracker := common.HexToAddress(*balanceTracker)
+	rules := []alert.Rule{
+		&alert.FailureCount{Window: *failureWindow, Threshold: *failureThreshold},
+		&alert.InsufficientBalance{BalanceTracker: tracker},
+	}
+	if *belowTargetPercent > 0 {
+		rules = append(rules, &alert.BelowTarget{Percent: *belowTargetPercent})
+	}
+	cfg := alert.Config{
+		BalanceTracker: tracker,
+		PollInterval:   *pollInterval,
+		RepeatInterval: *repeatInterval,
+	}
+	if err := run(*rpcURL, cfg, rules, *webhookURL, *alertFile, *stdout); err != nil && !errors.Is(err, context.Canceled) {
+		log.Crit("Alerter failed", "err", err)
+	}
+}
+
+func run(rpcURL string, cfg alert.Config, rules []alert.Rule, webhookURL, alertFile string, stdout bool) error {
+	var sinks []alert.Sink
+	if webhookURL != "" {
+		sinks = append(sinks, alert.NewWebhookSink(webhookURL, nil))
+	}
+	if alertFile != "" {
+		sink, err := alert.NewFileSink(alertFile)
+		if err != nil {
+			return err
+		}
+		defer sink.Close()
+		sinks = append(sinks, sink)
+	}
+	if stdout {
+		sinks = append(sinks, alert.NewJSONSink(os.Stdout))
+	}
+	if len(sinks) == 0 {
+		return errors.New("no alert sink configured")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", rpcURL, err)
+	}
+	defer client.Close()
+
+	logger := log.Root().New("alerter", "balance-tracker")
+	engine, err := alert.New(cfg, client, rules, sinks, logger)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Starting alerter", "balanceTracker", cfg.BalanceTracker, "sinks", len(sinks))
+	return engine.Run(ctx)
+}
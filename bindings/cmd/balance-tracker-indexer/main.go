@@ -0,0 +1,73 @@
+// Command balance-tracker-indexer indexes the ProcessedFunds, SentProfit and
+// ReceivedFunds events of a BalanceTracker into an SQLite database.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings/indexer"
+)
+
+func main() {
+	var (
+		rpcURL         = flag.String("rpc", "", "L2 JSON-RPC endpoint")
+		balanceTracker = flag.String("balance-tracker", "", "address of the BalanceTracker contract")
+		dbPath         = flag.String("db", "balance-tracker-indexer.db", "SQLite database to store the events in")
+		startBlock     = flag.Uint64("start-block", 0, "first block to index, usually the deployment block of the BalanceTracker")
+		chunkSize      = flag.Uint64("chunk-size", 2000, "largest number of blocks whose logs are fetched at once")
+		confirmations  = flag.Uint64("confirmations", 0, "number of blocks to stay behind the head")
+		pollInterval   = flag.Duration("poll-interval", 4*time.Second, "time between two checks for new blocks")
+	)
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+	cfg := indexer.Config{
+		StartBlock:    *startBlock,
+		ChunkSize:     *chunkSize,
+		Confirmations: *confirmations,
+		PollInterval:  *pollInterval,
+	}
+	if err := run(*rpcURL, *balanceTracker, *dbPath, cfg); err != nil && !errors.Is(err, context.Canceled) {
+		log.Crit("Indexer failed", "err", err)
+	}
+}
+
+func run(rpcURL, balanceTracker, dbPath string, cfg indexer.Config) error {
+	if !common.IsHexAddress(balanceTracker) {
+		return fmt.Errorf("invalid -balance-tracker address %q", balanceTracker)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", rpcURL, err)
+	}
+	defer client.Close()
+	db, err := indexer.Open(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	logger := log.Root().New("indexer", "balance-tracker")
+	ix, err := indexer.NewBalanceTracker(common.HexToAddress(balanceTracker), cfg, client, db, logger)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Starting indexer", "balanceTracker", balanceTracker, "db", dbPath)
+	return ix.Run(ctx)
+}
@@ -0,0 +1,101 @@
+// Command balance-tracker-keeper calls BalanceTracker.processFees when a system
+// address falls below a fraction of its target balance or the BalanceTracker
+// accumulated enough profit to be swept.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/base-org/contracts/bindings/keeper"
+	"github.com/base-org/contracts/bindings/txmgr"
+)
+
+func main() {
+	var (
+		rpcURL           = flag.String("rpc", "", "L1 JSON-RPC endpoint")
+		balanceTracker   = flag.String("balance-tracker", "", "address of the BalanceTracker contract")
+		statePath        = flag.String("state", "balance-tracker-keeper.json", "file to persist in-flight transactions to")
+		pollInterval     = flag.Duration("poll-interval", time.Minute, "time between two checks")
+		refillPercent    = flag.Uint64("refill-threshold-percent", 50, "refill once a system address holds less than this percentage of its target balance")
+		sweepThreshold   = flag.String("sweep-threshold-wei", "", "process fees once the BalanceTracker holds at least this many wei, empty to disable")
+		resubmitInterval = flag.Duration("resubmit-interval", txmgr.DefaultConfig.ResubmitInterval, "time before an unmined transaction is resubmitted with bumped fees")
+		feeBumpPercent   = flag.Uint64("fee-bump-percent", txmgr.DefaultConfig.FeeBumpPercent, "percentage fees are bumped by on resubmission")
+		maxFeeCapGwei    = flag.Uint64("max-fee-cap-gwei", 0, "highest fee cap in gwei, 0 for no limit")
+	)
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+	cfg := keeper.RefillerConfig{
+		StatePath:              *statePath,
+		PollInterval:           *pollInterval,
+		RefillThresholdPercent: *refillPercent,
+	}
+	if err := run(*rpcURL, *balanceTracker, *sweepThreshold, cfg, *resubmitInterval, *feeBumpPercent, *maxFeeCapGwei); err != nil && !errors.Is(err, context.Canceled) {
+		log.Crit("Keeper failed", "err", err)
+	}
+}
+
+func run(rpcURL, balanceTracker, sweepThreshold string, cfg keeper.RefillerConfig, resubmitInterval time.Duration, feeBumpPercent, maxFeeCapGwei uint64) error {
+	if !common.IsHexAddress(balanceTracker) {
+		return fmt.Errorf("invalid -balance-tracker address %q", balanceTracker)
+	}
+	cfg.BalanceTracker = common.HexToAddress(balanceTracker)
+	if sweepThreshold != "" {
+		threshold, ok := new(big.Int).SetString(sweepThreshold, 10)
+		if !ok {
+			return fmt.Errorf("invalid -sweep-threshold-wei %q", sweepThreshold)
+		}
+		cfg.SweepThreshold = threshold
+	}
+	key, err := crypto.HexToECDSA(os.Getenv("KEEPER_PRIVATE_KEY"))
+	if err != nil {
+		return fmt.Errorf("invalid KEEPER_PRIVATE_KEY: %w", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", rpcURL, err)
+	}
+	defer client.Close()
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return err
+	}
+
+	txCfg := txmgr.DefaultConfig
+	txCfg.ResubmitInterval = resubmitInterval
+	txCfg.FeeBumpPercent = feeBumpPercent
+	if maxFeeCapGwei > 0 {
+		txCfg.MaxFeeCap = new(big.Int).Mul(new(big.Int).SetUint64(maxFeeCapGwei), big.NewInt(params.GWei))
+	}
+	logger := log.Root().New("keeper", "balance-tracker")
+	refiller, err := keeper.NewRefiller(cfg, client, txmgr.New(client, opts, txCfg, logger), logger)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Starting keeper", "balanceTracker", cfg.BalanceTracker, "from", opts.From)
+	return refiller.Run(ctx)
+}
@@ -0,0 +1,182 @@
+// Command bindgen regenerates the Go bindings in this module from forge build
+// artifacts. It reads the contracts listed in a JSON config, writes the
+// pretty-printed ABI next to each binding and, with -check, fails instead of
+// writing when a committed binding has drifted from the artifacts.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// config lists the contracts to generate bindings for.
+type config struct {
+	Contracts []contract `json:"contracts"`
+}
+
+// contract describes a single binding.
+type contract struct {
+	// Type is the Go type name of the binding and the base name of its files.
+	Type string `json:"type"`
+	// Artifact is the path of the forge artifact, relative to the artifacts directory.
+	Artifact string `json:"artifact"`
+	// Bytecode embeds the creation and deployed bytecode in the binding. Deploy
+	// functions are not generated, they live in deploy.go so that constructor
+	// arguments can be validated before a deployment is sent.
+	Bytecode bool `json:"bytecode"`
+}
+
+// artifact is the subset of a forge build artifact used for generation.
+type artifact struct {
+	ABI              json.RawMessage `json:"abi"`
+	Bytecode         bytecode        `json:"bytecode"`
+	DeployedBytecode bytecode        `json:"deployedBytecode"`
+}
+
+type bytecode struct {
+	Object string `json:"object"`
+}
+
+func main() {
+	var (
+		configPath   = flag.String("config", "contracts.json", "path to the contracts config")
+		artifactsDir = flag.String("artifacts", "../out", "path to the forge artifacts directory")
+		outDir       = flag.String("out", ".", "directory to write the bindings to")
+		pkg          = flag.String("pkg", "bindings", "package name of the generated bindings")
+		check        = flag.Bool("check", false, "fail if the committed bindings do not match the artifacts")
+	)
+	flag.Parse()
+
+	if err := run(*configPath, *artifactsDir, *outDir, *pkg, *check); err != nil {
+		fmt.Fprintf(os.Stderr, "bindgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(configPath, artifactsDir, outDir, pkg string, check bool) error {
+	raw, err := os.ReadFile(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	var cfg config
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return fmt.Errorf("failed to parse config %s: %w", configPath, err)
+	}
+
+	var stale []string
+	for _, c := range cfg.Contracts {
+		files, err := generate(c, artifactsDir, pkg)
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.Type, err)
+		}
+		for _, f := range files {
+			path := filepath.Join(outDir, f.name)
+			if check {
+				committed, err := os.ReadFile(path)
+				if err != nil || !bytes.Equal(committed, f.data) {
+					stale = append(stale, path)
+				}
+				continue
+			}
+			if err := os.WriteFile(path, f.data, 0o644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", path, err)
+			}
+		}
+	}
+	if len(stale) > 0 {
+		return fmt.Errorf("bindings out of date with artifacts, run go generate: %s", strings.Join(stale, ", "))
+	}
+	return nil
+}
+
+type file struct {
+	name string
+	data []byte
+}
+
+// generate produces the ABI file and Go binding for a single contract.
+func generate(c contract, artifactsDir, pkg string) ([]file, error) {
+	raw, err := os.ReadFile(filepath.Join(artifactsDir, c.Artifact))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+	var a artifact
+	if err := json.Unmarshal(raw, &a); err != nil {
+		return nil, fmt.Errorf("failed to parse artifact: %w", err)
+	}
+
+	// Entries are re-encoded so that key order and indentation match forge's
+	// ABI output regardless of how the artifact itself was formatted.
+	var entries []map[string]interface{}
+	if err := json.Unmarshal(a.ABI, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+	var abiJSON bytes.Buffer
+	enc := json.NewEncoder(&abiJSON)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(entries); err != nil {
+		return nil, fmt.Errorf("failed to encode ABI: %w", err)
+	}
+
+	code, err := bind.Bind([]string{c.Type}, []string{abiJSON.String()}, []string{""}, nil, pkg, bind.LangGo, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate binding: %w", err)
+	}
+	if c.Bytecode {
+		bin := strings.TrimPrefix(a.Bytecode.Object, "0x")
+		if bin == "" {
+			return nil, fmt.Errorf("artifact has no bytecode")
+		}
+		if code, err = embedBytecode(code, c.Type, bin); err != nil {
+			return nil, err
+		}
+	}
+
+	files := []file{
+		{name: c.Type + ".abi", data: abiJSON.Bytes()},
+		{name: c.Type + ".go", data: []byte(code)},
+	}
+	if c.Bytecode {
+		deployed := strings.TrimPrefix(a.DeployedBytecode.Object, "0x")
+		if deployed == "" {
+			return nil, fmt.Errorf("artifact has no deployed bytecode")
+		}
+		files = append(files, file{name: c.Type + "Deployed.go", data: []byte(deployedSource(pkg, c.Type, deployed))})
+	}
+	return files, nil
+}
+
+// embedBytecode adds the creation bytecode to the MetaData of a generated binding.
+func embedBytecode(code, typ, bin string) (string, error) {
+	start := strings.Index(code, "var "+typ+"MetaData = &bind.MetaData{\n")
+	if start < 0 {
+		return "", fmt.Errorf("%sMetaData not found in generated binding", typ)
+	}
+	end := strings.Index(code[start:], "\n}\n")
+	if end < 0 {
+		return "", fmt.Errorf("%sMetaData is not terminated in generated binding", typ)
+	}
+	end += start + 1
+	return code[:end] + "\tBin: \"0x" + bin + "\",\n" + code[end:], nil
+}
+
+// deployedSource renders the runtime bytecode of a contract, which is useful to
+// verify the code found at a deployed address.
+func deployedSource(pkg, typ, deployed string) string {
+	return fmt.Sprintf(`// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package %s
+
+// %sDeployedBin is the runtime bytecode of the %s contract.
+var %sDeployedBin = "0x%s"
+`, pkg, typ, typ, typ, deployed)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// forgeArtifacts is the forge output directory, relative to this package.
+const forgeArtifacts = "../../../out"
+
+// TestCommittedBindingsUpToDate regenerates every configured binding and checks
+// that the output matches the committed files byte for byte. Bindings without
+// bytecode are generated from artifacts synthesized out of the committed ABI
+// files. Bytecode only lives in the real forge artifacts, so the bindings with
+// bytecode are generated from those and the test fails unless forge build has
+// been run.
+func TestCommittedBindingsUpToDate(t *testing.T) {
+	raw, err := os.ReadFile("../../contracts.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cfg config
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	artifacts := t.TempDir()
+	for _, c := range cfg.Contracts {
+		if c.Bytecode {
+			if _, err := os.Stat(filepath.Join(forgeArtifacts, c.Artifact)); err != nil {
+				t.Fatalf("%s: %v, run forge build from the repository root", c.Type, err)
+			}
+			checkGenerated(t, c, forgeArtifacts)
+			continue
+		}
+		abiJSON, err := os.ReadFile(filepath.Join("../..", c.Type+".abi"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, err := json.Marshal(artifact{ABI: abiJSON})
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(artifacts, c.Artifact)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, a, 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		checkGenerated(t, c, artifacts)
+	}
+}
+
+// checkGenerated compares the files generated for c from the artifacts in dir
+// with the committed ones.
+func checkGenerated(t *testing.T, c contract, dir string) {
+	t.Helper()
+	files, err := generate(c, dir, "bindings")
+	if err != nil {
+		t.Fatalf("%s: %v", c.Type, err)
+	}
+	for _, f := range files {
+		committed, err := os.ReadFile(filepath.Join("../..", f.name))
+		if err != nil {
+			t.Errorf("%s: %v", f.name, err)
+			continue
+		}
+		if string(committed) != string(f.data) {
+			t.Errorf("%s does not match the generator output", f.name)
+		}
+	}
+}
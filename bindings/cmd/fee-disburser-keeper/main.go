@@ -0,0 +1,91 @@
+// Command fee-disburser-keeper calls FeeDisburser.disburseFees whenever the
+// disbursement interval has passed and the fee vaults hold enough fees. Fee
+// vaults not withdrawing to the FeeDisburser on L2 are logged and the
+// disbursement skipped until they are fixed.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/base-org/contracts/bindings/keeper"
+	"github.com/base-org/contracts/bindings/txmgr"
+)
+
+func main() {
+	var (
+		rpcURL           = flag.String("rpc", "", "L2 JSON-RPC endpoint")
+		feeDisburser     = flag.String("fee-disburser", "", "address of the FeeDisburser contract")
+		statePath        = flag.String("state", "fee-disburser-keeper.json", "file to persist in-flight transactions to")
+		pollInterval     = flag.Duration("poll-interval", time.Minute, "longest time between two checks")
+		resubmitInterval = flag.Duration("resubmit-interval", txmgr.DefaultConfig.ResubmitInterval, "time before an unmined transaction is resubmitted with bumped fees")
+		feeBumpPercent   = flag.Uint64("fee-bump-percent", txmgr.DefaultConfig.FeeBumpPercent, "percentage fees are bumped by on resubmission")
+		maxFeeCapGwei    = flag.Uint64("max-fee-cap-gwei", 0, "highest fee cap in gwei, 0 for no limit")
+	)
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+	if err := run(*rpcURL, *feeDisburser, *statePath, *pollInterval, *resubmitInterval, *feeBumpPercent, *maxFeeCapGwei); err != nil && !errors.Is(err, context.Canceled) {
+		log.Crit("Keeper failed", "err", err)
+	}
+}
+
+func run(rpcURL, feeDisburser, statePath string, pollInterval, resubmitInterval time.Duration, feeBumpPercent, maxFeeCapGwei uint64) error {
+	if !common.IsHexAddress(feeDisburser) {
+		return fmt.Errorf("invalid -fee-disburser address %q", feeDisburser)
+	}
+	key, err := crypto.HexToECDSA(os.Getenv("KEEPER_PRIVATE_KEY"))
+	if err != nil {
+		return fmt.Errorf("invalid KEEPER_PRIVATE_KEY: %w", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", rpcURL, err)
+	}
+	defer client.Close()
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return err
+	}
+
+	cfg := txmgr.DefaultConfig
+	cfg.ResubmitInterval = resubmitInterval
+	cfg.FeeBumpPercent = feeBumpPercent
+	if maxFeeCapGwei > 0 {
+		cfg.MaxFeeCap = new(big.Int).Mul(new(big.Int).SetUint64(maxFeeCapGwei), big.NewInt(params.GWei))
+	}
+	logger := log.Root().New("keeper", "fee-disburser")
+	disburser, err := keeper.NewDisburser(keeper.DisburserConfig{
+		FeeDisburser: common.HexToAddress(feeDisburser),
+		StatePath:    statePath,
+		PollInterval: pollInterval,
+	}, client, txmgr.New(client, opts, cfg, logger), logger)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Starting keeper", "feeDisburser", feeDisburser, "from", opts.From)
+	return disburser.Run(ctx)
+}
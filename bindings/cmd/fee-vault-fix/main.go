@@ -0,0 +1,132 @@
+// Command fee-vault-fix prepares the correction of the totalProcessed of a
+// fee vault with src/fee-vault-fixes/FeeVault.sol. It sums the Withdrawal
+// events of the vault, writes the calls upgrading the vault to the fix
+// implementation, setting totalProcessed and upgrading it back to the calls
+// file of multisig-builder, and simulates them from the Safe owning the
+// ProxyAdmin, checking that the vault ends on its original implementation.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings/feevault"
+	"github.com/base-org/contracts/bindings/multisig"
+	"github.com/base-org/contracts/bindings/simulate"
+)
+
+// call3 is a call of the calls file of multisig-builder.
+type call3 struct {
+	Target       common.Address `json:"target"`
+	AllowFailure bool           `json:"allowFailure"`
+	CallData     hexutil.Bytes  `json:"callData"`
+}
+
+func main() {
+	var (
+		rpcURL     = flag.String("rpc", "", "L2 JSON-RPC endpoint")
+		vault      = flag.String("vault", "", "address of the fee vault proxy to correct")
+		fix        = flag.String("fix-implementation", "", "address src/fee-vault-fixes/FeeVault.sol is deployed at")
+		proxyAdmin = flag.String("proxy-admin", "", "address of the admin of the vault proxy, the ProxyAdmin predeploy if empty")
+		safe       = flag.String("safe", "", "address of the Safe owning the ProxyAdmin")
+		from       = flag.String("from", "", "address of the signer the transaction is simulated from")
+		startBlock = flag.Uint64("start-block", 0, "first block searched for withdrawals")
+		chunkSize  = flag.Uint64("chunk-size", 2000, "largest number of blocks whose logs are fetched at once")
+		out        = flag.String("out", "calls.json", "file to write the calls to, for the -calls flag of multisig-builder")
+	)
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+	if err := run(*rpcURL, *vault, *fix, *proxyAdmin, *safe, *from, *startBlock, *chunkSize, *out); err != nil && !errors.Is(err, context.Canceled) {
+		log.Crit("Fee vault fix failed", "err", err)
+	}
+}
+
+func run(rpcURL, vault, fix, proxyAdmin, safe, from string, startBlock, chunkSize uint64, out string) error {
+	for _, a := range []struct{ flag, value string }{{"vault", vault}, {"fix-implementation", fix}, {"safe", safe}, {"from", from}} {
+		if !common.IsHexAddress(a.value) {
+			return fmt.Errorf("invalid -%s address %q", a.flag, a.value)
+		}
+	}
+	cfg := feevault.Config{
+		Vault:             common.HexToAddress(vault),
+		FixImplementation: common.HexToAddress(fix),
+		StartBlock:        startBlock,
+		ChunkSize:         chunkSize,
+	}
+	if proxyAdmin != "" {
+		if !common.IsHexAddress(proxyAdmin) {
+			return fmt.Errorf("invalid -proxy-admin address %q", proxyAdmin)
+		}
+		cfg.ProxyAdmin = common.HexToAddress(proxyAdmin)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", rpcURL, err)
+	}
+	defer client.Close()
+
+	correction, err := feevault.Compute(ctx, client, cfg)
+	if err != nil {
+		return err
+	}
+	log.Info("Computed totalProcessed", "vault", correction.Vault, "block", correction.Block, "withdrawals", correction.Withdrawals,
+		"current", correction.Current, "correct", correction.TotalProcessed, "implementation", correction.Implementation)
+	calls, err := correction.Calls()
+	if err != nil {
+		return err
+	}
+
+	builder, err := multisig.New(multisig.Config{Safe: common.HexToAddress(safe)}, client, calls)
+	if err != nil {
+		return err
+	}
+	payload, err := builder.SignerPayload(ctx, common.HexToAddress(from))
+	if err != nil {
+		return err
+	}
+	sim, err := simulate.New(client, nil)
+	if err != nil {
+		return err
+	}
+	if err := correction.PostCheck(ctx, sim, payload); err != nil {
+		return err
+	}
+	log.Info("Simulation succeeded, the vault ends on its original implementation", "vault", correction.Vault, "implementation", correction.Implementation)
+
+	if err := writeCalls(out, calls); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote %d calls to %s, sign them with: multisig-builder -safe %s -calls %s sign\n", len(calls), out, common.HexToAddress(safe), out)
+	return nil
+}
+
+func writeCalls(path string, calls []multisig.Call3) error {
+	encoded := make([]call3, len(calls))
+	for i, c := range calls {
+		encoded[i] = call3{Target: c.Target, AllowFailure: c.AllowFailure, CallData: c.CallData}
+	}
+	raw, err := json.MarshalIndent(encoded, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(raw, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
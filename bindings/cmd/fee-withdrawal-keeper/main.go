@@ -0,0 +1,116 @@
+// Command fee-withdrawal-keeper proves and finalizes on L1 the withdrawals
+// bridging the L1 share of the fees disbursed by FeeDisburser.disburseFees.
+// Each withdrawal is proven against the first L2 output proposed after it was
+// initiated, or against the latest dispute game if the OptimismPortal uses
+// fault proofs, and finalized once the challenge period has passed.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/keeper"
+	"github.com/base-org/contracts/bindings/txmgr"
+)
+
+func main() {
+	var (
+		l2RPC            = flag.String("l2-rpc", "", "L2 JSON-RPC endpoint")
+		l1RPC            = flag.String("l1-rpc", "", "L1 JSON-RPC endpoint")
+		feeDisburser     = flag.String("fee-disburser", "", "address of the FeeDisburser contract")
+		portal           = flag.String("optimism-portal", "", "address of the OptimismPortal proxy on L1")
+		oracle           = flag.String("l2-output-oracle", "", "address of the L2OutputOracle proxy on L1, unless the OptimismPortal uses fault proofs")
+		statePath        = flag.String("state", "fee-withdrawal-keeper.json", "file to persist in-flight transactions to")
+		pollInterval     = flag.Duration("poll-interval", 5*time.Minute, "time between two checks when there is nothing to do")
+		startBlock       = flag.Uint64("start-block", 0, "first L2 block searched for disbursements")
+		chunkSize        = flag.Uint64("chunk-size", 2000, "largest number of L2 blocks whose logs are fetched at once")
+		resubmitInterval = flag.Duration("resubmit-interval", txmgr.DefaultConfig.ResubmitInterval, "time before an unmined transaction is resubmitted with bumped fees")
+		feeBumpPercent   = flag.Uint64("fee-bump-percent", txmgr.DefaultConfig.FeeBumpPercent, "percentage fees are bumped by on resubmission")
+		maxFeeCapGwei    = flag.Uint64("max-fee-cap-gwei", 0, "highest fee cap in gwei, 0 for no limit")
+	)
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+	cfg := keeper.ProverConfig{StatePath: *statePath, PollInterval: *pollInterval, StartBlock: *startBlock, ChunkSize: *chunkSize}
+	if err := run(*l2RPC, *l1RPC, *feeDisburser, *portal, *oracle, cfg, *resubmitInterval, *feeBumpPercent, *maxFeeCapGwei); err != nil && !errors.Is(err, context.Canceled) {
+		log.Crit("Keeper failed", "err", err)
+	}
+}
+
+func run(l2RPC, l1RPC, feeDisburser, portal, oracle string, cfg keeper.ProverConfig, resubmitInterval time.Duration, feeBumpPercent, maxFeeCapGwei uint64) error {
+	for _, a := range []struct{ flag, value string }{{"fee-disburser", feeDisburser}, {"optimism-portal", portal}} {
+		if !common.IsHexAddress(a.value) {
+			return fmt.Errorf("invalid -%s address %q", a.flag, a.value)
+		}
+	}
+	if oracle != "" && !common.IsHexAddress(oracle) {
+		return fmt.Errorf("invalid -l2-output-oracle address %q", oracle)
+	}
+	cfg.FeeDisburser = common.HexToAddress(feeDisburser)
+	cfg.OptimismPortal = common.HexToAddress(portal)
+	cfg.L2OutputOracle = common.HexToAddress(oracle)
+	key, err := crypto.HexToECDSA(os.Getenv("KEEPER_PRIVATE_KEY"))
+	if err != nil {
+		return fmt.Errorf("invalid KEEPER_PRIVATE_KEY: %w", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	l2, err := ethclient.DialContext(ctx, l2RPC)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", l2RPC, err)
+	}
+	defer l2.Close()
+	feeDisburserCaller, err := bindings.NewFeeDisburserCaller(cfg.FeeDisburser, l2)
+	if err != nil {
+		return err
+	}
+	if cfg.L1Wallet, err = feeDisburserCaller.L1WALLET(&bind.CallOpts{Context: ctx}); err != nil {
+		return fmt.Errorf("failed to fetch L1 wallet: %w", err)
+	}
+	l1, err := ethclient.DialContext(ctx, l1RPC)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", l1RPC, err)
+	}
+	defer l1.Close()
+	chainID, err := l1.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return err
+	}
+
+	txCfg := txmgr.DefaultConfig
+	txCfg.ResubmitInterval = resubmitInterval
+	txCfg.FeeBumpPercent = feeBumpPercent
+	txCfg.ReceiptPollInterval = 12 * time.Second
+	if maxFeeCapGwei > 0 {
+		txCfg.MaxFeeCap = new(big.Int).Mul(new(big.Int).SetUint64(maxFeeCapGwei), big.NewInt(params.GWei))
+	}
+	logger := log.Root().New("keeper", "fee-withdrawal")
+	prover, err := keeper.NewProver(cfg, l1, l2, txmgr.New(l1, opts, txCfg, logger), logger)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Starting keeper", "feeDisburser", feeDisburser, "optimismPortal", portal, "from", opts.From)
+	return prover.Run(ctx)
+}
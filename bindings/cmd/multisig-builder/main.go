@@ -0,0 +1,256 @@
+// Command multisig-builder replaces `forge script ... MultisigBuilder` for
+// Safe signers. It reads the Multicall3 calls to batch from a JSON file and
+// runs one of the steps of MultisigBuilder.sol:
+//
+//	nonce     print the nonce of the Safe transaction
+//	sign      simulate the transaction and print the data to sign
+//	status    report the owners having signed and how many are missing
+//	verify    check the collected signatures
+//	simulate  simulate the execution with the collected signatures
+//	calldata  print the execTransaction calldata to send to the Safe
+//
+// With -signer-safe, and -intermediate-safe for doubly nested Safes, the
+// steps of NestedMultisigBuilder.sol and DoubleNestedMultisigBuilder.sol are
+// run instead: the owners of the signer Safe sign its transaction approving
+// the transaction of the Safe it owns. The approvals are then executed bottom
+// up: calldata with -signer-safe and the signatures for every signer Safe,
+// then with -intermediate-safe alone for every intermediate Safe and finally
+// with -safe alone. Past the signer Safes, no signatures are needed as the
+// approvals of the owner Safes are collected onchain.
+//
+// The nonce can be overridden with the SAFE_NONCE_{UPPERCASE_SAFE_ADDRESS}
+// environment variables like with forge, and with SAFE_NONCE if no Safe is
+// nested.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings/multisig"
+	"github.com/base-org/contracts/bindings/signatures"
+	"github.com/base-org/contracts/bindings/simulate"
+)
+
+// call3 is a call of the calls file.
+type call3 struct {
+	Target       common.Address `json:"target"`
+	AllowFailure bool           `json:"allowFailure"`
+	CallData     hexutil.Bytes  `json:"callData"`
+}
+
+func main() {
+	var (
+		rpcURL      = flag.String("rpc", "", "JSON-RPC endpoint of the chain of the Safe")
+		safe        = flag.String("safe", "", "address of the Safe executing the calls")
+		inter       = flag.String("intermediate-safe", "", "address of the intermediate Safe owning -safe, for doubly nested Safes")
+		signerSafe  = flag.String("signer-safe", "", "address of the Safe owning -safe, or the intermediate Safe, for nested Safes")
+		callsPath   = flag.String("calls", "calls.json", "JSON file holding the calls as [{\"target\", \"allowFailure\", \"callData\"}]")
+		nonceOffset = flag.Uint64("nonce-offset", 0, "offset added to the current nonce of the Safe")
+		from        = flag.String("from", "", "address of the signer or executor the transaction is simulated from")
+		sigsHex     = flag.String("signatures", "", "comma-separated hex signatures of the signers, for status, verify, simulate and calldata")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] nonce|sign|status|verify|simulate|calldata\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0), *rpcURL, *safe, *inter, *signerSafe, *callsPath, *nonceOffset, *from, *sigsHex); err != nil && !errors.Is(err, context.Canceled) {
+		log.Crit("Multisig builder failed", "err", err)
+	}
+}
+
+func run(command, rpcURL, safe, inter, signerSafe, callsPath string, nonceOffset uint64, from, sigsHex string) error {
+	// The Safes from -safe to the one whose owners sign.
+	var safes []common.Address
+	for _, s := range []struct{ flag, value string }{{"safe", safe}, {"intermediate-safe", inter}, {"signer-safe", signerSafe}} {
+		if s.value == "" && s.flag != "safe" {
+			continue
+		}
+		if !common.IsHexAddress(s.value) {
+			return fmt.Errorf("invalid -%s address %q", s.flag, s.value)
+		}
+		safes = append(safes, common.HexToAddress(s.value))
+	}
+	configs := make([]multisig.Config, len(safes))
+	for i, address := range safes {
+		nonce, err := multisig.NonceFromEnv(address, len(safes) == 1)
+		if err != nil {
+			return err
+		}
+		configs[i] = multisig.Config{Safe: address, Nonce: nonce}
+	}
+	configs[0].NonceOffset = nonceOffset
+
+	calls, err := readCalls(callsPath)
+	if err != nil {
+		return err
+	}
+	var blobs [][]byte
+	if sigsHex != "" {
+		for _, sig := range strings.Split(sigsHex, ",") {
+			blob, err := hexutil.Decode(strings.TrimSpace(sig))
+			if err != nil {
+				return fmt.Errorf("invalid -signatures: %w", err)
+			}
+			blobs = append(blobs, blob)
+		}
+	}
+	sigs := signatures.Merge(blobs...)
+	var sender common.Address
+	if command == "sign" || command == "simulate" {
+		if !common.IsHexAddress(from) {
+			return fmt.Errorf("invalid -from address %q", from)
+		}
+		sender = common.HexToAddress(from)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", rpcURL, err)
+	}
+	defer client.Close()
+	// builders[i] builds the transaction of safes[i], approving the one of
+	// safes[i-1]. The last one is signed by owners.
+	builders := make([]*multisig.Builder, len(safes))
+	if builders[0], err = multisig.New(configs[0], client, calls); err != nil {
+		return err
+	}
+	for i := 1; i < len(safes); i++ {
+		if builders[i], err = builders[i-1].Approval(ctx, configs[i]); err != nil {
+			return err
+		}
+	}
+	b := builders[len(builders)-1]
+
+	switch command {
+	case "nonce":
+		for i, builder := range builders {
+			nonce, err := builder.Nonce(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Nonce of %s: %s\n", safes[i], nonce)
+		}
+	case "sign":
+		// Simulate first, so that nothing is signed if the transaction fails.
+		// Nested Safes simulate every approval from the signer Safe down.
+		var payload simulate.Payload
+		if len(builders) == 1 {
+			payload, err = b.SignerPayload(ctx, sender)
+		} else {
+			payload, err = builders[0].NestedPayload(ctx, b, sender)
+		}
+		if err != nil {
+			return err
+		}
+		if err := simulatePayload(ctx, client, payload); err != nil {
+			return err
+		}
+		data, hash, err := b.DataToSign(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("---\nIf submitting onchain, call Safe.approveHash on %s with the following hash:\n%s\n", safes[len(safes)-1], hash)
+		fmt.Printf("---\nData to sign:\nvvvvvvvv\n%s\n^^^^^^^^\n\n", hexutil.Encode(data))
+		fmt.Println("########## IMPORTANT ##########")
+		fmt.Println("Please make sure that the 'Data to sign' displayed above matches what you see in the simulation and on your hardware wallet.")
+		fmt.Println("This is a critical step that must not be skipped.")
+		fmt.Println("###############################")
+	case "status":
+		report, err := b.Status(ctx, sigs)
+		if err != nil {
+			return err
+		}
+		for _, signer := range report.Signers {
+			fmt.Println("Signed:", signer)
+		}
+		for _, approver := range report.Approvers {
+			fmt.Println("Approved onchain:", approver)
+		}
+		for _, nonOwner := range report.NonOwners {
+			fmt.Println("Skipped signature of non-owner:", nonOwner)
+		}
+		fmt.Printf("%d of %d signatures, %d missing\n", len(report.Signers), report.Threshold, report.Missing())
+	case "verify":
+		sorted, err := b.Verify(ctx, sigs)
+		if err != nil {
+			return err
+		}
+		fmt.Println("Signatures are valid:", hexutil.Encode(sorted))
+	case "simulate":
+		payload, err := b.ExecPayload(ctx, sender, sigs)
+		if err != nil {
+			return err
+		}
+		return simulatePayload(ctx, client, payload)
+	case "calldata":
+		data, err := b.ExecTransaction(ctx, sigs)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("To: %s\nData: %s\n", safes[len(safes)-1], hexutil.Encode(data))
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+	return nil
+}
+
+func readCalls(path string) ([]multisig.Call3, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var decoded []call3
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	calls := make([]multisig.Call3, len(decoded))
+	for i, c := range decoded {
+		calls[i] = multisig.Call3{Target: c.Target, AllowFailure: c.AllowFailure, CallData: c.CallData}
+	}
+	return calls, nil
+}
+
+// simulatePayload logs the Tenderly link of the payload and simulates it.
+func simulatePayload(ctx context.Context, client *ethclient.Client, payload simulate.Payload) error {
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	link, rawInput := simulate.LinkConfigFromEnv().Link(chainID, payload)
+	fmt.Printf("Simulation link:\n%s\n", link)
+	if rawInput != "" {
+		fmt.Printf("Insert the following hex into the 'Raw input data' field:\n%s\n", rawInput)
+	}
+	sim, err := simulate.New(client, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := sim.SimulatePayload(ctx, payload); err != nil {
+		return err
+	}
+	log.Info("Simulation succeeded", "safe", payload.To, "from", payload.From)
+	return nil
+}
@@ -0,0 +1,127 @@
+// Command revshare-exporter exposes the state of the FeeDisburser on L2 and
+// of the BalanceTracker on L1 as Prometheus metrics.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/base-org/contracts/bindings/exporter"
+)
+
+func main() {
+	var (
+		l2RPC          = flag.String("l2-rpc", "", "L2 JSON-RPC endpoint, required with -fee-disburser")
+		l1RPC          = flag.String("l1-rpc", "", "L1 JSON-RPC endpoint, required with -balance-tracker")
+		feeDisburser   = flag.String("fee-disburser", "", "address of the FeeDisburser contract on L2, empty to not export it")
+		balanceTracker = flag.String("balance-tracker", "", "address of the BalanceTracker contract on L1, empty to not export it")
+		l2Chain        = flag.String("l2-chain", "", "value of the chain label of the FeeDisburser, defaults to the L2 chain ID")
+		l1Chain        = flag.String("l1-chain", "", "value of the chain label of the BalanceTracker, defaults to the L1 chain ID")
+		listenAddr     = flag.String("listen", ":7300", "address to serve the metrics on")
+		pollInterval   = flag.Duration("poll-interval", 15*time.Second, "time between two polls of the contracts")
+	)
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+	if err := run(*l2RPC, *l1RPC, *feeDisburser, *balanceTracker, *l2Chain, *l1Chain, *listenAddr, *pollInterval); err != nil && !errors.Is(err, context.Canceled) {
+		log.Crit("Exporter failed", "err", err)
+	}
+}
+
+func run(l2RPC, l1RPC, feeDisburser, balanceTracker, l2Chain, l1Chain, listenAddr string, pollInterval time.Duration) error {
+	cfg := exporter.Config{L2Chain: l2Chain, L1Chain: l1Chain, PollInterval: pollInterval}
+	if feeDisburser != "" {
+		if !common.IsHexAddress(feeDisburser) {
+			return fmt.Errorf("invalid -fee-disburser address %q", feeDisburser)
+		}
+		address := common.HexToAddress(feeDisburser)
+		cfg.FeeDisburser = &address
+	}
+	if balanceTracker != "" {
+		if !common.IsHexAddress(balanceTracker) {
+			return fmt.Errorf("invalid -balance-tracker address %q", balanceTracker)
+		}
+		address := common.HexToAddress(balanceTracker)
+		cfg.BalanceTracker = &address
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	// A nil *ethclient.Client must not become a non-nil Backend.
+	var l1, l2 exporter.Backend
+	if cfg.FeeDisburser != nil {
+		client, err := dial(ctx, l2RPC, &cfg.L2Chain)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		l2 = client
+	}
+	if cfg.BalanceTracker != nil {
+		client, err := dial(ctx, l1RPC, &cfg.L1Chain)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		l1 = client
+	}
+
+	logger := log.Root().New("exporter", "revshare")
+	registry := prometheus.NewRegistry()
+	e, err := exporter.New(cfg, l1, l2, registry, logger)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:              listenAddr,
+		Handler:           promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errc := make(chan error, 1)
+	go func() {
+		errc <- server.ListenAndServe()
+	}()
+	defer server.Shutdown(context.Background())
+
+	logger.Info("Starting exporter", "listen", listenAddr, "l2Chain", cfg.L2Chain, "l1Chain", cfg.L1Chain)
+	go e.Run(ctx)
+	select {
+	case err := <-errc:
+		return fmt.Errorf("metrics server failed: %w", err)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// dial connects to the endpoint at url and, if chain is empty, sets it to the
+// chain ID.
+func dial(ctx context.Context, url string, chain *string) (*ethclient.Client, error) {
+	client, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", url, err)
+	}
+	if *chain == "" {
+		chainID, err := client.ChainID(ctx)
+		if err != nil {
+			client.Close()
+			return nil, fmt.Errorf("failed to fetch chain ID: %w", err)
+		}
+		*chain = chainID.String()
+	}
+	return client, nil
+}
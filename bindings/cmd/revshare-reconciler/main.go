@@ -0,0 +1,130 @@
+// Command revshare-reconciler checks that the L1 share of the disbursements
+// indexed by fee-indexer reached L1. Each disbursement is paired with the
+// withdrawal bridging its L1 share, whose proof and finalization are looked up
+// on L1. It exits with an error if a withdrawal is missing, stuck or doesn't
+// bridge the L1 share, so that it can be run periodically and alerted on.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/indexer"
+	"github.com/base-org/contracts/bindings/reconcile"
+	"github.com/base-org/contracts/bindings/withdrawals"
+)
+
+func main() {
+	var (
+		l2RPC            = flag.String("l2-rpc", "", "L2 JSON-RPC endpoint")
+		l1RPC            = flag.String("l1-rpc", "", "L1 JSON-RPC endpoint")
+		feeDisburser     = flag.String("fee-disburser", "", "address of the FeeDisburser contract")
+		dbPath           = flag.String("db", "fee-indexer.db", "SQLite database of fee-indexer")
+		portal           = flag.String("optimism-portal", "", "address of the OptimismPortal proxy on L1")
+		l1Bridge         = flag.String("l1-standard-bridge", "", "address of the L1StandardBridge proxy on L1")
+		fromBlock        = flag.Uint64("from-block", 0, "first L2 block whose disbursements are checked")
+		toBlock          = flag.Uint64("to-block", math.MaxInt64, "last L2 block whose disbursements are checked")
+		l1StartBlock     = flag.Uint64("l1-start-block", 0, "first L1 block searched for proofs and finalizations")
+		chunkSize        = flag.Uint64("chunk-size", 2000, "largest number of L1 blocks whose logs are fetched at once")
+		hashBatchSize    = flag.Int("hash-batch-size", withdrawals.DefaultHashBatchSize, "largest number of withdrawal hashes L1 logs are filtered by at once")
+		proveDeadline    = flag.Duration("prove-deadline", 24*time.Hour, "time after a disbursement its withdrawal must be proven by")
+		finalizeDeadline = flag.Duration("finalize-deadline", 8*24*time.Hour, "time after its proof a withdrawal must be finalized by")
+	)
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+	trackerCfg := withdrawals.TrackerConfig{StartBlock: *l1StartBlock, ChunkSize: *chunkSize, HashBatchSize: *hashBatchSize}
+	cfg := reconcile.Config{ProveDeadline: *proveDeadline, FinalizeDeadline: *finalizeDeadline}
+	if err := run(*l2RPC, *l1RPC, *feeDisburser, *dbPath, *portal, *l1Bridge, *fromBlock, *toBlock, trackerCfg, cfg); err != nil && !errors.Is(err, context.Canceled) {
+		log.Crit("Reconciliation failed", "err", err)
+	}
+}
+
+func run(l2RPC, l1RPC, feeDisburser, dbPath, portal, l1Bridge string, fromBlock, toBlock uint64, trackerCfg withdrawals.TrackerConfig, cfg reconcile.Config) error {
+	for _, a := range []struct{ flag, value string }{{"fee-disburser", feeDisburser}, {"optimism-portal", portal}, {"l1-standard-bridge", l1Bridge}} {
+		if !common.IsHexAddress(a.value) {
+			return fmt.Errorf("invalid -%s address %q", a.flag, a.value)
+		}
+	}
+	trackerCfg.OptimismPortal = common.HexToAddress(portal)
+	trackerCfg.L1StandardBridge = common.HexToAddress(l1Bridge)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	l2, err := ethclient.DialContext(ctx, l2RPC)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", l2RPC, err)
+	}
+	defer l2.Close()
+	feeDisburserCaller, err := bindings.NewFeeDisburserCaller(common.HexToAddress(feeDisburser), l2)
+	if err != nil {
+		return err
+	}
+	if cfg.L1Wallet, err = feeDisburserCaller.L1WALLET(&bind.CallOpts{Context: ctx}); err != nil {
+		return fmt.Errorf("failed to fetch L1 wallet: %w", err)
+	}
+	l1, err := ethclient.DialContext(ctx, l1RPC)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", l1RPC, err)
+	}
+	defer l1.Close()
+	db, err := indexer.Open(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	disbursements, err := db.Disbursements(ctx, common.HexToAddress(feeDisburser), fromBlock, toBlock)
+	if err != nil {
+		return err
+	}
+	tracker, err := withdrawals.NewTracker(trackerCfg, l1)
+	if err != nil {
+		return err
+	}
+	entries, err := reconcile.New(cfg, l2, tracker).Reconcile(ctx, disbursements, nil)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, e := range entries {
+		d := e.Disbursement
+		status := "no withdrawal"
+		switch {
+		case e.Status == nil:
+		case e.Status.Finalized:
+			status = "finalized in " + e.Status.FinalizeTx.Hex()
+		case e.Status.Proven:
+			status = "proven in " + e.Status.ProveTx.Hex()
+		default:
+			status = "initiated"
+		}
+		fmt.Printf("%d %s l1Share=%s %s\n", d.BlockNumber, d.TxHash, d.L1Share, status)
+		for _, problem := range e.Problems {
+			log.Error("Disbursement not reconciled", "block", d.BlockNumber, "tx", d.TxHash, "err", problem)
+		}
+		if !e.OK() {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d disbursements not reconciled", failed, len(entries))
+	}
+	log.Info("All disbursements reconciled", "disbursements", len(entries))
+	return nil
+}
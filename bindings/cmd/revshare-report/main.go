@@ -0,0 +1,121 @@
+// Command revshare-report writes the revenue report of a FeeDisburser for a
+// date range from the events indexed by fee-indexer: the fees received from
+// the sequencer, base and L1 fee vaults, and for every disbursement whether
+// Optimism was paid its share of the net or of the gross revenue. The report
+// is written as CSV, one row per disbursement, and as JSON including every
+// FeesReceived event, all with their block and timestamp.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings/indexer"
+	"github.com/base-org/contracts/bindings/report"
+	"github.com/base-org/contracts/bindings/revshare"
+)
+
+const dateLayout = "2006-01-02"
+
+func main() {
+	var (
+		dbPath        = flag.String("db", "fee-indexer.db", "SQLite database of fee-indexer")
+		feeDisburser  = flag.String("fee-disburser", "", "address of the FeeDisburser contract")
+		from          = flag.String("from", "", "first day of the report, as YYYY-MM-DD in UTC")
+		to            = flag.String("to", "", "day after the last day of the report, as YYYY-MM-DD in UTC")
+		netShareBps   = flag.Int64("net-share-bps", 1500, "OPTIMISM_NET_REVENUE_SHARE_BASIS_POINTS of the FeeDisburser")
+		grossShareBps = flag.Int64("gross-share-bps", 250, "OPTIMISM_GROSS_REVENUE_SHARE_BASIS_POINTS of the FeeDisburser")
+		scale         = flag.Int64("basis-point-scale", 10_000, "BASIS_POINT_SCALE of the FeeDisburser")
+		csvPath       = flag.String("csv", "", "file to write the CSV report to, - for stdout, empty to skip")
+		jsonPath      = flag.String("json", "", "file to write the JSON report to, - for stdout, empty to skip")
+	)
+	flag.Parse()
+
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+	params := revshare.Params{
+		NetShareBasisPoints:   big.NewInt(*netShareBps),
+		GrossShareBasisPoints: big.NewInt(*grossShareBps),
+		BasisPointScale:       big.NewInt(*scale),
+	}
+	if err := run(*dbPath, *feeDisburser, *from, *to, params, *csvPath, *jsonPath); err != nil && !errors.Is(err, context.Canceled) {
+		log.Crit("Report failed", "err", err)
+	}
+}
+
+func run(dbPath, feeDisburser, from, to string, params revshare.Params, csvPath, jsonPath string) error {
+	if !common.IsHexAddress(feeDisburser) {
+		return fmt.Errorf("invalid -fee-disburser address %q", feeDisburser)
+	}
+	start, err := time.Parse(dateLayout, from)
+	if err != nil {
+		return fmt.Errorf("invalid -from date %q: %w", from, err)
+	}
+	end, err := time.Parse(dateLayout, to)
+	if err != nil {
+		return fmt.Errorf("invalid -to date %q: %w", to, err)
+	}
+	if !end.After(start) {
+		return fmt.Errorf("-to date %s not after -from date %s", to, from)
+	}
+	if csvPath == "" && jsonPath == "" {
+		return errors.New("neither -csv nor -json is set")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	db, err := indexer.Open(dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	r, err := report.Build(ctx, db, common.HexToAddress(feeDisburser), params, start, end)
+	if err != nil {
+		return err
+	}
+	for _, d := range r.Disbursements {
+		if !d.Consistent {
+			log.Warn("Recomputed split doesn't match the disbursement", "block", d.BlockNumber, "tx", d.TxHash, "optimismShare", d.OptimismShare, "netShare", d.NetShare, "grossShare", d.GrossShare)
+		}
+	}
+	if csvPath != "" {
+		if err := write(csvPath, r.WriteCSV); err != nil {
+			return err
+		}
+	}
+	if jsonPath != "" {
+		if err := write(jsonPath, r.WriteJSON); err != nil {
+			return err
+		}
+	}
+	log.Info("Wrote report", "disbursements", len(r.Disbursements), "gross", r.GrossRevenue, "optimismShare", r.OptimismShare, "l1Share", r.L1Share)
+	return nil
+}
+
+// write writes to the file at path, or to stdout if path is -.
+func write(path string, fn func(io.Writer) error) error {
+	if path == "-" {
+		return fn(os.Stdout)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if err := fn(f); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,70 @@
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// MinFeeDisbursementInterval is the minimum interval between fee disbursements
+// accepted by the FeeDisburser constructor.
+const MinFeeDisbursementInterval = 24 * time.Hour
+
+// ErrBytecodeUnavailable is returned when deploying a contract whose binding was
+// generated without creation bytecode.
+var ErrBytecodeUnavailable = errors.New("bindings: creation bytecode not embedded, regenerate bindings from forge artifacts")
+
+// DeployBalanceTracker deploys a new BalanceTracker contract, binding an instance of BalanceTracker to it.
+// The constructor arguments are validated before the deployment is sent, returning the
+// sentinel error of the revert reason the constructor would fail with.
+func DeployBalanceTracker(auth *bind.TransactOpts, backend bind.ContractBackend, profitWallet common.Address) (common.Address, *types.Transaction, *BalanceTracker, error) {
+	if profitWallet == (common.Address{}) {
+		return common.Address{}, nil, nil, ErrBalanceTrackerProfitWalletZero
+	}
+
+	address, tx, contract, err := deployContract(auth, backend, BalanceTrackerMetaData, profitWallet)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &BalanceTracker{BalanceTrackerCaller: BalanceTrackerCaller{contract: contract}, BalanceTrackerTransactor: BalanceTrackerTransactor{contract: contract}, BalanceTrackerFilterer: BalanceTrackerFilterer{contract: contract}}, nil
+}
+
+// DeployFeeDisburser deploys a new FeeDisburser contract, binding an instance of FeeDisburser to it.
+// The constructor arguments are validated before the deployment is sent, returning the
+// sentinel error of the revert reason the constructor would fail with.
+func DeployFeeDisburser(auth *bind.TransactOpts, backend bind.ContractBackend, optimismWallet common.Address, l1Wallet common.Address, feeDisbursementInterval *big.Int) (common.Address, *types.Transaction, *FeeDisburser, error) {
+	if optimismWallet == (common.Address{}) {
+		return common.Address{}, nil, nil, ErrFeeDisburserOptimismWalletZero
+	}
+	if l1Wallet == (common.Address{}) {
+		return common.Address{}, nil, nil, ErrFeeDisburserL1WalletZero
+	}
+	if feeDisbursementInterval == nil || feeDisbursementInterval.Cmp(big.NewInt(int64(MinFeeDisbursementInterval/time.Second))) < 0 {
+		return common.Address{}, nil, nil, ErrFeeDisburserIntervalTooShort
+	}
+
+	address, tx, contract, err := deployContract(auth, backend, FeeDisburserMetaData, optimismWallet, l1Wallet, feeDisbursementInterval)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &FeeDisburser{FeeDisburserCaller: FeeDisburserCaller{contract: contract}, FeeDisburserTransactor: FeeDisburserTransactor{contract: contract}, FeeDisburserFilterer: FeeDisburserFilterer{contract: contract}}, nil
+}
+
+// deployContract sends the creation transaction for the contract described by metadata.
+func deployContract(auth *bind.TransactOpts, backend bind.ContractBackend, metadata *bind.MetaData, params ...interface{}) (common.Address, *types.Transaction, *bind.BoundContract, error) {
+	if metadata.Bin == "" {
+		return common.Address{}, nil, nil, ErrBytecodeUnavailable
+	}
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+	return bind.DeployContract(auth, *parsed, common.FromHex(metadata.Bin), backend, params...)
+}
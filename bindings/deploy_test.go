@@ -0,0 +1,124 @@
+package bindings
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestDeployBalanceTrackerValidatesArguments(t *testing.T) {
+	_, _, _, err := DeployBalanceTracker(nil, nil, common.Address{})
+	if err == nil || err.Error() != "BalanceTracker: PROFIT_WALLET cannot be address(0)" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeployFeeDisburserValidatesArguments(t *testing.T) {
+	wallet := common.HexToAddress("0x1")
+	day := big.NewInt(24 * 60 * 60)
+
+	tests := []struct {
+		name           string
+		optimismWallet common.Address
+		l1Wallet       common.Address
+		interval       *big.Int
+		want           string
+	}{
+		{"zero optimism wallet", common.Address{}, wallet, day, "FeeDisburser: OptimismWallet cannot be address(0)"},
+		{"zero l1 wallet", wallet, common.Address{}, day, "FeeDisburser: L1Wallet cannot be address(0)"},
+		{"interval too short", wallet, wallet, new(big.Int).Sub(day, common.Big1), "FeeDisburser: FeeDisbursementInterval cannot be less than 24 hours"},
+		{"missing interval", wallet, wallet, nil, "FeeDisburser: FeeDisbursementInterval cannot be less than 24 hours"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, _, err := DeployFeeDisburser(nil, nil, tt.optimismWallet, tt.l1Wallet, tt.interval)
+			if err == nil || err.Error() != tt.want {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestDeployBalanceTrackerSimulated(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{deployer: {Balance: big.NewInt(params.Ether)}})
+	defer backend.Close()
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profitWallet := common.HexToAddress("0x2")
+	_, _, tracker, err := DeployBalanceTracker(auth, backend.Client(), profitWallet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	got, err := tracker.PROFITWALLET(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != profitWallet {
+		t.Fatalf("PROFIT_WALLET = %s, want %s", got, profitWallet)
+	}
+	// The constructor disables the initializers of the implementation.
+	if _, err := tracker.Initialize(auth, nil, nil); err == nil {
+		t.Fatal("initialize of the implementation didn't revert")
+	}
+}
+
+func TestDeployFeeDisburserSimulated(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{deployer: {Balance: big.NewInt(params.Ether)}})
+	defer backend.Close()
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	optimismWallet, l1Wallet := common.HexToAddress("0x2"), common.HexToAddress("0x3")
+	interval := big.NewInt(int64(MinFeeDisbursementInterval / time.Second))
+	_, _, disburser, err := DeployFeeDisburser(auth, backend.Client(), optimismWallet, l1Wallet, interval)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	got, err := disburser.OPTIMISMWALLET(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != optimismWallet {
+		t.Fatalf("OPTIMISM_WALLET = %s, want %s", got, optimismWallet)
+	}
+	got, err = disburser.L1WALLET(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != l1Wallet {
+		t.Fatalf("L1_WALLET = %s, want %s", got, l1Wallet)
+	}
+	period, err := disburser.FEEDISBURSEMENTINTERVAL(&bind.CallOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if period.Cmp(interval) != 0 {
+		t.Fatalf("FEE_DISBURSEMENT_INTERVAL = %s, want %s", period, interval)
+	}
+}
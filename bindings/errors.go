@@ -0,0 +1,46 @@
+package bindings
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// unpackCustomError matches the 4-byte selector of the given revert data against
+// the custom errors declared in parsed and decodes the error arguments.
+func unpackCustomError(parsed *abi.ABI, data []byte) (*abi.Error, []interface{}, bool) {
+	if len(data) < 4 {
+		return nil, nil, false
+	}
+	for name := range parsed.Errors {
+		abiErr := parsed.Errors[name]
+		if !bytes.Equal(data[:4], abiErr.ID[:4]) {
+			continue
+		}
+		args, err := abiErr.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, nil, false
+		}
+		return &abiErr, args, true
+	}
+	return nil, nil, false
+}
+
+// copyCustomError decodes revert data into the typed error constructed by the
+// targets entry registered under the custom error's name. Constructors must
+// return a pointer to a struct whose fields match the error arguments.
+func copyCustomError(parsed *abi.ABI, data []byte, targets map[string]func() error) (error, bool) {
+	abiErr, args, ok := unpackCustomError(parsed, data)
+	if !ok {
+		return nil, false
+	}
+	newTarget, ok := targets[abiErr.Name]
+	if !ok {
+		return nil, false
+	}
+	target := newTarget()
+	if err := abiErr.Inputs.Copy(target, args); err != nil {
+		return nil, false
+	}
+	return target, true
+}
@@ -0,0 +1,238 @@
+package bindings
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+// ErrUnknownEvent is returned when no registered contract declares an event
+// matching a log.
+var ErrUnknownEvent = errors.New("bindings: unknown event")
+
+// Event is a log decoded by an EventDecoder.
+type Event struct {
+	// Contract is the name of the contract declaring the event.
+	Contract string
+	// Name is the name of the event.
+	Name string
+	// Value is the typed event of the contract's binding, e.g.
+	// *FeeDisburserFeesDisbursed, nil for contracts registered without one.
+	Value interface{}
+	// Args holds the event arguments by name, indexed ones included.
+	Args map[string]interface{}
+	Raw  types.Log
+}
+
+// eventType is an event declared by a registered contract.
+type eventType struct {
+	contract string
+	event    abi.Event
+	// parse is the Parse method of the contract's filterer, invalid if the
+	// contract has no binding.
+	parse reflect.Value
+}
+
+// EventDecoder decodes logs of any registered contract by their topic0.
+type EventDecoder struct {
+	types map[common.Hash][]*eventType
+	// contracts is the contract deployed at an address, used to tell apart
+	// events with the same signature declared by several contracts.
+	contracts map[common.Address]string
+}
+
+// NewEventDecoder creates an EventDecoder without any registered contract.
+func NewEventDecoder() *EventDecoder {
+	return &EventDecoder{
+		types:     make(map[common.Hash][]*eventType),
+		contracts: make(map[common.Address]string),
+	}
+}
+
+// Register adds the events declared in metadata under the contract name.
+// Values are decoded by the Parse<Event> methods of filterer, a contract
+// filterer of the generated bindings such as *FeeDisburserFilterer. A nil
+// filterer registers a contract without binding, whose events only have Args.
+func (d *EventDecoder) Register(contract string, metadata *bind.MetaData, filterer interface{}) error {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return err
+	}
+	for _, event := range parsed.Events {
+		var parse reflect.Value
+		if filterer != nil {
+			parse = reflect.ValueOf(filterer).MethodByName("Parse" + abi.ToCamelCase(event.Name))
+			if !parse.IsValid() {
+				return fmt.Errorf("bindings: %T has no parse method for %s", filterer, event.Name)
+			}
+		}
+		d.types[event.ID] = append(d.types[event.ID], &eventType{contract: contract, event: event, parse: parse})
+	}
+	return nil
+}
+
+// Bind records that the named contract is deployed at address. Logs of events
+// declared by several contracts, like Initialized, are then decoded as the
+// event of the contract deployed at their address.
+func (d *EventDecoder) Bind(address common.Address, contract string) {
+	d.contracts[address] = contract
+}
+
+// Decode decodes a log, or returns ErrUnknownEvent. A log whose first topic
+// matches an event but whose number of topics doesn't, like the log of another
+// contract declaring an event of the same signature with other indexed
+// arguments, is unknown too.
+func (d *EventDecoder) Decode(log types.Log) (*Event, error) {
+	if len(log.Topics) == 0 {
+		return nil, ErrUnknownEvent
+	}
+	candidates := d.types[log.Topics[0]]
+	// Prefer the contract bound to the address of the log.
+	if contract, ok := d.contracts[log.Address]; ok {
+		for _, t := range candidates {
+			if t.contract == contract {
+				candidates = []*eventType{t}
+				break
+			}
+		}
+	}
+	var lastErr error = ErrUnknownEvent
+	for _, t := range candidates {
+		ev, err := t.decode(log)
+		if err == nil {
+			return ev, nil
+		}
+		// Report why a matching event failed to decode over a mismatch.
+		if errors.Is(lastErr, ErrUnknownEvent) {
+			lastErr = err
+		}
+	}
+	return nil, lastErr
+}
+
+// DecodeReceipt decodes every log of a receipt. Logs of unknown events are
+// returned with a nil Value and Args and only their Raw log set.
+func (d *EventDecoder) DecodeReceipt(receipt *types.Receipt) ([]*Event, error) {
+	events := make([]*Event, 0, len(receipt.Logs))
+	for _, log := range receipt.Logs {
+		ev, err := d.Decode(*log)
+		if errors.Is(err, ErrUnknownEvent) {
+			ev = &Event{Raw: *log}
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode log %d: %w", log.Index, err)
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+func (t *eventType) decode(log types.Log) (*Event, error) {
+	var indexed abi.Arguments
+	for _, arg := range t.event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if len(log.Topics) != len(indexed)+1 {
+		return nil, fmt.Errorf("%w: %s.%s expects %d topics, got %d", ErrUnknownEvent, t.contract, t.event.Name, len(indexed)+1, len(log.Topics))
+	}
+	args := make(map[string]interface{})
+	if err := t.event.Inputs.UnpackIntoMap(args, log.Data); err != nil {
+		return nil, fmt.Errorf("%s.%s: %w", t.contract, t.event.Name, err)
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("%s.%s: %w", t.contract, t.event.Name, err)
+	}
+	ev := &Event{
+		Contract: t.contract,
+		Name:     t.event.Name,
+		Args:     args,
+		Raw:      log,
+	}
+	if t.parse.IsValid() {
+		out := t.parse.Call([]reflect.Value{reflect.ValueOf(log)})
+		if err, _ := out[1].Interface().(error); err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", t.contract, t.event.Name, err)
+		}
+		ev.Value = out[0].Interface()
+	}
+	return ev, nil
+}
+
+var (
+	defaultDecoderOnce sync.Once
+	defaultDecoder     *EventDecoder
+	defaultDecoderErr  error
+)
+
+// DefaultEventDecoder returns an EventDecoder with every contract of this
+// package and the predeploys registered. It must not be modified, create one
+// with NewEventDecoder to Bind addresses.
+func DefaultEventDecoder() (*EventDecoder, error) {
+	defaultDecoderOnce.Do(func() {
+		defaultDecoder = NewEventDecoder()
+		if defaultDecoderErr = defaultDecoder.RegisterAll(); defaultDecoderErr != nil {
+			defaultDecoder = nil
+		}
+	})
+	return defaultDecoder, defaultDecoderErr
+}
+
+// RegisterAll registers every contract of this package, and the predeploys a
+// disbursement goes through bound to their addresses.
+func (d *EventDecoder) RegisterAll() error {
+	contracts := []struct {
+		name     string
+		metadata *bind.MetaData
+		filterer func() (interface{}, error)
+	}{
+		{"BalanceTracker", BalanceTrackerMetaData, func() (interface{}, error) { return NewBalanceTrackerFilterer(common.Address{}, nil) }},
+		{"Challenger1of2", Challenger1of2MetaData, func() (interface{}, error) { return NewChallenger1of2Filterer(common.Address{}, nil) }},
+		{"FeeDisburser", FeeDisburserMetaData, func() (interface{}, error) { return NewFeeDisburserFilterer(common.Address{}, nil) }},
+		{"FeeVault", FeeVaultMetaData, func() (interface{}, error) { return NewFeeVaultFilterer(common.Address{}, nil) }},
+		{"IGnosisSafe", IGnosisSafeMetaData, func() (interface{}, error) { return NewIGnosisSafeFilterer(common.Address{}, nil) }},
+		{"SmartEscrow", SmartEscrowMetaData, func() (interface{}, error) { return NewSmartEscrowFilterer(common.Address{}, nil) }},
+		{"Vetoer1of2", Vetoer1of2MetaData, func() (interface{}, error) { return NewVetoer1of2Filterer(common.Address{}, nil) }},
+		{"L2StandardBridge", L2StandardBridgeEventsMetaData, noFilterer},
+		{"L2CrossDomainMessenger", L2CrossDomainMessengerEventsMetaData, noFilterer},
+		{"L2ToL1MessagePasser", L2ToL1MessagePasserEventsMetaData, noFilterer},
+	}
+	for _, c := range contracts {
+		filterer, err := c.filterer()
+		if err != nil {
+			return err
+		}
+		if err := d.Register(c.name, c.metadata, filterer); err != nil {
+			return err
+		}
+	}
+	d.Bind(predeploys.L2StandardBridge, "L2StandardBridge")
+	d.Bind(predeploys.L2CrossDomainMessenger, "L2CrossDomainMessenger")
+	d.Bind(predeploys.L2ToL1MessagePasser, "L2ToL1MessagePasser")
+	for _, vault := range predeploys.FeeVaults {
+		d.Bind(vault, "FeeVault")
+	}
+	return nil
+}
+
+// noFilterer registers a contract without binding.
+func noFilterer() (interface{}, error) {
+	return nil, nil
+}
+
+// DecodeEvent decodes a log of any contract of this package.
+func DecodeEvent(log types.Log) (*Event, error) {
+	d, err := DefaultEventDecoder()
+	if err != nil {
+		return nil, err
+	}
+	return d.Decode(log)
+}
@@ -0,0 +1,168 @@
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+func TestDecodeReceipt(t *testing.T) {
+	feeDisburser, err := FeeDisburserMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	balanceTracker, err := BalanceTrackerMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	disbursed := feeDisburser.Events["FeesDisbursed"]
+	disbursedData, err := disbursed.Inputs.Pack(big.NewInt(1_700_000_000), big.NewInt(30), big.NewInt(70))
+	if err != nil {
+		t.Fatal(err)
+	}
+	processed := balanceTracker.Events["ProcessedFunds"]
+	processedData, err := processed.Inputs.NonIndexed().Pack(big.NewInt(5), big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	batcher := common.HexToAddress("0xba")
+	receipt := &types.Receipt{Logs: []*types.Log{
+		{Topics: []common.Hash{disbursed.ID}, Data: disbursedData, Index: 0},
+		{Topics: []common.Hash{processed.ID, common.BytesToHash(batcher.Bytes()), common.BigToHash(big.NewInt(1))}, Data: processedData, Index: 1},
+		{Topics: []common.Hash{common.HexToHash("0xdead")}, Index: 2},
+		// Same signature as ProcessedFunds, but nothing indexed.
+		{Topics: []common.Hash{processed.ID}, Data: processedData, Index: 3},
+	}}
+
+	d, err := DefaultEventDecoder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := d.DecodeReceipt(receipt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 4 {
+		t.Fatalf("got %d events, want 4", len(events))
+	}
+
+	fees, ok := events[0].Value.(*FeeDisburserFeesDisbursed)
+	if !ok || events[0].Contract != "FeeDisburser" || events[0].Name != "FeesDisbursed" {
+		t.Fatalf("unexpected event %s.%s (%T)", events[0].Contract, events[0].Name, events[0].Value)
+	}
+	if fees.PaidToOptimism.Int64() != 30 || fees.TotalFeesDisbursed.Int64() != 70 {
+		t.Fatalf("unexpected FeesDisbursed values: %+v", fees)
+	}
+
+	funds, ok := events[1].Value.(*BalanceTrackerProcessedFunds)
+	if !ok || funds.SystemAddress != batcher || !funds.Success || funds.BalanceSent.Int64() != 5 {
+		t.Fatalf("unexpected ProcessedFunds event: %+v", events[1].Value)
+	}
+	if events[1].Args["_systemAddress"] != batcher || events[1].Args["_success"] != true {
+		t.Fatalf("unexpected ProcessedFunds args: %v", events[1].Args)
+	}
+
+	if events[2].Value != nil || events[2].Raw.Index != 2 {
+		t.Fatalf("expected the unknown log to be returned undecoded, got %+v", events[2])
+	}
+	if _, err := d.Decode(*receipt.Logs[2]); !errors.Is(err, ErrUnknownEvent) {
+		t.Fatalf("expected ErrUnknownEvent, got %v", err)
+	}
+	if events[3].Value != nil || events[3].Raw.Index != 3 {
+		t.Fatalf("expected the log with mismatching topics to be returned undecoded, got %+v", events[3])
+	}
+	if _, err := d.Decode(*receipt.Logs[3]); !errors.Is(err, ErrUnknownEvent) {
+		t.Fatalf("expected ErrUnknownEvent, got %v", err)
+	}
+}
+
+func TestEventDecoderBind(t *testing.T) {
+	filterer, err := NewFeeDisburserFilterer(common.Address{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewEventDecoder()
+	for _, name := range []string{"Sequencer", "Base"} {
+		if err := d.Register(name, FeeDisburserMetaData, filterer); err != nil {
+			t.Fatal(err)
+		}
+	}
+	parsed, err := FeeDisburserMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := common.HexToAddress("0xfd")
+	log := types.Log{Address: address, Topics: []common.Hash{parsed.Events["NoFeesCollected"].ID}}
+
+	ev, err := d.Decode(log)
+	if err != nil || ev.Contract != "Sequencer" {
+		t.Fatalf("expected the first registered contract, got %+v, %v", ev, err)
+	}
+	d.Bind(address, "Base")
+	ev, err = d.Decode(log)
+	if err != nil || ev.Contract != "Base" {
+		t.Fatalf("expected the bound contract, got %+v, %v", ev, err)
+	}
+}
+
+func TestDecodePredeployEvents(t *testing.T) {
+	feeVault, err := FeeVaultMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bridge, err := L2StandardBridgeEventsMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	passer, err := L2ToL1MessagePasserEventsMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	feeDisburser := common.HexToAddress("0xfd")
+	l1Wallet := common.HexToAddress("0x11")
+	l1Messenger := common.HexToAddress("0x1c")
+
+	withdrawal := feeVault.Events["Withdrawal0"]
+	withdrawalData, err := withdrawal.Inputs.Pack(big.NewInt(100), feeDisburser, predeploys.SequencerFeeVault, uint8(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	initiated := bridge.Events["ETHBridgeInitiated"]
+	initiatedData, err := initiated.Inputs.NonIndexed().Pack(big.NewInt(85), []byte{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	passed := passer.Events["MessagePassed"]
+	passedData, err := passed.Inputs.NonIndexed().Pack(big.NewInt(85), big.NewInt(200_000), []byte{0x1}, common.HexToHash("0xaa"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt := &types.Receipt{Logs: []*types.Log{
+		{Address: predeploys.SequencerFeeVault, Topics: []common.Hash{withdrawal.ID}, Data: withdrawalData},
+		{Address: predeploys.L2StandardBridge, Topics: []common.Hash{initiated.ID, common.BytesToHash(feeDisburser.Bytes()), common.BytesToHash(l1Wallet.Bytes())}, Data: initiatedData},
+		{Address: predeploys.L2ToL1MessagePasser, Topics: []common.Hash{passed.ID, common.BigToHash(big.NewInt(7)), common.BytesToHash(predeploys.L2CrossDomainMessenger.Bytes()), common.BytesToHash(l1Messenger.Bytes())}, Data: passedData},
+	}}
+
+	d, err := DefaultEventDecoder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := d.DecodeReceipt(receipt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w, ok := events[0].Value.(*FeeVaultWithdrawal0); !ok || events[0].Contract != "FeeVault" || w.Value.Int64() != 100 || w.To != feeDisburser {
+		t.Fatalf("unexpected fee vault event %s.%s: %+v", events[0].Contract, events[0].Name, events[0].Value)
+	}
+	if ev := events[1]; ev.Contract != "L2StandardBridge" || ev.Name != "ETHBridgeInitiated" || ev.Value != nil || ev.Args["to"] != l1Wallet || ev.Args["amount"].(*big.Int).Int64() != 85 {
+		t.Fatalf("unexpected bridge event %s.%s: %v", ev.Contract, ev.Name, ev.Args)
+	}
+	if ev := events[2]; ev.Contract != "L2ToL1MessagePasser" || ev.Name != "MessagePassed" || ev.Args["sender"] != predeploys.L2CrossDomainMessenger || ev.Args["withdrawalHash"] != [32]byte(common.HexToHash("0xaa")) {
+		t.Fatalf("unexpected message passer event %s.%s: %v", ev.Contract, ev.Name, ev.Args)
+	}
+}
@@ -0,0 +1,236 @@
+// Package exporter polls the state of the revenue-share contracts and exposes
+// it as Prometheus metrics.
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+const namespace = "revshare"
+
+// Backend is the chain access needed by the Exporter.
+type Backend interface {
+	bind.ContractCaller
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Config configures an Exporter.
+type Config struct {
+	// L2Chain is the chain label of the FeeDisburser metrics, usually the L2
+	// chain ID.
+	L2Chain string
+	// L1Chain is the chain label of the BalanceTracker metrics, usually the
+	// L1 chain ID.
+	L1Chain string
+	// FeeDisburser is the address of the FeeDisburser on L2, nil to not
+	// export it.
+	FeeDisburser *common.Address
+	// BalanceTracker is the address of the BalanceTracker on L1, nil to not
+	// export it.
+	BalanceTracker *common.Address
+	// PollInterval is the time between two polls.
+	PollInterval time.Duration
+}
+
+// Exporter polls the revenue-share contracts and updates their metrics.
+type Exporter struct {
+	cfg            Config
+	l1             Backend
+	l2             Backend
+	feeDisburser   *bindings.FeeDisburserCaller
+	balanceTracker *bindings.BalanceTrackerCaller
+	log            log.Logger
+
+	// systemAddresses are the system addresses exported by the last poll, to
+	// drop the series of those removed from the BalanceTracker.
+	systemAddresses map[common.Address]bool
+
+	netFeeRevenue        *prometheus.GaugeVec
+	lastDisbursementTime *prometheus.GaugeVec
+	untilDisbursement    *prometheus.GaugeVec
+	balance              *prometheus.GaugeVec
+	targetBalance        *prometheus.GaugeVec
+	systemAddressBalance *prometheus.GaugeVec
+	lastPollTime         *prometheus.GaugeVec
+	pollErrors           *prometheus.CounterVec
+}
+
+// New creates an Exporter reading the FeeDisburser from l2 and the
+// BalanceTracker from l1, and registers its metrics with registry. The backend
+// of a contract that is not exported may be nil.
+func New(cfg Config, l1, l2 Backend, registry prometheus.Registerer, logger log.Logger) (*Exporter, error) {
+	if cfg.FeeDisburser == nil && cfg.BalanceTracker == nil {
+		return nil, errors.New("exporter: no contract to export")
+	}
+	if cfg.FeeDisburser != nil && l2 == nil {
+		return nil, errors.New("exporter: no L2 backend to export the FeeDisburser from")
+	}
+	if cfg.BalanceTracker != nil && l1 == nil {
+		return nil, errors.New("exporter: no L1 backend to export the BalanceTracker from")
+	}
+	e := &Exporter{
+		cfg:             cfg,
+		l1:              l1,
+		l2:              l2,
+		log:             logger,
+		systemAddresses: make(map[common.Address]bool),
+	}
+	if cfg.FeeDisburser != nil {
+		contract, err := bindings.NewFeeDisburserCaller(*cfg.FeeDisburser, l2)
+		if err != nil {
+			return nil, err
+		}
+		e.feeDisburser = contract
+	}
+	if cfg.BalanceTracker != nil {
+		contract, err := bindings.NewBalanceTrackerCaller(*cfg.BalanceTracker, l1)
+		if err != nil {
+			return nil, err
+		}
+		e.balanceTracker = contract
+	}
+
+	labels := []string{"chain", "contract"}
+	systemAddressLabels := []string{"chain", "contract", "system_address"}
+	gauge := func(subsystem, name, help string, labels []string) *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts{Namespace: namespace, Subsystem: subsystem, Name: name, Help: help}, labels)
+	}
+	e.netFeeRevenue = gauge("fee_disburser", "net_fee_revenue_wei", "Net fee revenue collected by the FeeDisburser since the last disbursement.", labels)
+	e.lastDisbursementTime = gauge("fee_disburser", "last_disbursement_timestamp_seconds", "Timestamp of the last disbursement.", labels)
+	e.untilDisbursement = gauge("fee_disburser", "seconds_until_disbursement", "Seconds until disburseFees may be called again, negative once overdue.", labels)
+	e.balance = gauge("", "contract_balance_wei", "Balance of the contract.", labels)
+	e.targetBalance = gauge("balance_tracker", "target_balance_wei", "Target balance of a system address funded by the BalanceTracker.", systemAddressLabels)
+	e.systemAddressBalance = gauge("balance_tracker", "system_address_balance_wei", "Balance of a system address funded by the BalanceTracker.", systemAddressLabels)
+	e.lastPollTime = gauge("", "last_poll_timestamp_seconds", "Time of the last successful poll of the contract.", labels)
+	e.pollErrors = prometheus.NewCounterVec(prometheus.CounterOpts{Namespace: namespace, Name: "poll_errors_total", Help: "Number of failed polls of the contract."}, labels)
+
+	for _, c := range []prometheus.Collector{e.netFeeRevenue, e.lastDisbursementTime, e.untilDisbursement, e.balance, e.targetBalance, e.systemAddressBalance, e.lastPollTime, e.pollErrors} {
+		if err := registry.Register(c); err != nil {
+			return nil, fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+	return e, nil
+}
+
+// Run polls the contracts until ctx is cancelled.
+func (e *Exporter) Run(ctx context.Context) error {
+	for {
+		e.Poll(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(e.cfg.PollInterval):
+		}
+	}
+}
+
+// Poll updates the metrics of every contract. Failures are logged and counted
+// in the poll errors metric, and leave the metrics of the contract unchanged.
+func (e *Exporter) Poll(ctx context.Context) {
+	if e.feeDisburser != nil {
+		e.poll(ctx, e.cfg.L2Chain, *e.cfg.FeeDisburser, e.pollFeeDisburser)
+	}
+	if e.balanceTracker != nil {
+		e.poll(ctx, e.cfg.L1Chain, *e.cfg.BalanceTracker, e.pollBalanceTracker)
+	}
+}
+
+func (e *Exporter) poll(ctx context.Context, chain string, contract common.Address, fn func(context.Context, prometheus.Labels) error) {
+	labels := prometheus.Labels{"chain": chain, "contract": contract.Hex()}
+	if err := fn(ctx, labels); err != nil {
+		e.log.Warn("Failed to poll contract", "contract", contract, "err", err)
+		e.pollErrors.With(labels).Inc()
+		return
+	}
+	e.lastPollTime.With(labels).SetToCurrentTime()
+}
+
+func (e *Exporter) pollFeeDisburser(ctx context.Context, labels prometheus.Labels) error {
+	// Read everything at the same block so the metrics are consistent.
+	head, err := e.l2.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch head: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: head.Number}
+	netFeeRevenue, err := e.feeDisburser.NetFeeRevenue(opts)
+	if err != nil {
+		return fmt.Errorf("failed to fetch netFeeRevenue: %w", err)
+	}
+	last, err := e.feeDisburser.LastDisbursementTime(opts)
+	if err != nil {
+		return fmt.Errorf("failed to fetch lastDisbursementTime: %w", err)
+	}
+	interval, err := e.feeDisburser.FEEDISBURSEMENTINTERVAL(opts)
+	if err != nil {
+		return fmt.Errorf("failed to fetch FEE_DISBURSEMENT_INTERVAL: %w", err)
+	}
+	balance, err := e.l2.BalanceAt(ctx, *e.cfg.FeeDisburser, head.Number)
+	if err != nil {
+		return fmt.Errorf("failed to fetch balance: %w", err)
+	}
+
+	until := new(big.Int).Add(last, interval)
+	until.Sub(until, new(big.Int).SetUint64(head.Time))
+	e.netFeeRevenue.With(labels).Set(toFloat(netFeeRevenue))
+	e.lastDisbursementTime.With(labels).Set(toFloat(last))
+	e.untilDisbursement.With(labels).Set(toFloat(until))
+	e.balance.With(labels).Set(toFloat(balance))
+	return nil
+}
+
+func (e *Exporter) pollBalanceTracker(ctx context.Context, labels prometheus.Labels) error {
+	head, err := e.l1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch head: %w", err)
+	}
+	targets, err := e.balanceTracker.Targets(&bind.CallOpts{Context: ctx, BlockNumber: head.Number})
+	if err != nil {
+		return err
+	}
+	balance, err := e.l1.BalanceAt(ctx, *e.cfg.BalanceTracker, head.Number)
+	if err != nil {
+		return fmt.Errorf("failed to fetch balance: %w", err)
+	}
+	balances := make([]*big.Int, len(targets))
+	for i, target := range targets {
+		if balances[i], err = e.l1.BalanceAt(ctx, target.SystemAddress, head.Number); err != nil {
+			return fmt.Errorf("failed to fetch balance of %s: %w", target.SystemAddress, err)
+		}
+	}
+
+	e.balance.With(labels).Set(toFloat(balance))
+	current := make(map[common.Address]bool)
+	for i, target := range targets {
+		current[target.SystemAddress] = true
+		addressLabels := prometheus.Labels{"chain": labels["chain"], "contract": labels["contract"], "system_address": target.SystemAddress.Hex()}
+		e.targetBalance.With(addressLabels).Set(toFloat(target.TargetBalance))
+		e.systemAddressBalance.With(addressLabels).Set(toFloat(balances[i]))
+	}
+	for address := range e.systemAddresses {
+		if !current[address] {
+			e.targetBalance.DeleteLabelValues(labels["chain"], labels["contract"], address.Hex())
+			e.systemAddressBalance.DeleteLabelValues(labels["chain"], labels["contract"], address.Hex())
+		}
+	}
+	e.systemAddresses = current
+	return nil
+}
+
+// toFloat converts v to a float64, losing precision beyond 53 bits.
+func toFloat(v *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(v).Float64()
+	return f
+}
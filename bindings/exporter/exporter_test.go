@@ -0,0 +1,167 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+var (
+	feeDisburser   = common.HexToAddress("0xfd")
+	balanceTracker = common.HexToAddress("0xb7")
+)
+
+// fakeChain answers the calls of the exporter from in-memory state.
+type fakeChain struct {
+	time            uint64
+	netFeeRevenue   int64
+	lastDisbursed   int64
+	balances        map[common.Address]int64
+	systemAddresses []common.Address
+	targetBalances  []int64
+}
+
+func (c *fakeChain) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (c *fakeChain) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var metadata *bind.MetaData
+	switch *call.To {
+	case feeDisburser:
+		metadata = bindings.FeeDisburserMetaData
+	case balanceTracker:
+		metadata = bindings.BalanceTrackerMetaData
+	}
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "netFeeRevenue":
+		return method.Outputs.Pack(big.NewInt(c.netFeeRevenue))
+	case "lastDisbursementTime":
+		return method.Outputs.Pack(big.NewInt(c.lastDisbursed))
+	case "FEE_DISBURSEMENT_INTERVAL":
+		return method.Outputs.Pack(big.NewInt(24 * 60 * 60))
+	case "MAX_SYSTEM_ADDRESS_COUNT":
+		return method.Outputs.Pack(big.NewInt(20))
+	case "systemAddresses", "targetBalances":
+		return c.target(method, call.Data[4:])
+	}
+	return nil, errors.New("unexpected call " + method.Name)
+}
+
+func (c *fakeChain) target(method *abi.Method, input []byte) ([]byte, error) {
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, err
+	}
+	i := int(args[0].(*big.Int).Int64())
+	if i >= len(c.systemAddresses) {
+		return nil, errors.New("execution reverted: out-of-bounds access of an array or bytesN")
+	}
+	if method.Name == "systemAddresses" {
+		return method.Outputs.Pack(c.systemAddresses[i])
+	}
+	return method.Outputs.Pack(big.NewInt(c.targetBalances[i]))
+}
+
+func (c *fakeChain) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return big.NewInt(c.balances[account]), nil
+}
+
+func (c *fakeChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(100), Time: c.time}, nil
+}
+
+func TestExporter(t *testing.T) {
+	batcher := common.HexToAddress("0xba")
+	proposer := common.HexToAddress("0xbb")
+	l2 := &fakeChain{
+		time:          1_700_000_000,
+		netFeeRevenue: 500,
+		lastDisbursed: 1_700_000_000 - 60*60,
+		balances:      map[common.Address]int64{feeDisburser: 800},
+	}
+	l1 := &fakeChain{
+		balances: map[common.Address]int64{
+			balanceTracker: 300,
+			batcher:        10,
+			proposer:       20,
+		},
+		systemAddresses: []common.Address{batcher, proposer},
+		targetBalances:  []int64{100, 200},
+	}
+	registry := prometheus.NewRegistry()
+	e, err := New(Config{L2Chain: "8453", L1Chain: "1", FeeDisburser: &feeDisburser, BalanceTracker: &balanceTracker}, l1, l2, registry, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e.Poll(context.Background())
+	err = testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP revshare_balance_tracker_system_address_balance_wei Balance of a system address funded by the BalanceTracker.
+# TYPE revshare_balance_tracker_system_address_balance_wei gauge
+revshare_balance_tracker_system_address_balance_wei{chain="1",contract="0x00000000000000000000000000000000000000b7",system_address="0x00000000000000000000000000000000000000BA"} 10
+revshare_balance_tracker_system_address_balance_wei{chain="1",contract="0x00000000000000000000000000000000000000b7",system_address="0x00000000000000000000000000000000000000bb"} 20
+# HELP revshare_balance_tracker_target_balance_wei Target balance of a system address funded by the BalanceTracker.
+# TYPE revshare_balance_tracker_target_balance_wei gauge
+revshare_balance_tracker_target_balance_wei{chain="1",contract="0x00000000000000000000000000000000000000b7",system_address="0x00000000000000000000000000000000000000BA"} 100
+revshare_balance_tracker_target_balance_wei{chain="1",contract="0x00000000000000000000000000000000000000b7",system_address="0x00000000000000000000000000000000000000bb"} 200
+# HELP revshare_contract_balance_wei Balance of the contract.
+# TYPE revshare_contract_balance_wei gauge
+revshare_contract_balance_wei{chain="1",contract="0x00000000000000000000000000000000000000b7"} 300
+revshare_contract_balance_wei{chain="8453",contract="0x00000000000000000000000000000000000000fd"} 800
+# HELP revshare_fee_disburser_last_disbursement_timestamp_seconds Timestamp of the last disbursement.
+# TYPE revshare_fee_disburser_last_disbursement_timestamp_seconds gauge
+revshare_fee_disburser_last_disbursement_timestamp_seconds{chain="8453",contract="0x00000000000000000000000000000000000000fd"} 1.6999964e+09
+# HELP revshare_fee_disburser_net_fee_revenue_wei Net fee revenue collected by the FeeDisburser since the last disbursement.
+# TYPE revshare_fee_disburser_net_fee_revenue_wei gauge
+revshare_fee_disburser_net_fee_revenue_wei{chain="8453",contract="0x00000000000000000000000000000000000000fd"} 500
+# HELP revshare_fee_disburser_seconds_until_disbursement Seconds until disburseFees may be called again, negative once overdue.
+# TYPE revshare_fee_disburser_seconds_until_disbursement gauge
+revshare_fee_disburser_seconds_until_disbursement{chain="8453",contract="0x00000000000000000000000000000000000000fd"} 82800
+`),
+		"revshare_balance_tracker_system_address_balance_wei",
+		"revshare_balance_tracker_target_balance_wei",
+		"revshare_contract_balance_wei",
+		"revshare_fee_disburser_last_disbursement_timestamp_seconds",
+		"revshare_fee_disburser_net_fee_revenue_wei",
+		"revshare_fee_disburser_seconds_until_disbursement",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Removing a system address drops its series.
+	l1.systemAddresses = l1.systemAddresses[:1]
+	l1.targetBalances = l1.targetBalances[:1]
+	e.Poll(context.Background())
+	if n := testutil.CollectAndCount(e.targetBalance); n != 1 {
+		t.Fatalf("got %d target balance series, want 1", n)
+	}
+	if n := testutil.CollectAndCount(e.systemAddressBalance); n != 1 {
+		t.Fatalf("got %d system address balance series, want 1", n)
+	}
+	if n := testutil.CollectAndCount(e.pollErrors); n != 0 {
+		t.Fatalf("got %d poll error series, want 0", n)
+	}
+}
@@ -0,0 +1,247 @@
+// Package feevault corrects the totalProcessed of a fee vault with
+// src/fee-vault-fixes/FeeVault.sol. The correct value is rebuilt from the
+// Withdrawal events of the vault, and the correction is a single batch of
+// calls for the owner of the ProxyAdmin: upgrade the vault to the fix
+// implementation, set totalProcessed and upgrade it back to its original
+// implementation.
+package feevault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/multisig"
+	"github.com/base-org/contracts/bindings/predeploys"
+	"github.com/base-org/contracts/bindings/simulate"
+)
+
+// proxyAdminABI holds the ProxyAdmin functions upgrading and reading the
+// implementation of a proxy.
+const proxyAdminABI = `[{"type":"function","name":"upgrade","stateMutability":"nonpayable","inputs":[{"name":"_proxy","type":"address"},{"name":"_implementation","type":"address"}],"outputs":[]},{"type":"function","name":"getProxyImplementation","stateMutability":"view","inputs":[{"name":"_proxy","type":"address"}],"outputs":[{"name":"","type":"address"}]}]`
+
+// fixABI holds setTotalProcessed of src/fee-vault-fixes/FeeVault.sol.
+const fixABI = `[{"type":"function","name":"setTotalProcessed","stateMutability":"nonpayable","inputs":[{"name":"_correctTotalProcessed","type":"uint256"}],"outputs":[]}]`
+
+var (
+	// ErrAlreadyFixImplementation is returned when the vault already runs the
+	// fix implementation, whose original implementation is then unknown.
+	ErrAlreadyFixImplementation = errors.New("feevault: vault already upgraded to the fix implementation")
+	// ErrWrongImplementation is returned when the simulated correction leaves
+	// the vault on another implementation than the original one.
+	ErrWrongImplementation = errors.New("feevault: vault not upgraded back to its original implementation")
+	// ErrWrongTotalProcessed is returned when the simulated correction doesn't
+	// set totalProcessed to the correct value.
+	ErrWrongTotalProcessed = errors.New("feevault: totalProcessed not corrected")
+)
+
+// Backend is the chain access needed to compute a correction. ethclient.Client
+// implements it.
+type Backend interface {
+	bind.ContractCaller
+	bind.ContractFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Config configures the correction of a fee vault.
+type Config struct {
+	// Vault is the proxy of the fee vault to correct.
+	Vault common.Address
+	// FixImplementation is the address src/fee-vault-fixes/FeeVault.sol is
+	// deployed at.
+	FixImplementation common.Address
+	// ProxyAdmin is the admin of the vault proxy, predeploys.ProxyAdmin if
+	// zero.
+	ProxyAdmin common.Address
+	// StartBlock is the first block searched for Withdrawal events, usually
+	// the block the vault was deployed at.
+	StartBlock uint64
+	// ChunkSize is the largest number of blocks whose logs are fetched at once.
+	ChunkSize uint64
+}
+
+// Correction is the totalProcessed correction of a fee vault.
+type Correction struct {
+	// Vault is the proxy of the fee vault.
+	Vault common.Address
+	// ProxyAdmin is the admin of the vault proxy.
+	ProxyAdmin common.Address
+	// Implementation is the implementation of the vault, restored once
+	// totalProcessed is set.
+	Implementation common.Address
+	// FixImplementation is the implementation setting totalProcessed.
+	FixImplementation common.Address
+	// TotalProcessed is the sum of the withdrawals of the vault up to Block.
+	TotalProcessed *big.Int
+	// Current is the totalProcessed of the vault at Block.
+	Current *big.Int
+	// Withdrawals is the number of withdrawals of the vault up to Block.
+	Withdrawals int
+	// Block is the last block searched for withdrawals.
+	Block uint64
+}
+
+// Compute rebuilds the correct totalProcessed of the vault from its
+// withdrawals up to the latest block. Withdrawals made after Block and before
+// the correction is executed are not accounted for.
+func Compute(ctx context.Context, backend Backend, cfg Config) (*Correction, error) {
+	if cfg.ChunkSize == 0 {
+		return nil, errors.New("feevault: chunk size must be positive")
+	}
+	if cfg.ProxyAdmin == (common.Address{}) {
+		cfg.ProxyAdmin = predeploys.ProxyAdmin
+	}
+	head, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch head: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: head.Number}
+	implementation, err := Implementation(opts, backend, cfg.ProxyAdmin, cfg.Vault)
+	if err != nil {
+		return nil, err
+	}
+	if implementation == cfg.FixImplementation {
+		return nil, ErrAlreadyFixImplementation
+	}
+	vault, err := bindings.NewFeeVaultCaller(cfg.Vault, backend)
+	if err != nil {
+		return nil, err
+	}
+	filterer, err := bindings.NewFeeVaultFilterer(cfg.Vault, backend)
+	if err != nil {
+		return nil, err
+	}
+	current, err := vault.TotalProcessed(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch totalProcessed of fee vault %s: %w", cfg.Vault, err)
+	}
+	total, count, err := withdrawn(ctx, filterer, cfg.StartBlock, head.Number.Uint64(), cfg.ChunkSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Correction{
+		Vault:             cfg.Vault,
+		ProxyAdmin:        cfg.ProxyAdmin,
+		Implementation:    implementation,
+		FixImplementation: cfg.FixImplementation,
+		TotalProcessed:    total,
+		Current:           current,
+		Withdrawals:       count,
+		Block:             head.Number.Uint64(),
+	}, nil
+}
+
+// withdrawn returns the sum and number of the withdrawals of the vault in the
+// blocks from start to end. Vaults emitting the Withdrawal event with the
+// withdrawal network also emit the one without, so only the latter is summed.
+func withdrawn(ctx context.Context, vault *bindings.FeeVaultFilterer, start, end, chunkSize uint64) (*big.Int, int, error) {
+	total, count := new(big.Int), 0
+	for from := start; from <= end; from += chunkSize {
+		to := min(from+chunkSize-1, end)
+		it, err := vault.FilterWithdrawal(&bind.FilterOpts{Start: from, End: &to, Context: ctx})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to fetch withdrawals of blocks %d-%d: %w", from, to, err)
+		}
+		for it.Next() {
+			total.Add(total, it.Event.Value)
+			count++
+		}
+		err = it.Error()
+		it.Close()
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to decode withdrawals of blocks %d-%d: %w", from, to, err)
+		}
+	}
+	return total, count, nil
+}
+
+// Implementation returns the implementation of the proxy, as reported by its
+// ProxyAdmin.
+func Implementation(opts *bind.CallOpts, backend bind.ContractCaller, proxyAdmin, proxy common.Address) (common.Address, error) {
+	parsed, err := abi.JSON(strings.NewReader(proxyAdminABI))
+	if err != nil {
+		return common.Address{}, err
+	}
+	var out []interface{}
+	if err := bind.NewBoundContract(proxyAdmin, parsed, backend, nil, nil).Call(opts, &out, "getProxyImplementation", proxy); err != nil {
+		return common.Address{}, fmt.Errorf("failed to fetch implementation of %s: %w", proxy, err)
+	}
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+// Calls returns the calls to batch from the owner of the ProxyAdmin, e.g.
+// with the multisig package: upgrade the vault to the fix implementation, set
+// totalProcessed and upgrade the vault back to its original implementation.
+func (c *Correction) Calls() ([]multisig.Call3, error) {
+	proxyAdmin, err := abi.JSON(strings.NewReader(proxyAdminABI))
+	if err != nil {
+		return nil, err
+	}
+	fix, err := abi.JSON(strings.NewReader(fixABI))
+	if err != nil {
+		return nil, err
+	}
+	upgradeToFix, err := proxyAdmin.Pack("upgrade", c.Vault, c.FixImplementation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack upgrade: %w", err)
+	}
+	set, err := fix.Pack("setTotalProcessed", c.TotalProcessed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack setTotalProcessed: %w", err)
+	}
+	upgradeBack, err := proxyAdmin.Pack("upgrade", c.Vault, c.Implementation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack upgrade: %w", err)
+	}
+	return []multisig.Call3{
+		{Target: c.ProxyAdmin, CallData: upgradeToFix},
+		{Target: c.Vault, CallData: set},
+		{Target: c.ProxyAdmin, CallData: upgradeBack},
+	}, nil
+}
+
+// PostCheck simulates the payload executing the calls, e.g. returned by
+// multisig.Builder.SignerPayload, and checks that the vault ends on its
+// original implementation with the correct totalProcessed.
+func (c *Correction) PostCheck(ctx context.Context, sim *simulate.Simulator, payload simulate.Payload) error {
+	proxyAdmin, err := abi.JSON(strings.NewReader(proxyAdminABI))
+	if err != nil {
+		return err
+	}
+	getImplementation, err := proxyAdmin.Pack("getProxyImplementation", c.Vault)
+	if err != nil {
+		return fmt.Errorf("failed to pack getProxyImplementation: %w", err)
+	}
+	getTotalProcessed, err := simulate.NewCall(bindings.FeeVaultMetaData, payload.From, c.Vault, "totalProcessed")
+	if err != nil {
+		return err
+	}
+	results, err := sim.SimulateCalls(ctx, []simulate.Call{
+		{From: payload.From, To: payload.To, Data: payload.Data},
+		{From: payload.From, To: c.ProxyAdmin, Data: getImplementation},
+		getTotalProcessed,
+	}, payload.Overrides)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			return fmt.Errorf("simulation failed: %w", result.Err)
+		}
+	}
+	if implementation := common.BytesToAddress(results[1].ReturnData); implementation != c.Implementation {
+		return fmt.Errorf("%w: got %s, want %s", ErrWrongImplementation, implementation, c.Implementation)
+	}
+	if total := new(big.Int).SetBytes(results[2].ReturnData); total.Cmp(c.TotalProcessed) != 0 {
+		return fmt.Errorf("%w: got %s, want %s", ErrWrongTotalProcessed, total, c.TotalProcessed)
+	}
+	return nil
+}
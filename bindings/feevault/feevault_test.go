@@ -0,0 +1,242 @@
+package feevault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/predeploys"
+	"github.com/base-org/contracts/bindings/simulate"
+)
+
+var (
+	vault          = predeploys.BaseFeeVault
+	implementation = common.HexToAddress("0x1111")
+	fix            = common.HexToAddress("0xf1f1")
+)
+
+// fakeChain answers the ProxyAdmin and FeeVault getters and serves the
+// Withdrawal logs of the vault.
+type fakeChain struct {
+	head           uint64
+	implementation common.Address
+	totalProcessed int64
+	logs           []types.Log
+	// queries are the block ranges logs were fetched for.
+	queries [][2]uint64
+}
+
+func (c *fakeChain) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (c *fakeChain) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	proxyAdmin, err := abi.JSON(strings.NewReader(proxyAdminABI))
+	if err != nil {
+		return nil, err
+	}
+	feeVault, err := bindings.FeeVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case *call.To == predeploys.ProxyAdmin:
+		if method, err := proxyAdmin.MethodById(call.Data[:4]); err == nil && method.Name == "getProxyImplementation" {
+			return method.Outputs.Pack(c.implementation)
+		}
+	case *call.To == vault:
+		if method, err := feeVault.MethodById(call.Data[:4]); err == nil && method.Name == "totalProcessed" {
+			return method.Outputs.Pack(big.NewInt(c.totalProcessed))
+		}
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func (c *fakeChain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	from, to := q.FromBlock.Uint64(), q.ToBlock.Uint64()
+	c.queries = append(c.queries, [2]uint64{from, to})
+	var logs []types.Log
+	for _, l := range c.logs {
+		if l.BlockNumber >= from && l.BlockNumber <= to && l.Topics[0] == q.Topics[0][0] {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (c *fakeChain) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return event.NewSubscription(func(<-chan struct{}) error { return nil }), nil
+}
+
+func (c *fakeChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: new(big.Int).SetUint64(c.head)}, nil
+}
+
+// withdrawalLogs returns the logs of a withdrawal of value at block, with the
+// event carrying the withdrawal network if withNetwork is set.
+func withdrawalLogs(t *testing.T, block uint64, value int64, withNetwork bool) []types.Log {
+	t.Helper()
+	parsed, err := bindings.FeeVaultMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	recipient := common.HexToAddress("0xfd")
+	legacy := parsed.Events["Withdrawal"]
+	data, err := legacy.Inputs.Pack(big.NewInt(value), recipient, recipient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs := []types.Log{{Address: vault, Topics: []common.Hash{legacy.ID}, Data: data, BlockNumber: block}}
+	if withNetwork {
+		withdrawal := parsed.Events["Withdrawal0"]
+		data, err := withdrawal.Inputs.Pack(big.NewInt(value), recipient, recipient, uint8(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		logs = append(logs, types.Log{Address: vault, Topics: []common.Hash{withdrawal.ID}, Data: data, BlockNumber: block})
+	}
+	return logs
+}
+
+func TestCompute(t *testing.T) {
+	chain := &fakeChain{head: 25, implementation: implementation, totalProcessed: 1}
+	chain.logs = append(chain.logs, withdrawalLogs(t, 3, 100, false)...)
+	chain.logs = append(chain.logs, withdrawalLogs(t, 12, 20, true)...)
+	chain.logs = append(chain.logs, withdrawalLogs(t, 25, 3, true)...)
+
+	c, err := Compute(context.Background(), chain, Config{Vault: vault, FixImplementation: fix, StartBlock: 2, ChunkSize: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.TotalProcessed.Int64() != 123 || c.Withdrawals != 3 || c.Current.Int64() != 1 || c.Block != 25 {
+		t.Fatalf("unexpected correction %+v", c)
+	}
+	if c.Implementation != implementation || c.ProxyAdmin != predeploys.ProxyAdmin {
+		t.Fatalf("unexpected implementation %s of ProxyAdmin %s", c.Implementation, c.ProxyAdmin)
+	}
+	want := [][2]uint64{{2, 11}, {12, 21}, {22, 25}}
+	if len(chain.queries) != len(want) {
+		t.Fatalf("got queries %v, want %v", chain.queries, want)
+	}
+	for i := range want {
+		if chain.queries[i] != want[i] {
+			t.Fatalf("got queries %v, want %v", chain.queries, want)
+		}
+	}
+
+	chain.implementation = fix
+	if _, err := Compute(context.Background(), chain, Config{Vault: vault, FixImplementation: fix, ChunkSize: 10}); !errors.Is(err, ErrAlreadyFixImplementation) {
+		t.Fatalf("expected ErrAlreadyFixImplementation, got %v", err)
+	}
+}
+
+func TestCalls(t *testing.T) {
+	c := &Correction{Vault: vault, ProxyAdmin: predeploys.ProxyAdmin, Implementation: implementation, FixImplementation: fix, TotalProcessed: big.NewInt(123)}
+	calls, err := c.Calls()
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxyAdmin, err := abi.JSON(strings.NewReader(proxyAdminABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixParsed, err := abi.JSON(strings.NewReader(fixABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 3 || calls[0].Target != predeploys.ProxyAdmin || calls[1].Target != vault || calls[2].Target != predeploys.ProxyAdmin {
+		t.Fatalf("unexpected calls %+v", calls)
+	}
+	for i, want := range []common.Address{fix, implementation} {
+		args, err := proxyAdmin.Methods["upgrade"].Inputs.Unpack(calls[2*i].CallData[4:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if args[0].(common.Address) != vault || args[1].(common.Address) != want {
+			t.Fatalf("call %d upgrades %s to %s, want %s", 2*i, args[0], args[1], want)
+		}
+	}
+	args, err := fixParsed.Methods["setTotalProcessed"].Inputs.Unpack(calls[1].CallData[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args[0].(*big.Int).Int64() != 123 {
+		t.Fatalf("got totalProcessed %s, want 123", args[0])
+	}
+}
+
+// ethService serves eth_simulateV1 with the return data of each call.
+type ethService struct {
+	returnData []hexutil.Bytes
+}
+
+func (s *ethService) SimulateV1(ctx context.Context, opts json.RawMessage, block string) ([]map[string]interface{}, error) {
+	calls := make([]map[string]interface{}, len(s.returnData))
+	for i, data := range s.returnData {
+		calls[i] = map[string]interface{}{"returnData": data, "logs": []types.Log{}, "gasUsed": "0x1", "status": "0x1"}
+	}
+	return []map[string]interface{}{{"calls": calls}}, nil
+}
+
+// simBackend serves eth_simulateV1 in process.
+type simBackend struct {
+	fakeChain
+	client *rpc.Client
+}
+
+func (b *simBackend) Client() *rpc.Client { return b.client }
+
+func TestPostCheck(t *testing.T) {
+	service := &ethService{}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatal(err)
+	}
+	client := rpc.DialInProc(server)
+	defer func() {
+		client.Close()
+		server.Stop()
+	}()
+	sim, err := simulate.New(&simBackend{client: client}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Correction{Vault: vault, ProxyAdmin: predeploys.ProxyAdmin, Implementation: implementation, FixImplementation: fix, TotalProcessed: big.NewInt(123)}
+	payload := simulate.Payload{From: common.HexToAddress("0x5e"), To: common.HexToAddress("0x5afe"), Data: []byte{0x1}}
+	success := common.LeftPadBytes([]byte{1}, 32)
+	tests := []struct {
+		name           string
+		implementation common.Address
+		totalProcessed int64
+		want           error
+	}{
+		{"corrected", implementation, 123, nil},
+		{"left on the fix implementation", fix, 123, ErrWrongImplementation},
+		{"wrong totalProcessed", implementation, 1, ErrWrongTotalProcessed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service.returnData = []hexutil.Bytes{
+				success,
+				common.LeftPadBytes(tt.implementation.Bytes(), 32),
+				common.BigToHash(big.NewInt(tt.totalProcessed)).Bytes(),
+			}
+			if err := c.PostCheck(context.Background(), sim, payload); !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package bindings
+
+// Bindings are generated from forge artifacts, run `forge build` from the
+// repository root first. Use `go run ./cmd/bindgen -check` to verify that the
+// committed bindings match the artifacts.
+//go:generate go run ./cmd/bindgen -config contracts.json -artifacts ../out
@@ -0,0 +1,410 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sort"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+const balanceTrackerSchema = `
+CREATE TABLE IF NOT EXISTS processed_funds (
+	contract       TEXT    NOT NULL,
+	block_number   INTEGER NOT NULL,
+	block_hash     TEXT    NOT NULL,
+	tx_hash        TEXT    NOT NULL,
+	log_index      INTEGER NOT NULL,
+	system_address TEXT    NOT NULL,
+	success        INTEGER NOT NULL,
+	balance_needed TEXT    NOT NULL,
+	balance_sent   TEXT    NOT NULL,
+	PRIMARY KEY (contract, block_number, log_index)
+);
+CREATE INDEX IF NOT EXISTS processed_funds_system_address ON processed_funds (contract, system_address, block_number);
+CREATE TABLE IF NOT EXISTS sent_profit (
+	contract      TEXT    NOT NULL,
+	block_number  INTEGER NOT NULL,
+	block_hash    TEXT    NOT NULL,
+	tx_hash       TEXT    NOT NULL,
+	log_index     INTEGER NOT NULL,
+	profit_wallet TEXT    NOT NULL,
+	success       INTEGER NOT NULL,
+	balance_sent  TEXT    NOT NULL,
+	PRIMARY KEY (contract, block_number, log_index)
+);
+CREATE TABLE IF NOT EXISTS received_funds (
+	contract     TEXT    NOT NULL,
+	block_number INTEGER NOT NULL,
+	block_hash   TEXT    NOT NULL,
+	tx_hash      TEXT    NOT NULL,
+	log_index    INTEGER NOT NULL,
+	sender       TEXT    NOT NULL,
+	amount       TEXT    NOT NULL,
+	PRIMARY KEY (contract, block_number, log_index)
+);`
+
+// ProcessedFunds is a ProcessedFunds event. BalanceTracker emits it for every
+// system address on processFees, with a zero BalanceNeeded and Success unset
+// when no refill was needed.
+type ProcessedFunds struct {
+	Location
+	SystemAddress common.Address
+	Success       bool
+	// BalanceNeeded is the amount the system address lacked to reach its
+	// target balance.
+	BalanceNeeded *big.Int
+	// BalanceSent is the amount sent to the system address, which is less
+	// than BalanceNeeded if the BalanceTracker could not cover it.
+	BalanceSent *big.Int
+}
+
+// Refill reports whether the system address needed a refill.
+func (p *ProcessedFunds) Refill() bool {
+	return p.BalanceNeeded.Sign() > 0
+}
+
+// Failed reports whether the system address needed a refill and sending it
+// failed.
+func (p *ProcessedFunds) Failed() bool {
+	return p.Refill() && !p.Success
+}
+
+// SentProfit is a SentProfit event.
+type SentProfit struct {
+	Location
+	ProfitWallet common.Address
+	Success      bool
+	// BalanceSent is the amount swept to the profit wallet, or attempted to
+	// if Success is unset.
+	BalanceSent *big.Int
+}
+
+// ReceivedFunds is a ReceivedFunds event.
+type ReceivedFunds struct {
+	Location
+	Sender common.Address
+	Amount *big.Int
+}
+
+// Ledger summarizes the refills of a system address.
+type Ledger struct {
+	SystemAddress common.Address
+	// Checks is the number of times the balance of the address was checked.
+	Checks uint64
+	// Refills is the number of checks that found the address below its
+	// target balance.
+	Refills uint64
+	// Failures is the number of refills whose transfer failed.
+	Failures uint64
+	// Shortfalls is the number of successful refills that sent less than
+	// needed.
+	Shortfalls uint64
+	// Needed is the total amount needed over all refills.
+	Needed *big.Int
+	// Sent is the total amount sent by successful refills.
+	Sent *big.Int
+	// FailureStreak is the number of consecutive failed refills up to the
+	// latest check, zero if the latest check did not fail.
+	FailureStreak uint64
+	// LongestFailureStreak is the longest run of consecutive failed refills.
+	LongestFailureStreak uint64
+	// LastFailure is the latest failed refill, nil if none failed.
+	LastFailure *Location
+}
+
+// ProfitSummary summarizes the profit sent by a BalanceTracker.
+type ProfitSummary struct {
+	// Sweeps is the number of attempts to send profit.
+	Sweeps uint64
+	// Failures is the number of attempts that failed.
+	Failures uint64
+	// Swept is the total amount sent to the profit wallet.
+	Swept *big.Int
+	// Failed is the total amount of the failed attempts.
+	Failed *big.Int
+}
+
+// NewBalanceTracker creates an Indexer for the events of the BalanceTracker
+// at the given address.
+func NewBalanceTracker(address common.Address, cfg Config, backend Backend, db *DB, logger log.Logger) (*Indexer, error) {
+	parsed, err := bindings.BalanceTrackerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	// The filterer is only used to parse logs, which are fetched by the
+	// Indexer itself.
+	contract, err := bindings.NewBalanceTrackerFilterer(address, nil)
+	if err != nil {
+		return nil, err
+	}
+	src := &balanceTrackerSource{
+		address:  address,
+		contract: contract,
+		topics: []common.Hash{
+			parsed.Events["ProcessedFunds"].ID,
+			parsed.Events["SentProfit"].ID,
+			parsed.Events["ReceivedFunds"].ID,
+		},
+	}
+	return newIndexer(cfg, backend, db, src, logger)
+}
+
+type balanceTrackerSource struct {
+	address  common.Address
+	contract *bindings.BalanceTrackerFilterer
+	topics   []common.Hash
+}
+
+func (s *balanceTrackerSource) name() string {
+	return "BalanceTracker:" + s.address.Hex()
+}
+
+func (s *balanceTrackerSource) query(from, to uint64) ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{s.address},
+		Topics:    [][]common.Hash{s.topics},
+	}
+}
+
+func (s *balanceTrackerSource) insert(ctx context.Context, tx *sql.Tx, l types.Log) error {
+	if len(l.Topics) == 0 {
+		return nil
+	}
+	var (
+		query string
+		args  []interface{}
+	)
+	switch l.Topics[0] {
+	case s.topics[0]:
+		ev, err := s.contract.ParseProcessedFunds(l)
+		if err != nil {
+			return fmt.Errorf("failed to parse ProcessedFunds: %w", err)
+		}
+		query = `INSERT INTO processed_funds (contract, block_number, block_hash, tx_hash, log_index, system_address, success, balance_needed, balance_sent) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		args = []interface{}{ev.SystemAddress.Hex(), ev.Success, ev.BalanceNeeded.String(), ev.BalanceSent.String()}
+	case s.topics[1]:
+		ev, err := s.contract.ParseSentProfit(l)
+		if err != nil {
+			return fmt.Errorf("failed to parse SentProfit: %w", err)
+		}
+		query = `INSERT INTO sent_profit (contract, block_number, block_hash, tx_hash, log_index, profit_wallet, success, balance_sent) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+		args = []interface{}{ev.ProfitWallet.Hex(), ev.Success, ev.BalanceSent.String()}
+	case s.topics[2]:
+		ev, err := s.contract.ParseReceivedFunds(l)
+		if err != nil {
+			return fmt.Errorf("failed to parse ReceivedFunds: %w", err)
+		}
+		query = `INSERT INTO received_funds (contract, block_number, block_hash, tx_hash, log_index, sender, amount) VALUES (?, ?, ?, ?, ?, ?, ?)`
+		args = []interface{}{ev.Sender.Hex(), ev.Amount.String()}
+	default:
+		return nil
+	}
+	args = append([]interface{}{s.address.Hex(), l.BlockNumber, l.BlockHash.Hex(), l.TxHash.Hex(), l.Index}, args...)
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to store event: %w", err)
+	}
+	return nil
+}
+
+func (s *balanceTrackerSource) revert(ctx context.Context, tx *sql.Tx, after int64) error {
+	for _, table := range []string{"processed_funds", "sent_profit", "received_funds"} {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE contract = ? AND block_number > ?`, s.address.Hex(), after); err != nil {
+			return fmt.Errorf("failed to delete from %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// ProcessedFunds returns the ProcessedFunds events of the BalanceTracker at
+// contract in blocks from to to, inclusive, oldest first.
+func (db *DB) ProcessedFunds(ctx context.Context, contract common.Address, from, to uint64) ([]ProcessedFunds, error) {
+	return db.processedFunds(ctx, `contract = ? AND block_number BETWEEN ? AND ?`, contract.Hex(), from, to)
+}
+
+func (db *DB) processedFunds(ctx context.Context, where string, args ...interface{}) ([]ProcessedFunds, error) {
+	rows, err := db.db.QueryContext(ctx, `SELECT `+locationColumns+`, system_address, success, balance_needed, balance_sent FROM processed_funds
+		WHERE `+where+` ORDER BY block_number, log_index`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query processed funds: %w", err)
+	}
+	defer rows.Close()
+	var events []ProcessedFunds
+	for rows.Next() {
+		var (
+			ev                          ProcessedFunds
+			systemAddress, needed, sent string
+		)
+		dest, fill := scanLocation(&ev.Location)
+		if err := rows.Scan(append(dest, &systemAddress, &ev.Success, &needed, &sent)...); err != nil {
+			return nil, fmt.Errorf("failed to read processed funds: %w", err)
+		}
+		fill()
+		ev.SystemAddress = common.HexToAddress(systemAddress)
+		if ev.BalanceNeeded, err = parseAmount(needed); err != nil {
+			return nil, err
+		}
+		if ev.BalanceSent, err = parseAmount(sent); err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, rows.Err()
+}
+
+// SentProfit returns the SentProfit events of the BalanceTracker at contract
+// in blocks from to to, inclusive, oldest first.
+func (db *DB) SentProfit(ctx context.Context, contract common.Address, from, to uint64) ([]SentProfit, error) {
+	rows, err := db.db.QueryContext(ctx, `SELECT `+locationColumns+`, profit_wallet, success, balance_sent FROM sent_profit
+		WHERE contract = ? AND block_number BETWEEN ? AND ? ORDER BY block_number, log_index`, contract.Hex(), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sent profit: %w", err)
+	}
+	defer rows.Close()
+	var events []SentProfit
+	for rows.Next() {
+		var (
+			ev                 SentProfit
+			profitWallet, sent string
+		)
+		dest, fill := scanLocation(&ev.Location)
+		if err := rows.Scan(append(dest, &profitWallet, &ev.Success, &sent)...); err != nil {
+			return nil, fmt.Errorf("failed to read sent profit: %w", err)
+		}
+		fill()
+		ev.ProfitWallet = common.HexToAddress(profitWallet)
+		if ev.BalanceSent, err = parseAmount(sent); err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, rows.Err()
+}
+
+// ReceivedFunds returns the ReceivedFunds events of the BalanceTracker at
+// contract in blocks from to to, inclusive, oldest first.
+func (db *DB) ReceivedFunds(ctx context.Context, contract common.Address, from, to uint64) ([]ReceivedFunds, error) {
+	rows, err := db.db.QueryContext(ctx, `SELECT `+locationColumns+`, sender, amount FROM received_funds
+		WHERE contract = ? AND block_number BETWEEN ? AND ? ORDER BY block_number, log_index`, contract.Hex(), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query received funds: %w", err)
+	}
+	defer rows.Close()
+	var events []ReceivedFunds
+	for rows.Next() {
+		var (
+			ev             ReceivedFunds
+			sender, amount string
+		)
+		dest, fill := scanLocation(&ev.Location)
+		if err := rows.Scan(append(dest, &sender, &amount)...); err != nil {
+			return nil, fmt.Errorf("failed to read received funds: %w", err)
+		}
+		fill()
+		ev.Sender = common.HexToAddress(sender)
+		if ev.Amount, err = parseAmount(amount); err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, rows.Err()
+}
+
+// Ledgers returns the ledger of every system address refilled by the
+// BalanceTracker at contract in blocks from to to, inclusive, ordered by
+// address.
+func (db *DB) Ledgers(ctx context.Context, contract common.Address, from, to uint64) ([]Ledger, error) {
+	events, err := db.ProcessedFunds(ctx, contract, from, to)
+	if err != nil {
+		return nil, err
+	}
+	ledgers := make(map[common.Address]*Ledger)
+	for i := range events {
+		ledger, ok := ledgers[events[i].SystemAddress]
+		if !ok {
+			ledger = newLedger(events[i].SystemAddress)
+			ledgers[events[i].SystemAddress] = ledger
+		}
+		ledger.add(&events[i])
+	}
+	result := make([]Ledger, 0, len(ledgers))
+	for _, ledger := range ledgers {
+		result = append(result, *ledger)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].SystemAddress.Cmp(result[j].SystemAddress) < 0
+	})
+	return result, nil
+}
+
+// Ledger returns the ledger of a system address refilled by the
+// BalanceTracker at contract in blocks from to to, inclusive.
+func (db *DB) Ledger(ctx context.Context, contract, systemAddress common.Address, from, to uint64) (*Ledger, error) {
+	events, err := db.processedFunds(ctx, `contract = ? AND system_address = ? AND block_number BETWEEN ? AND ?`, contract.Hex(), systemAddress.Hex(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	ledger := newLedger(systemAddress)
+	for i := range events {
+		ledger.add(&events[i])
+	}
+	return ledger, nil
+}
+
+// Profit summarizes the profit sent by the BalanceTracker at contract in
+// blocks from to to, inclusive.
+func (db *DB) Profit(ctx context.Context, contract common.Address, from, to uint64) (*ProfitSummary, error) {
+	events, err := db.SentProfit(ctx, contract, from, to)
+	if err != nil {
+		return nil, err
+	}
+	summary := &ProfitSummary{Swept: new(big.Int), Failed: new(big.Int)}
+	for _, ev := range events {
+		summary.Sweeps++
+		if ev.Success {
+			summary.Swept.Add(summary.Swept, ev.BalanceSent)
+		} else {
+			summary.Failures++
+			summary.Failed.Add(summary.Failed, ev.BalanceSent)
+		}
+	}
+	return summary, nil
+}
+
+func newLedger(systemAddress common.Address) *Ledger {
+	return &Ledger{SystemAddress: systemAddress, Needed: new(big.Int), Sent: new(big.Int)}
+}
+
+// add records ev, which must be later than the events already recorded.
+func (l *Ledger) add(ev *ProcessedFunds) {
+	l.Checks++
+	if !ev.Failed() {
+		l.FailureStreak = 0
+	}
+	if !ev.Refill() {
+		return
+	}
+	l.Refills++
+	l.Needed.Add(l.Needed, ev.BalanceNeeded)
+	if ev.Success {
+		l.Sent.Add(l.Sent, ev.BalanceSent)
+		if ev.BalanceSent.Cmp(ev.BalanceNeeded) < 0 {
+			l.Shortfalls++
+		}
+		return
+	}
+	l.Failures++
+	l.FailureStreak++
+	l.LongestFailureStreak = max(l.LongestFailureStreak, l.FailureStreak)
+	location := ev.Location
+	l.LastFailure = &location
+}
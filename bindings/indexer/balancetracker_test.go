@@ -0,0 +1,119 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+func processedFundsLog(t *testing.T, address, systemAddress common.Address, success bool, needed, sent int64) types.Log {
+	event := balanceTrackerEvent(t, "ProcessedFunds")
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(needed), big.NewInt(sent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var successTopic common.Hash
+	if success {
+		successTopic = common.BigToHash(common.Big1)
+	}
+	return types.Log{Address: address, Topics: []common.Hash{event.ID, common.BytesToHash(systemAddress.Bytes()), successTopic}, Data: data}
+}
+
+func sentProfitLog(t *testing.T, address, profitWallet common.Address, success bool, sent int64) types.Log {
+	event := balanceTrackerEvent(t, "SentProfit")
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(sent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var successTopic common.Hash
+	if success {
+		successTopic = common.BigToHash(common.Big1)
+	}
+	return types.Log{Address: address, Topics: []common.Hash{event.ID, common.BytesToHash(profitWallet.Bytes()), successTopic}, Data: data}
+}
+
+func balanceTrackerEvent(t *testing.T, name string) abi.Event {
+	parsed, err := bindings.BalanceTrackerMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.Events[name]
+}
+
+func TestBalanceTrackerLedger(t *testing.T) {
+	ctx := context.Background()
+	address := common.HexToAddress("0xb7")
+	batcher := common.HexToAddress("0xba")
+	proposer := common.HexToAddress("0xbb")
+	profitWallet := common.HexToAddress("0xcc")
+
+	chain := &fakeChain{logs: make(map[uint64][]types.Log)}
+	chain.extend(8, 0)
+	chain.addLog(1, processedFundsLog(t, address, batcher, true, 100, 100))
+	chain.addLog(1, processedFundsLog(t, address, proposer, false, 0, 0))
+	chain.addLog(1, sentProfitLog(t, address, profitWallet, true, 40))
+	chain.addLog(2, processedFundsLog(t, address, batcher, false, 50, 50))
+	chain.addLog(3, processedFundsLog(t, address, batcher, false, 60, 60))
+	chain.addLog(3, sentProfitLog(t, address, profitWallet, false, 5))
+	chain.addLog(4, processedFundsLog(t, address, batcher, true, 70, 30))
+	chain.addLog(5, processedFundsLog(t, address, batcher, false, 80, 80))
+
+	db, err := Open(filepath.Join(t.TempDir(), "index.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	ix, err := NewBalanceTracker(address, Config{ChunkSize: 2}, chain, db, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	syncAll(t, ix)
+
+	ledgers, err := db.Ledgers(ctx, address, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ledgers) != 2 || ledgers[0].SystemAddress != batcher || ledgers[1].SystemAddress != proposer {
+		t.Fatalf("unexpected ledgers: %+v", ledgers)
+	}
+	b := ledgers[0]
+	if b.Checks != 5 || b.Refills != 5 || b.Failures != 3 || b.Shortfalls != 1 {
+		t.Fatalf("unexpected counts: %+v", b)
+	}
+	if b.Needed.Int64() != 360 || b.Sent.Int64() != 130 {
+		t.Fatalf("needed %v and sent %v, want 360 and 130", b.Needed, b.Sent)
+	}
+	if b.FailureStreak != 1 || b.LongestFailureStreak != 2 {
+		t.Fatalf("failure streak %d, longest %d, want 1 and 2", b.FailureStreak, b.LongestFailureStreak)
+	}
+	if b.LastFailure == nil || b.LastFailure.BlockNumber != 5 {
+		t.Fatalf("unexpected last failure: %+v", b.LastFailure)
+	}
+	if p := ledgers[1]; p.Checks != 1 || p.Refills != 0 || p.Failures != 0 || p.LastFailure != nil {
+		t.Fatalf("check without refill counted as refill: %+v", p)
+	}
+
+	ledger, err := db.Ledger(ctx, address, batcher, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ledger.Refills != 3 || ledger.FailureStreak != 2 {
+		t.Fatalf("unexpected ledger of blocks 0-3: %+v", ledger)
+	}
+
+	profit, err := db.Profit(ctx, address, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profit.Sweeps != 2 || profit.Failures != 1 || profit.Swept.Int64() != 40 || profit.Failed.Int64() != 5 {
+		t.Fatalf("unexpected profit summary: %+v", profit)
+	}
+}
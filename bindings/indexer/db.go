@@ -0,0 +1,165 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	_ "modernc.org/sqlite"
+)
+
+// maxCheckpoints is how many checkpoints are kept per source, bounding the
+// depth of the reorgs that can be recovered from without reindexing.
+const maxCheckpoints = 128
+
+const checkpointSchema = `
+CREATE TABLE IF NOT EXISTS checkpoints (
+	source       TEXT    NOT NULL,
+	block_number INTEGER NOT NULL,
+	block_hash   TEXT    NOT NULL,
+	PRIMARY KEY (source, block_number)
+);`
+
+// DB is an SQLite database holding indexed events. Amounts are uint256 values
+// and are stored as decimal strings.
+type DB struct {
+	db *sql.DB
+}
+
+// Open opens the SQLite database at path, creating it and its tables if
+// needed.
+func Open(path string) (*DB, error) {
+	dsn := "file:" + path + "?" + url.Values{"_pragma": {"busy_timeout(5000)", "journal_mode(WAL)"}}.Encode()
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	// SQLite allows a single writer, serialize access instead of failing with
+	// SQLITE_BUSY.
+	db.SetMaxOpenConns(1)
+	for _, schema := range []string{checkpointSchema, feeDisburserSchema, balanceTrackerSchema} {
+		if _, err := db.Exec(schema); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed to create tables: %w", err)
+		}
+	}
+	return &DB{db: db}, nil
+}
+
+// Close closes the database.
+func (db *DB) Close() error {
+	return db.db.Close()
+}
+
+// checkpoint is the last block of an indexed range.
+type checkpoint struct {
+	Number uint64
+	Hash   common.Hash
+}
+
+// checkpoints returns the checkpoints of source, latest first.
+func (db *DB) checkpoints(ctx context.Context, source string) ([]checkpoint, error) {
+	rows, err := db.db.QueryContext(ctx, `SELECT block_number, block_hash FROM checkpoints WHERE source = ? ORDER BY block_number DESC`, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query checkpoints: %w", err)
+	}
+	defer rows.Close()
+	var checkpoints []checkpoint
+	for rows.Next() {
+		var (
+			cp   checkpoint
+			hash string
+		)
+		if err := rows.Scan(&cp.Number, &hash); err != nil {
+			return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+		}
+		cp.Hash = common.HexToHash(hash)
+		checkpoints = append(checkpoints, cp)
+	}
+	return checkpoints, rows.Err()
+}
+
+// apply stores the logs of a range ending at cp and records cp as the latest
+// checkpoint of the source, atomically.
+func (db *DB) apply(ctx context.Context, src source, cp checkpoint, logs []types.Log) error {
+	return db.update(ctx, func(tx *sql.Tx) error {
+		for _, l := range logs {
+			if err := src.insert(ctx, tx, l); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO checkpoints (source, block_number, block_hash) VALUES (?, ?, ?)`, src.name(), cp.Number, cp.Hash.Hex()); err != nil {
+			return fmt.Errorf("failed to store checkpoint: %w", err)
+		}
+		_, err := tx.ExecContext(ctx, `DELETE FROM checkpoints WHERE source = ? AND block_number NOT IN (
+			SELECT block_number FROM checkpoints WHERE source = ? ORDER BY block_number DESC LIMIT ?)`, src.name(), src.name(), maxCheckpoints)
+		if err != nil {
+			return fmt.Errorf("failed to prune checkpoints: %w", err)
+		}
+		return nil
+	})
+}
+
+// rewind deletes everything the source indexed after block number after,
+// which is -1 to delete everything.
+func (db *DB) rewind(ctx context.Context, src source, after int64) error {
+	return db.update(ctx, func(tx *sql.Tx) error {
+		if err := src.revert(ctx, tx, after); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM checkpoints WHERE source = ? AND block_number > ?`, src.name(), after); err != nil {
+			return fmt.Errorf("failed to delete checkpoints: %w", err)
+		}
+		return nil
+	})
+}
+
+// update runs fn in a transaction, committing it if fn succeeds.
+func (db *DB) update(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Location locates an indexed event on chain.
+type Location struct {
+	BlockNumber uint64
+	BlockHash   common.Hash
+	TxHash      common.Hash
+	LogIndex    uint
+}
+
+const locationColumns = `block_number, block_hash, tx_hash, log_index`
+
+// scanLocation returns the scan destinations of the locationColumns, and a
+// function filling loc once scanned.
+func scanLocation(loc *Location) ([]interface{}, func()) {
+	var blockHash, txHash string
+	return []interface{}{&loc.BlockNumber, &blockHash, &txHash, &loc.LogIndex}, func() {
+		loc.BlockHash = common.HexToHash(blockHash)
+		loc.TxHash = common.HexToHash(txHash)
+	}
+}
+
+// parseAmount parses an amount stored as a decimal string.
+func parseAmount(s string) (*big.Int, error) {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q", s)
+	}
+	return v, nil
+}
@@ -0,0 +1,247 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+const feeDisburserSchema = `
+CREATE TABLE IF NOT EXISTS fee_disbursements (
+	contract          TEXT    NOT NULL,
+	block_number      INTEGER NOT NULL,
+	block_hash        TEXT    NOT NULL,
+	tx_hash           TEXT    NOT NULL,
+	log_index         INTEGER NOT NULL,
+	disbursement_time INTEGER NOT NULL,
+	total             TEXT    NOT NULL,
+	optimism_share    TEXT    NOT NULL,
+	l1_share          TEXT    NOT NULL,
+	PRIMARY KEY (contract, block_number, log_index)
+);
+CREATE TABLE IF NOT EXISTS fees_received (
+	contract     TEXT    NOT NULL,
+	block_number INTEGER NOT NULL,
+	block_hash   TEXT    NOT NULL,
+	tx_hash      TEXT    NOT NULL,
+	log_index    INTEGER NOT NULL,
+	sender       TEXT    NOT NULL,
+	amount       TEXT    NOT NULL,
+	PRIMARY KEY (contract, block_number, log_index)
+);
+CREATE TABLE IF NOT EXISTS no_fees_collected (
+	contract     TEXT    NOT NULL,
+	block_number INTEGER NOT NULL,
+	block_hash   TEXT    NOT NULL,
+	tx_hash      TEXT    NOT NULL,
+	log_index    INTEGER NOT NULL,
+	PRIMARY KEY (contract, block_number, log_index)
+);`
+
+// Disbursement is a FeesDisbursed event.
+type Disbursement struct {
+	Location
+	// Time is the block timestamp of the disbursement.
+	Time uint64
+	// Total is the fee balance that was disbursed.
+	Total *big.Int
+	// OptimismShare is the amount paid to the Optimism wallet.
+	OptimismShare *big.Int
+	// L1Share is the amount bridged to the L1 wallet.
+	L1Share *big.Int
+}
+
+// FeeReceipt is a FeesReceived event.
+type FeeReceipt struct {
+	Location
+	// Sender is the fee vault that sent the fees.
+	Sender common.Address
+	Amount *big.Int
+}
+
+// NoFeesCollected is a NoFeesCollected event.
+type NoFeesCollected struct {
+	Location
+}
+
+// NewFeeDisburser creates an Indexer for the events of the FeeDisburser at
+// the given address.
+func NewFeeDisburser(address common.Address, cfg Config, backend Backend, db *DB, logger log.Logger) (*Indexer, error) {
+	parsed, err := bindings.FeeDisburserMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	// The filterer is only used to parse logs, which are fetched by the
+	// Indexer itself.
+	contract, err := bindings.NewFeeDisburserFilterer(address, nil)
+	if err != nil {
+		return nil, err
+	}
+	src := &feeDisburserSource{
+		address:  address,
+		contract: contract,
+		topics: []common.Hash{
+			parsed.Events["FeesDisbursed"].ID,
+			parsed.Events["FeesReceived"].ID,
+			parsed.Events["NoFeesCollected"].ID,
+		},
+	}
+	return newIndexer(cfg, backend, db, src, logger)
+}
+
+type feeDisburserSource struct {
+	address  common.Address
+	contract *bindings.FeeDisburserFilterer
+	topics   []common.Hash
+}
+
+func (s *feeDisburserSource) name() string {
+	return "FeeDisburser:" + s.address.Hex()
+}
+
+func (s *feeDisburserSource) query(from, to uint64) ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []common.Address{s.address},
+		Topics:    [][]common.Hash{s.topics},
+	}
+}
+
+func (s *feeDisburserSource) insert(ctx context.Context, tx *sql.Tx, l types.Log) error {
+	if len(l.Topics) == 0 {
+		return nil
+	}
+	var (
+		query string
+		args  []interface{}
+	)
+	switch l.Topics[0] {
+	case s.topics[0]:
+		ev, err := s.contract.ParseFeesDisbursed(l)
+		if err != nil {
+			return fmt.Errorf("failed to parse FeesDisbursed: %w", err)
+		}
+		l1Share := new(big.Int).Sub(ev.TotalFeesDisbursed, ev.PaidToOptimism)
+		query = `INSERT INTO fee_disbursements (contract, block_number, block_hash, tx_hash, log_index, disbursement_time, total, optimism_share, l1_share) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		args = []interface{}{ev.DisbursementTime.Uint64(), ev.TotalFeesDisbursed.String(), ev.PaidToOptimism.String(), l1Share.String()}
+	case s.topics[1]:
+		ev, err := s.contract.ParseFeesReceived(l)
+		if err != nil {
+			return fmt.Errorf("failed to parse FeesReceived: %w", err)
+		}
+		query = `INSERT INTO fees_received (contract, block_number, block_hash, tx_hash, log_index, sender, amount) VALUES (?, ?, ?, ?, ?, ?, ?)`
+		args = []interface{}{ev.Sender.Hex(), ev.Amount.String()}
+	case s.topics[2]:
+		query = `INSERT INTO no_fees_collected (contract, block_number, block_hash, tx_hash, log_index) VALUES (?, ?, ?, ?, ?)`
+	default:
+		return nil
+	}
+	args = append([]interface{}{s.address.Hex(), l.BlockNumber, l.BlockHash.Hex(), l.TxHash.Hex(), l.Index}, args...)
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to store event: %w", err)
+	}
+	return nil
+}
+
+func (s *feeDisburserSource) revert(ctx context.Context, tx *sql.Tx, after int64) error {
+	for _, table := range []string{"fee_disbursements", "fees_received", "no_fees_collected"} {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE contract = ? AND block_number > ?`, s.address.Hex(), after); err != nil {
+			return fmt.Errorf("failed to delete from %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// Disbursements returns the disbursements of the FeeDisburser at contract in
+// blocks from to to, inclusive, oldest first.
+func (db *DB) Disbursements(ctx context.Context, contract common.Address, from, to uint64) ([]Disbursement, error) {
+	rows, err := db.db.QueryContext(ctx, `SELECT `+locationColumns+`, disbursement_time, total, optimism_share, l1_share FROM fee_disbursements
+		WHERE contract = ? AND block_number BETWEEN ? AND ? ORDER BY block_number, log_index`, contract.Hex(), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query disbursements: %w", err)
+	}
+	defer rows.Close()
+	var disbursements []Disbursement
+	for rows.Next() {
+		var (
+			d                             Disbursement
+			total, optimismShare, l1Share string
+		)
+		dest, fill := scanLocation(&d.Location)
+		if err := rows.Scan(append(dest, &d.Time, &total, &optimismShare, &l1Share)...); err != nil {
+			return nil, fmt.Errorf("failed to read disbursement: %w", err)
+		}
+		fill()
+		if d.Total, err = parseAmount(total); err != nil {
+			return nil, err
+		}
+		if d.OptimismShare, err = parseAmount(optimismShare); err != nil {
+			return nil, err
+		}
+		if d.L1Share, err = parseAmount(l1Share); err != nil {
+			return nil, err
+		}
+		disbursements = append(disbursements, d)
+	}
+	return disbursements, rows.Err()
+}
+
+// FeesReceived returns the fees received by the FeeDisburser at contract in
+// blocks from to to, inclusive, oldest first.
+func (db *DB) FeesReceived(ctx context.Context, contract common.Address, from, to uint64) ([]FeeReceipt, error) {
+	rows, err := db.db.QueryContext(ctx, `SELECT `+locationColumns+`, sender, amount FROM fees_received
+		WHERE contract = ? AND block_number BETWEEN ? AND ? ORDER BY block_number, log_index`, contract.Hex(), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query received fees: %w", err)
+	}
+	defer rows.Close()
+	var receipts []FeeReceipt
+	for rows.Next() {
+		var (
+			r              FeeReceipt
+			sender, amount string
+		)
+		dest, fill := scanLocation(&r.Location)
+		if err := rows.Scan(append(dest, &sender, &amount)...); err != nil {
+			return nil, fmt.Errorf("failed to read received fees: %w", err)
+		}
+		fill()
+		r.Sender = common.HexToAddress(sender)
+		if r.Amount, err = parseAmount(amount); err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, r)
+	}
+	return receipts, rows.Err()
+}
+
+// NoFeesCollected returns the disbursements of the FeeDisburser at contract
+// that collected no fees in blocks from to to, inclusive, oldest first.
+func (db *DB) NoFeesCollected(ctx context.Context, contract common.Address, from, to uint64) ([]NoFeesCollected, error) {
+	rows, err := db.db.QueryContext(ctx, `SELECT `+locationColumns+` FROM no_fees_collected
+		WHERE contract = ? AND block_number BETWEEN ? AND ? ORDER BY block_number, log_index`, contract.Hex(), from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query empty disbursements: %w", err)
+	}
+	defer rows.Close()
+	var events []NoFeesCollected
+	for rows.Next() {
+		var ev NoFeesCollected
+		dest, fill := scanLocation(&ev.Location)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to read empty disbursement: %w", err)
+		}
+		fill()
+		events = append(events, ev)
+	}
+	return events, rows.Err()
+}
@@ -0,0 +1,190 @@
+// Package indexer stores the events of the revenue-share contracts in an
+// SQLite database. It backfills them in chunks of blocks, then follows the
+// head, rewinding whatever was indexed from blocks that got reorged out.
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Backend is the chain access needed by an Indexer.
+type Backend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+}
+
+// Config configures an Indexer.
+type Config struct {
+	// StartBlock is the first block indexed, usually the deployment block of
+	// the contract.
+	StartBlock uint64
+	// ChunkSize is the largest number of blocks whose logs are fetched at once.
+	ChunkSize uint64
+	// Confirmations is how many blocks the indexer stays behind the head.
+	Confirmations uint64
+	// PollInterval is the time between two checks for new blocks once the
+	// indexer caught up with the head.
+	PollInterval time.Duration
+}
+
+// source indexes the events of one contract.
+type source interface {
+	// name identifies the checkpoints of the source.
+	name() string
+	// query returns the filter for the logs of the source in a block range.
+	query(from, to uint64) ethereum.FilterQuery
+	// insert stores the event of a log.
+	insert(ctx context.Context, tx *sql.Tx, l types.Log) error
+	// revert deletes the events stored for blocks after block number after.
+	revert(ctx context.Context, tx *sql.Tx, after int64) error
+}
+
+// Indexer indexes the events of a contract into a DB.
+type Indexer struct {
+	cfg     Config
+	backend Backend
+	db      *DB
+	src     source
+	log     log.Logger
+}
+
+func newIndexer(cfg Config, backend Backend, db *DB, src source, logger log.Logger) (*Indexer, error) {
+	if cfg.ChunkSize == 0 {
+		return nil, errors.New("indexer: chunk size must be positive")
+	}
+	return &Indexer{
+		cfg:     cfg,
+		backend: backend,
+		db:      db,
+		src:     src,
+		log:     logger,
+	}, nil
+}
+
+// Run keeps indexing new blocks until ctx is cancelled.
+func (ix *Indexer) Run(ctx context.Context) error {
+	for {
+		synced, err := ix.Sync(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			ix.log.Error("Failed to index events", "err", err)
+		}
+		wait := time.Duration(0)
+		if synced || err != nil {
+			wait = ix.cfg.PollInterval
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// Sync indexes the next chunk of blocks and reports whether the indexer caught
+// up with the head.
+func (ix *Indexer) Sync(ctx context.Context) (bool, error) {
+	head, err := ix.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch head: %w", err)
+	}
+	if head.Number.Uint64() < ix.cfg.Confirmations {
+		return true, nil
+	}
+	target := head.Number.Uint64() - ix.cfg.Confirmations
+
+	from, last, err := ix.resume(ctx)
+	if err != nil {
+		return false, err
+	}
+	if from > target {
+		return true, nil
+	}
+	to := min(from+ix.cfg.ChunkSize-1, target)
+
+	end, err := ix.header(ctx, to)
+	if err != nil {
+		return false, err
+	}
+	logs, err := ix.backend.FilterLogs(ctx, ix.src.query(from, to))
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch logs of blocks %d-%d: %w", from, to, err)
+	}
+	// The logs belong to the chain ending at end only if it still is the
+	// canonical chain after they were fetched.
+	if after, err := ix.header(ctx, to); err != nil {
+		return false, err
+	} else if after.Hash() != end.Hash() {
+		return false, fmt.Errorf("block %d reorged while indexing", to)
+	}
+	// They must also extend the indexed chain: a reorg at or below the last
+	// checkpoint after it was checked would leave stale events below them.
+	if last != nil {
+		first, err := ix.header(ctx, from)
+		if err != nil {
+			return false, err
+		}
+		if first.ParentHash != last.Hash {
+			return false, fmt.Errorf("block %d reorged while indexing", last.Number)
+		}
+	}
+
+	if err := ix.db.apply(ctx, ix.src, checkpoint{Number: to, Hash: end.Hash()}, logs); err != nil {
+		return false, err
+	}
+	ix.log.Info("Indexed blocks", "from", from, "to", to, "logs", len(logs), "head", head.Number)
+	return to == target, nil
+}
+
+// resume returns the first block to index, rewinding to the latest checkpoint
+// still on the canonical chain if a reorg happened. It also returns that
+// checkpoint, nil if indexing starts over from StartBlock.
+func (ix *Indexer) resume(ctx context.Context) (uint64, *checkpoint, error) {
+	checkpoints, err := ix.db.checkpoints(ctx, ix.src.name())
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(checkpoints) == 0 {
+		return ix.cfg.StartBlock, nil, nil
+	}
+	for i, cp := range checkpoints {
+		header, err := ix.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(cp.Number))
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return 0, nil, fmt.Errorf("failed to fetch block %d: %w", cp.Number, err)
+		}
+		if header == nil || header.Hash() != cp.Hash {
+			continue
+		}
+		if i > 0 {
+			ix.log.Warn("Reorg detected, rewinding", "from", checkpoints[0].Number, "to", cp.Number)
+			if err := ix.db.rewind(ctx, ix.src, int64(cp.Number)); err != nil {
+				return 0, nil, err
+			}
+		}
+		return cp.Number + 1, &checkpoints[i], nil
+	}
+	ix.log.Warn("Reorg deeper than all checkpoints, reindexing", "from", ix.cfg.StartBlock)
+	if err := ix.db.rewind(ctx, ix.src, -1); err != nil {
+		return 0, nil, err
+	}
+	return ix.cfg.StartBlock, nil, nil
+}
+
+func (ix *Indexer) header(ctx context.Context, number uint64) (*types.Header, error) {
+	header, err := ix.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch block %d: %w", number, err)
+	}
+	return header, nil
+}
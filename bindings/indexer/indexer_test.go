@@ -0,0 +1,266 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+// fakeChain is a Backend serving a chain of empty headers and given logs.
+type fakeChain struct {
+	headers []*types.Header
+	logs    map[uint64][]types.Log
+	queries int
+	// onHeader, if set, runs before serving a header by number.
+	onHeader func(number uint64)
+}
+
+// extend appends n blocks, using fork to tell apart blocks of different
+// forks at the same height.
+func (c *fakeChain) extend(n int, fork byte) {
+	for i := 0; i < n; i++ {
+		header := &types.Header{Number: big.NewInt(int64(len(c.headers))), Extra: []byte{fork}, Difficulty: common.Big0}
+		if len(c.headers) > 0 {
+			header.ParentHash = c.headers[len(c.headers)-1].Hash()
+		}
+		c.headers = append(c.headers, header)
+	}
+}
+
+// reorg drops the blocks from number on, and their logs.
+func (c *fakeChain) reorg(number uint64) {
+	c.headers = c.headers[:number]
+	for n := range c.logs {
+		if n >= number {
+			delete(c.logs, n)
+		}
+	}
+}
+
+func (c *fakeChain) addLog(number uint64, l types.Log) {
+	l.BlockNumber = number
+	l.BlockHash = c.headers[number].Hash()
+	l.TxHash = common.BigToHash(big.NewInt(int64(number)))
+	l.Index = uint(len(c.logs[number]))
+	c.logs[number] = append(c.logs[number], l)
+}
+
+func (c *fakeChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		return c.headers[len(c.headers)-1], nil
+	}
+	if c.onHeader != nil {
+		c.onHeader(number.Uint64())
+	}
+	if number.Uint64() >= uint64(len(c.headers)) {
+		return nil, ethereum.NotFound
+	}
+	return c.headers[number.Uint64()], nil
+}
+
+func (c *fakeChain) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.queries++
+	var logs []types.Log
+	for n := q.FromBlock.Uint64(); n <= q.ToBlock.Uint64(); n++ {
+		for _, l := range c.logs[n] {
+			if l.Address == q.Addresses[0] {
+				logs = append(logs, l)
+			}
+		}
+	}
+	return logs, nil
+}
+
+func feesDisbursedLog(t *testing.T, address common.Address, time, paid, total int64) types.Log {
+	event := feeDisburserEvent(t, "FeesDisbursed")
+	data, err := event.Inputs.Pack(big.NewInt(time), big.NewInt(paid), big.NewInt(total))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{Address: address, Topics: []common.Hash{event.ID}, Data: data}
+}
+
+func feesReceivedLog(t *testing.T, address, sender common.Address, amount int64) types.Log {
+	event := feeDisburserEvent(t, "FeesReceived")
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(amount))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{Address: address, Topics: []common.Hash{event.ID, common.BytesToHash(sender.Bytes())}, Data: data}
+}
+
+func feeDisburserEvent(t *testing.T, name string) abi.Event {
+	parsed, err := bindings.FeeDisburserMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed.Events[name]
+}
+
+// syncAll syncs until the indexer caught up with the head.
+func syncAll(t *testing.T, ix *Indexer) {
+	for i := 0; ; i++ {
+		synced, err := ix.Sync(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if synced {
+			return
+		}
+		if i > 100 {
+			t.Fatal("indexer did not catch up")
+		}
+	}
+}
+
+func TestFeeDisburserIndexer(t *testing.T) {
+	ctx := context.Background()
+	address := common.HexToAddress("0xfd")
+	other := common.HexToAddress("0xbeef")
+
+	chain := &fakeChain{logs: make(map[uint64][]types.Log)}
+	chain.extend(10, 0)
+	chain.addLog(3, feesReceivedLog(t, address, predeploys.SequencerFeeVault, 700))
+	chain.addLog(3, feesReceivedLog(t, other, predeploys.SequencerFeeVault, 1))
+	chain.addLog(5, feesDisbursedLog(t, address, 1000, 150, 1000))
+
+	db, err := Open(filepath.Join(t.TempDir(), "index.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	ix, err := NewFeeDisburser(address, Config{ChunkSize: 3}, chain, db, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	syncAll(t, ix)
+	if chain.queries != 4 {
+		t.Fatalf("fetched logs %d times, want 4", chain.queries)
+	}
+	received, err := db.FeesReceived(ctx, address, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 || received[0].Sender != predeploys.SequencerFeeVault || received[0].Amount.Int64() != 700 || received[0].BlockNumber != 3 {
+		t.Fatalf("unexpected received fees: %+v", received)
+	}
+	disbursements, err := db.Disbursements(ctx, address, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(disbursements) != 1 {
+		t.Fatalf("got %d disbursements, want 1", len(disbursements))
+	}
+	if d := disbursements[0]; d.BlockNumber != 5 || d.BlockHash != chain.headers[5].Hash() || d.OptimismShare.Int64() != 150 || d.L1Share.Int64() != 850 || d.Total.Int64() != 1000 || d.Time != 1000 {
+		t.Fatalf("unexpected disbursement: %+v", d)
+	}
+
+	// Replace the blocks from 4 on with a fork where the disbursement landed
+	// in block 7 instead and a later one collected no fees.
+	chain.reorg(4)
+	chain.extend(8, 1)
+	chain.addLog(7, feesDisbursedLog(t, address, 2000, 300, 2000))
+	chain.addLog(9, types.Log{Address: address, Topics: []common.Hash{feeDisburserEvent(t, "NoFeesCollected").ID}})
+
+	syncAll(t, ix)
+	disbursements, err = db.Disbursements(ctx, address, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(disbursements) != 1 || disbursements[0].BlockNumber != 7 || disbursements[0].BlockHash != chain.headers[7].Hash() {
+		t.Fatalf("unexpected disbursements after reorg: %+v", disbursements)
+	}
+	received, err = db.FeesReceived(ctx, address, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 1 {
+		t.Fatalf("got %d received fees after reorg, want 1", len(received))
+	}
+	empty, err := db.NoFeesCollected(ctx, address, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(empty) != 1 || empty[0].BlockNumber != 9 {
+		t.Fatalf("unexpected empty disbursements: %+v", empty)
+	}
+}
+
+func TestSyncStaysBehindHead(t *testing.T) {
+	chain := &fakeChain{logs: make(map[uint64][]types.Log)}
+	chain.extend(5, 0)
+	db, err := Open(filepath.Join(t.TempDir(), "index.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	ix, err := NewFeeDisburser(common.HexToAddress("0xfd"), Config{ChunkSize: 100, Confirmations: 2}, chain, db, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	syncAll(t, ix)
+	checkpoints, err := db.checkpoints(context.Background(), ix.src.name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checkpoints) != 1 || checkpoints[0].Number != 2 {
+		t.Fatalf("unexpected checkpoints: %+v", checkpoints)
+	}
+}
+
+func TestSyncReorgBelowChunk(t *testing.T) {
+	ctx := context.Background()
+	address := common.HexToAddress("0xfd")
+
+	chain := &fakeChain{logs: make(map[uint64][]types.Log)}
+	chain.extend(6, 0)
+	chain.addLog(2, feesDisbursedLog(t, address, 1000, 150, 1000))
+
+	db, err := Open(filepath.Join(t.TempDir(), "index.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	ix, err := NewFeeDisburser(address, Config{ChunkSize: 3}, chain, db, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ix.Sync(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reorg the checkpointed block 2 away once the next chunk is being
+	// indexed, after its checkpoint was found canonical.
+	chain.onHeader = func(number uint64) {
+		if number != 5 {
+			return
+		}
+		chain.onHeader = nil
+		chain.reorg(2)
+		chain.extend(4, 1)
+		chain.addLog(4, feesDisbursedLog(t, address, 2000, 300, 2000))
+	}
+	if _, err := ix.Sync(ctx); err == nil {
+		t.Fatal("expected the reorg to be detected")
+	}
+
+	syncAll(t, ix)
+	disbursements, err := db.Disbursements(ctx, address, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(disbursements) != 1 || disbursements[0].BlockNumber != 4 || disbursements[0].BlockHash != chain.headers[4].Hash() {
+		t.Fatalf("unexpected disbursements after reorg: %+v", disbursements)
+	}
+}
@@ -0,0 +1,204 @@
+// Package keeper contains the services that call the revenue-share contracts,
+// and the OptimismPortal for the withdrawals they initiate, whenever there is
+// work for them to do.
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/revshare"
+	"github.com/base-org/contracts/bindings/txmgr"
+)
+
+// Backend is the chain access needed by the keepers.
+type Backend interface {
+	bind.ContractBackend
+	txmgr.Backend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// DisburserConfig configures a Disburser.
+type DisburserConfig struct {
+	// FeeDisburser is the address of the FeeDisburser contract.
+	FeeDisburser common.Address
+	// StatePath is the file in-flight transactions are persisted to.
+	StatePath string
+	// PollInterval is the longest the keeper sleeps between two checks.
+	PollInterval time.Duration
+}
+
+// Disburser calls FeeDisburser.disburseFees once the disbursement interval has
+// passed and there are fees to collect.
+type Disburser struct {
+	cfg      DisburserConfig
+	backend  Backend
+	contract *bindings.FeeDisburser
+	revshare *revshare.Reader
+	sender   *sender
+	log      log.Logger
+}
+
+// NewDisburser creates a Disburser, restoring any in-flight call from the
+// state file.
+func NewDisburser(cfg DisburserConfig, backend Backend, txm *txmgr.Manager, logger log.Logger) (*Disburser, error) {
+	contract, err := bindings.NewFeeDisburser(cfg.FeeDisburser, backend)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := revshare.NewReader(cfg.FeeDisburser, backend)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := newSender(backend, txm, cfg.StatePath, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Disburser{
+		cfg:      cfg,
+		backend:  backend,
+		contract: contract,
+		revshare: reader,
+		sender:   sender,
+		log:      logger,
+	}, nil
+}
+
+// Run keeps disbursing fees until ctx is cancelled.
+func (d *Disburser) Run(ctx context.Context) error {
+	for {
+		wait, err := d.step(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			d.log.Error("Failed to disburse fees", "err", err)
+			wait = d.cfg.PollInterval
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// step performs a single check and returns how long to wait before the next.
+func (d *Disburser) step(ctx context.Context) (time.Duration, error) {
+	if d.sender.pending() {
+		receipt, err := d.sender.resume(ctx)
+		if receipt != nil {
+			d.logReceipt(receipt)
+		}
+		return 0, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	last, err := d.contract.LastDisbursementTime(opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch lastDisbursementTime: %w", err)
+	}
+	interval, err := d.contract.FEEDISBURSEMENTINTERVAL(opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch FEE_DISBURSEMENT_INTERVAL: %w", err)
+	}
+	head, err := d.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch head: %w", err)
+	}
+	if wait := untilDisbursement(last, interval, head.Time); wait > 0 {
+		d.log.Debug("Disbursement interval not reached", "wait", wait)
+		return min(wait, d.cfg.PollInterval), nil
+	}
+
+	// feeVaultWithdrawal reverts on a misconfigured vault, report all of them
+	// rather than the first revert reason of the simulation.
+	violations, err := d.revshare.Audit(opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to audit fee vaults: %w", err)
+	}
+	if len(violations) > 0 {
+		for _, v := range violations {
+			d.log.Error("Fee vault misconfigured", "vault", v.Vault, "err", v.Err, "got", v.Got)
+		}
+		d.log.Warn("Fee vaults misconfigured, skipping disbursement", "violations", len(violations))
+		return d.cfg.PollInterval, nil
+	}
+
+	prediction, err := d.revshare.Predict(opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to predict disbursement: %w", err)
+	}
+	if prediction.Split == nil {
+		d.log.Info("Fee vaults below minimum withdrawal amount, skipping disbursement")
+		return d.cfg.PollInterval, nil
+	}
+	d.log.Info("Disbursing fees", "withdrawn", len(prediction.Withdrawn), "total", prediction.Split.GrossRevenue, "optimismShare", prediction.Split.OptimismShare, "l1Share", prediction.Split.L1Share)
+
+	data, err := d.calldata()
+	if err != nil {
+		return 0, err
+	}
+	gasLimit, err := d.sender.simulate(ctx, d.cfg.FeeDisburser, data)
+	if err != nil {
+		d.log.Warn("disburseFees simulation failed, skipping disbursement", "err", err)
+		return d.cfg.PollInterval, nil
+	}
+	receipt, err := d.sender.submit(ctx, d.cfg.FeeDisburser, data, gasLimit)
+	if receipt != nil {
+		d.logReceipt(receipt)
+	}
+	return 0, err
+}
+
+func (d *Disburser) logReceipt(receipt *types.Receipt) {
+	logger := d.log.New("tx", receipt.TxHash, "block", receipt.BlockNumber)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		logger.Error("disburseFees transaction reverted")
+		return
+	}
+	for _, l := range receipt.Logs {
+		if l.Address != d.cfg.FeeDisburser {
+			continue
+		}
+		if disbursed, err := d.contract.ParseFeesDisbursed(*l); err == nil {
+			logger.Info("Disbursed fees", "paidToOptimism", disbursed.PaidToOptimism, "total", disbursed.TotalFeesDisbursed)
+			return
+		}
+		if _, err := d.contract.ParseNoFeesCollected(*l); err == nil {
+			logger.Warn("disburseFees collected no fees")
+			return
+		}
+	}
+}
+
+func (d *Disburser) calldata() ([]byte, error) {
+	parsed, err := bindings.FeeDisburserMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("disburseFees")
+}
+
+// untilDisbursement returns how long it takes until disburseFees may be called
+// at a block with timestamp now.
+func untilDisbursement(lastDisbursementTime, interval *big.Int, now uint64) time.Duration {
+	next := new(big.Int).Add(lastDisbursementTime, interval)
+	wait := next.Sub(next, new(big.Int).SetUint64(now))
+	if wait.Sign() <= 0 {
+		return 0
+	}
+	if !wait.IsInt64() || wait.Int64() > int64(math.MaxInt64/time.Second) {
+		return math.MaxInt64
+	}
+	return time.Duration(wait.Int64()) * time.Second
+}
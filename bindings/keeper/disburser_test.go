@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/predeploys"
+	"github.com/base-org/contracts/bindings/txmgr"
+)
+
+func TestUntilDisbursement(t *testing.T) {
+	day := big.NewInt(24 * 60 * 60)
+	tests := []struct {
+		name string
+		last int64
+		now  uint64
+		want time.Duration
+	}{
+		{"never disbursed", 0, 1_700_000_000, 0},
+		{"interval reached", 1_000, 1_000 + 86_400, 0},
+		{"interval not reached", 1_000, 1_000 + 86_399, time.Second},
+		{"just disbursed", 1_000, 1_000, 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := untilDisbursement(big.NewInt(tt.last), day, tt.now); got != tt.want {
+				t.Fatalf("untilDisbursement = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStateRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	var missing callState
+	if err := loadState(path, &missing); err != nil {
+		t.Fatal(err)
+	}
+	if missing.Pending != nil {
+		t.Fatal("expected empty state for a missing file")
+	}
+
+	saved := callState{Pending: &pendingCall{Nonce: 7, GasLimit: 200_000, Attempts: []common.Hash{{1}, {2}}}}
+	if err := saveState(path, &saved); err != nil {
+		t.Fatal(err)
+	}
+	var loaded callState
+	if err := loadState(path, &loaded); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Pending == nil || loaded.Pending.Nonce != 7 || loaded.Pending.GasLimit != 200_000 || len(loaded.Pending.Attempts) != 2 {
+		t.Fatalf("unexpected state %+v", loaded.Pending)
+	}
+}
+
+// fakeFeeDisburser is a simulated L2 chain on which the FeeDisburser and the
+// fee vaults are faked: calls to them are answered from memory and
+// disburseFees is mined as a plain call.
+type fakeFeeDisburser struct {
+	simulated.Client
+	sim              *simulated.Backend
+	lastDisbursement uint64
+	recipient        common.Address
+	reverts          bool
+	sent             int
+}
+
+func (b *fakeFeeDisburser) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	metadata := bindings.FeeDisburserMetaData
+	if *call.To != feeDisburser {
+		metadata = bindings.FeeVaultMetaData
+		if !isFeeVault(*call.To) {
+			return b.Client.CallContract(ctx, call, blockNumber)
+		}
+	}
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data)
+	if err != nil {
+		return nil, errors.New("execution reverted")
+	}
+	switch method.Name {
+	case "lastDisbursementTime":
+		return method.Outputs.Pack(new(big.Int).SetUint64(b.lastDisbursement))
+	case "FEE_DISBURSEMENT_INTERVAL":
+		return method.Outputs.Pack(big.NewInt(24 * 60 * 60))
+	case "OPTIMISM_NET_REVENUE_SHARE_BASIS_POINTS":
+		return method.Outputs.Pack(big.NewInt(1_500))
+	case "OPTIMISM_GROSS_REVENUE_SHARE_BASIS_POINTS":
+		return method.Outputs.Pack(big.NewInt(250))
+	case "BASIS_POINT_SCALE":
+		return method.Outputs.Pack(uint32(10_000))
+	case "netFeeRevenue":
+		return method.Outputs.Pack(new(big.Int))
+	case "disburseFees":
+		if b.reverts {
+			return nil, errors.New("execution reverted: FeeDisburser: Disbursement interval not reached")
+		}
+		return nil, nil
+	case "WITHDRAWAL_NETWORK":
+		return method.Outputs.Pack(uint8(1))
+	case "RECIPIENT":
+		return method.Outputs.Pack(b.recipient)
+	case "MIN_WITHDRAWAL_AMOUNT":
+		return method.Outputs.Pack(big.NewInt(params.Ether))
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func (b *fakeFeeDisburser) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	b.sent++
+	b.sim.Commit()
+	return nil
+}
+
+func isFeeVault(address common.Address) bool {
+	for _, vault := range predeploys.FeeVaults {
+		if address == vault {
+			return true
+		}
+	}
+	return false
+}
+
+// newTestDisburser returns a Disburser of a FeeDisburser whose fee vaults
+// hold vaultBalance each, and which is due for a disbursement.
+func newTestDisburser(t *testing.T, vaultBalance int64) (*Disburser, *fakeFeeDisburser, *bind.TransactOpts) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+	alloc := types.GenesisAlloc{opts.From: {Balance: big.NewInt(params.Ether)}}
+	for _, vault := range predeploys.FeeVaults {
+		alloc[vault] = types.Account{Balance: new(big.Int).Mul(big.NewInt(vaultBalance), big.NewInt(params.Ether))}
+	}
+	sim := simulated.NewBackend(alloc)
+	t.Cleanup(func() { sim.Close() })
+	// The FeeDisburser never disbursed, start a day after genesis.
+	if err := sim.AdjustTime(24 * time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+	backend := &fakeFeeDisburser{Client: sim.Client(), sim: sim, recipient: feeDisburser}
+
+	txm := txmgr.New(backend, opts, txmgr.Config{ResubmitInterval: time.Second, ReceiptPollInterval: 10 * time.Millisecond, FeeBumpPercent: 10}, log.Root())
+	d, err := NewDisburser(DisburserConfig{
+		FeeDisburser: feeDisburser,
+		StatePath:    filepath.Join(t.TempDir(), "state.json"),
+		PollInterval: time.Minute,
+	}, backend, txm, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d, backend, opts
+}
+
+func TestDisburserStepSends(t *testing.T) {
+	d, backend, _ := newTestDisburser(t, 2)
+
+	wait, err := d.step(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wait != 0 || backend.sent != 1 {
+		t.Fatalf("got wait %v after %d transactions, want disburseFees sent", wait, backend.sent)
+	}
+	if d.sender.pending() {
+		t.Fatal("call still pending after its transaction was mined")
+	}
+}
+
+func TestDisburserStepSkips(t *testing.T) {
+	tests := []struct {
+		name         string
+		vaultBalance int64
+		setup        func(t *testing.T, b *fakeFeeDisburser)
+	}{
+		{"interval not reached", 2, func(t *testing.T, b *fakeFeeDisburser) {
+			head, err := b.HeaderByNumber(context.Background(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b.lastDisbursement = head.Time
+		}},
+		{"fee vault misconfigured", 2, func(t *testing.T, b *fakeFeeDisburser) {
+			b.recipient = common.HexToAddress("0xbad")
+		}},
+		{"fee vaults below minimum withdrawal amount", 0, func(t *testing.T, b *fakeFeeDisburser) {}},
+		{"simulation reverts", 2, func(t *testing.T, b *fakeFeeDisburser) {
+			b.reverts = true
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, backend, _ := newTestDisburser(t, tt.vaultBalance)
+			tt.setup(t, backend)
+
+			wait, err := d.step(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if wait != d.cfg.PollInterval || backend.sent != 0 {
+				t.Fatalf("got wait %v after %d transactions, want a skipped disbursement", wait, backend.sent)
+			}
+		})
+	}
+}
+
+func TestDisburserStepResumes(t *testing.T) {
+	d, backend, opts := newTestDisburser(t, 2)
+	data, err := d.calldata()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A previous run persisted the call and broadcast an attempt, which got
+	// mined while the keeper was down.
+	to := feeDisburser
+	mined, err := opts.Signer(opts.From, types.NewTx(&types.DynamicFeeTx{
+		Nonce:     0,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(10 * params.GWei),
+		Gas:       100_000,
+		To:        &to,
+		Data:      data,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := backend.Client.SendTransaction(context.Background(), mined); err != nil {
+		t.Fatal(err)
+	}
+	backend.sim.Commit()
+	d.sender.state.Pending = &pendingCall{To: feeDisburser, Data: data, Nonce: 0, GasLimit: 100_000, Attempts: []common.Hash{mined.Hash()}}
+
+	if _, err := d.step(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if backend.sent != 0 || d.sender.pending() {
+		t.Fatalf("sent %d transactions, pending %v, want the mined attempt settled", backend.sent, d.sender.pending())
+	}
+
+	// The attempt of the next call was never broadcast, it is resubmitted
+	// with the persisted nonce.
+	d.sender.state.Pending = &pendingCall{To: feeDisburser, Data: data, Nonce: 1, GasLimit: 100_000}
+	if _, err := d.step(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	nonce, err := backend.NonceAt(context.Background(), opts.From, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend.sent != 1 || nonce != 2 || d.sender.pending() {
+		t.Fatalf("sent %d transactions up to nonce %d, pending %v, want the call resubmitted", backend.sent, nonce, d.sender.pending())
+	}
+}
@@ -0,0 +1,294 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/txmgr"
+	"github.com/base-org/contracts/bindings/withdrawals"
+)
+
+// ProverL2Backend is the L2 chain access needed by a Prover. ethclient.Client
+// implements it.
+type ProverL2Backend interface {
+	withdrawals.L2Backend
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// ProverConfig configures a Prover.
+type ProverConfig struct {
+	// FeeDisburser is the address of the FeeDisburser contract on L2.
+	FeeDisburser common.Address
+	// L1Wallet is the L1_WALLET of the FeeDisburser, the recipient of the
+	// withdrawals proven and finalized.
+	L1Wallet common.Address
+	// OptimismPortal is the address of the OptimismPortal proxy on L1.
+	OptimismPortal common.Address
+	// L2OutputOracle is the address of the L2OutputOracle proxy on L1,
+	// unused if the OptimismPortal proves withdrawals against dispute games.
+	L2OutputOracle common.Address
+	// StatePath is the file in-flight transactions are persisted to.
+	StatePath string
+	// PollInterval is the time between two checks once there is nothing to
+	// prove or finalize.
+	PollInterval time.Duration
+	// StartBlock is the first L2 block searched for disbursements. Earlier
+	// withdrawals are left alone.
+	StartBlock uint64
+	// ChunkSize is the largest number of L2 blocks whose logs are fetched at
+	// once.
+	ChunkSize uint64
+}
+
+// Prover proves the withdrawals initiated by FeeDisburser.disburseFees on L1
+// once their L2 block is covered by a proposed output or dispute game, and
+// finalizes them once the challenge period has passed. Disbursements are
+// searched from the start block on every start, withdrawals already finalized
+// are then skipped.
+type Prover struct {
+	cfg           ProverConfig
+	l1            Backend
+	l2            ProverL2Backend
+	portal        *withdrawals.Portal
+	feesDisbursed common.Hash
+	sender        *sender
+	log           log.Logger
+
+	// next is the next L2 block searched for disbursements.
+	next uint64
+	// outstanding are the withdrawals not finalized yet, in the order they
+	// were initiated.
+	outstanding []*withdrawals.Withdrawal
+}
+
+// NewProver creates a Prover sending L1 transactions through txm, restoring
+// any in-flight call from the state file.
+func NewProver(cfg ProverConfig, l1 Backend, l2 ProverL2Backend, txm *txmgr.Manager, logger log.Logger) (*Prover, error) {
+	if cfg.ChunkSize == 0 {
+		return nil, errors.New("keeper: chunk size must be positive")
+	}
+	parsed, err := bindings.FeeDisburserMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	sender, err := newSender(l1, txm, cfg.StatePath, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Prover{
+		cfg:           cfg,
+		l1:            l1,
+		l2:            l2,
+		feesDisbursed: parsed.Events["FeesDisbursed"].ID,
+		sender:        sender,
+		log:           logger,
+		next:          cfg.StartBlock,
+	}, nil
+}
+
+// Run keeps proving and finalizing withdrawals until ctx is cancelled. It
+// fails right away if the OptimismPortal isn't supported.
+func (p *Prover) Run(ctx context.Context) error {
+	if err := p.init(ctx); err != nil {
+		return err
+	}
+	for {
+		wait, err := p.step(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			p.log.Error("Failed to process withdrawals", "err", err)
+			wait = p.cfg.PollInterval
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// init detects whether the OptimismPortal proves withdrawals against the
+// L2OutputOracle or against dispute games.
+func (p *Prover) init(ctx context.Context) error {
+	portal, err := withdrawals.NewPortal(&bind.CallOpts{Context: ctx}, withdrawals.PortalConfig{
+		OptimismPortal: p.cfg.OptimismPortal,
+		L2OutputOracle: p.cfg.L2OutputOracle,
+		ProofSubmitter: p.sender.txmgr.From(),
+	}, p.l1, p.l2)
+	if err != nil {
+		return err
+	}
+	p.portal = portal
+	p.log.Info("Detected OptimismPortal", "version", portal.Version(), "faultProofs", portal.FaultProofs())
+	return nil
+}
+
+// step sends at most one transaction and returns how long to wait before the
+// next step.
+func (p *Prover) step(ctx context.Context) (time.Duration, error) {
+	if p.sender.pending() {
+		receipt, err := p.sender.resume(ctx)
+		if receipt != nil {
+			p.logReceipt(receipt)
+		}
+		return 0, err
+	}
+	if err := p.scan(ctx); err != nil {
+		return 0, err
+	}
+	if len(p.outstanding) == 0 {
+		return p.cfg.PollInterval, nil
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	head, err := p.l1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	for i := 0; i < len(p.outstanding); {
+		w := p.outstanding[i]
+		finalized, err := p.portal.Finalized(opts, w.Hash)
+		if err != nil {
+			return 0, err
+		}
+		if finalized {
+			p.log.Info("Withdrawal finalized", "withdrawal", w.Hash, "l2Tx", w.TxHash)
+			p.outstanding = append(p.outstanding[:i], p.outstanding[i+1:]...)
+			continue
+		}
+		// A withdrawal that can't be processed must not hold back the others.
+		data, err := p.calldata(ctx, w, head.Time)
+		if err != nil {
+			p.log.Error("Failed to process withdrawal", "withdrawal", w.Hash, "l2Tx", w.TxHash, "err", err)
+			i++
+			continue
+		}
+		if data == nil {
+			i++
+			continue
+		}
+		gasLimit, err := p.sender.simulate(ctx, p.cfg.OptimismPortal, data)
+		if err != nil {
+			p.log.Warn("OptimismPortal call simulation failed, skipping withdrawal", "withdrawal", w.Hash, "err", err)
+			i++
+			continue
+		}
+		receipt, err := p.sender.submit(ctx, p.cfg.OptimismPortal, data, gasLimit)
+		if receipt != nil {
+			p.logReceipt(receipt)
+		}
+		return 0, err
+	}
+	return p.cfg.PollInterval, nil
+}
+
+// scan appends the withdrawals of the disbursements up to the finalized L2
+// block to the outstanding ones. Later disbursements may still be reorged
+// out, along with their receipts, and are left for the next scans.
+func (p *Prover) scan(ctx context.Context) error {
+	head, err := p.l2.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return fmt.Errorf("failed to fetch finalized L2 block: %w", err)
+	}
+	end := head.Number.Uint64()
+	for from := p.next; from <= end; from += p.cfg.ChunkSize {
+		to := min(from+p.cfg.ChunkSize-1, end)
+		logs, err := p.l2.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{p.cfg.FeeDisburser},
+			Topics:    [][]common.Hash{{p.feesDisbursed}},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to fetch FeeDisburser logs of blocks %d-%d: %w", from, to, err)
+		}
+		for _, l := range logs {
+			if l.Removed {
+				continue
+			}
+			receipt, err := p.l2.TransactionReceipt(ctx, l.TxHash)
+			if err != nil {
+				return fmt.Errorf("failed to fetch receipt of disbursement %s: %w", l.TxHash, err)
+			}
+			ws, err := withdrawals.FromReceipt(receipt)
+			if err != nil {
+				return fmt.Errorf("disbursement %s: %w", l.TxHash, err)
+			}
+			// disburseFees bridges the L1 share with a single withdrawal of
+			// the L2CrossDomainMessenger.
+			w := withdrawals.BridgingETHTo(ws, p.cfg.L1Wallet)
+			if w == nil {
+				p.log.Warn("Disbursement initiated no withdrawal to the L1 wallet", "l2Tx", l.TxHash)
+				continue
+			}
+			p.log.Info("Found disbursement withdrawal", "withdrawal", w.Hash, "l2Tx", w.TxHash, "value", w.Value)
+			p.outstanding = append(p.outstanding, w)
+		}
+		p.next = to + 1
+	}
+	return nil
+}
+
+// calldata returns the OptimismPortal call progressing w at L1 time now, nil
+// if there is nothing to do yet. A withdrawal whose proof is no longer valid,
+// because its output root was replaced or its dispute game was lost, is
+// proven again.
+func (p *Prover) calldata(ctx context.Context, w *withdrawals.Withdrawal, now uint64) ([]byte, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	proven, err := p.portal.ProvenWithdrawal(opts, w.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if proven != nil {
+		ready, err := p.portal.Maturity(opts, proven)
+		switch {
+		case errors.Is(err, withdrawals.ErrGameInProgress):
+			p.log.Debug("Withdrawal dispute game not resolved yet", "withdrawal", w.Hash, "game", proven.DisputeGame)
+			return nil, nil
+		case errors.Is(err, withdrawals.ErrInvalidProof):
+			p.log.Warn("Proof no longer valid, proving withdrawal again", "withdrawal", w.Hash, "err", err)
+		case err != nil:
+			return nil, err
+		case now <= ready:
+			p.log.Debug("Withdrawal in challenge period", "withdrawal", w.Hash, "wait", time.Duration(ready-now+1)*time.Second)
+			return nil, nil
+		default:
+			p.log.Info("Finalizing withdrawal", "withdrawal", w.Hash, "value", w.Value)
+			return withdrawals.FinalizeCalldata(w)
+		}
+	}
+
+	proof, err := p.portal.Prove(ctx, w)
+	if errors.Is(err, withdrawals.ErrNotProposed) {
+		p.log.Debug("Withdrawal block not proposed yet", "withdrawal", w.Hash, "l2Block", w.BlockNumber)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	p.log.Info("Proving withdrawal", "withdrawal", w.Hash, "output", proof.L2OutputIndex, "value", w.Value)
+	return withdrawals.ProveCalldata(w, proof)
+}
+
+func (p *Prover) logReceipt(receipt *types.Receipt) {
+	logger := p.log.New("tx", receipt.TxHash, "block", receipt.BlockNumber)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		logger.Error("OptimismPortal transaction reverted")
+		return
+	}
+	logger.Info("OptimismPortal transaction mined")
+}
@@ -0,0 +1,721 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/predeploys"
+	"github.com/base-org/contracts/bindings/txmgr"
+	"github.com/base-org/contracts/bindings/withdrawals"
+)
+
+// bridgeABI holds the OptimismPortal and L2OutputOracle functions and the
+// L2ToL1MessagePasser event faked by the tests.
+const bridgeABI = `[
+{"type":"function","name":"proveWithdrawalTransaction","inputs":[{"name":"_tx","type":"tuple","components":[{"name":"nonce","type":"uint256"},{"name":"sender","type":"address"},{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"gasLimit","type":"uint256"},{"name":"data","type":"bytes"}]},{"name":"_l2OutputIndex","type":"uint256"},{"name":"_outputRootProof","type":"tuple","components":[{"name":"version","type":"bytes32"},{"name":"stateRoot","type":"bytes32"},{"name":"messagePasserStorageRoot","type":"bytes32"},{"name":"latestBlockhash","type":"bytes32"}]},{"name":"_withdrawalProof","type":"bytes[]"}],"outputs":[]},
+{"type":"function","name":"finalizeWithdrawalTransaction","inputs":[{"name":"_tx","type":"tuple","components":[{"name":"nonce","type":"uint256"},{"name":"sender","type":"address"},{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"gasLimit","type":"uint256"},{"name":"data","type":"bytes"}]}],"outputs":[]},
+{"type":"function","name":"provenWithdrawals","inputs":[{"name":"","type":"bytes32"}],"outputs":[{"name":"outputRoot","type":"bytes32"},{"name":"timestamp","type":"uint128"},{"name":"l2OutputIndex","type":"uint128"}]},
+{"type":"function","name":"finalizedWithdrawals","inputs":[{"name":"","type":"bytes32"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"function","name":"version","inputs":[],"outputs":[{"name":"","type":"string"}]},
+{"type":"function","name":"FINALIZATION_PERIOD_SECONDS","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"latestBlockNumber","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"getL2OutputIndexAfter","inputs":[{"name":"_l2BlockNumber","type":"uint256"}],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"getL2Output","inputs":[{"name":"_l2OutputIndex","type":"uint256"}],"outputs":[{"name":"","type":"tuple","components":[{"name":"outputRoot","type":"bytes32"},{"name":"timestamp","type":"uint128"},{"name":"l2BlockNumber","type":"uint128"}]}]},
+{"type":"event","name":"MessagePassed","inputs":[{"name":"nonce","type":"uint256","indexed":true},{"name":"sender","type":"address","indexed":true},{"name":"target","type":"address","indexed":true},{"name":"value","type":"uint256"},{"name":"gasLimit","type":"uint256"},{"name":"data","type":"bytes"},{"name":"withdrawalHash","type":"bytes32"}]}
+]`
+
+// faultProofsABI holds the OptimismPortal2, DisputeGameFactory and dispute
+// game functions faked by the tests. go-ethereum suffixes the
+// provenWithdrawals overload of OptimismPortal2 with 0 once merged with
+// bridgeABI.
+const faultProofsABI = `[
+{"type":"function","name":"provenWithdrawals","inputs":[{"name":"","type":"bytes32"},{"name":"","type":"address"}],"outputs":[{"name":"disputeGameProxy","type":"address"},{"name":"timestamp","type":"uint64"}]},
+{"type":"function","name":"disputeGameFactory","inputs":[],"outputs":[{"name":"","type":"address"}]},
+{"type":"function","name":"respectedGameType","inputs":[],"outputs":[{"name":"","type":"uint32"}]},
+{"type":"function","name":"respectedGameTypeUpdatedAt","inputs":[],"outputs":[{"name":"","type":"uint64"}]},
+{"type":"function","name":"disputeGameBlacklist","inputs":[{"name":"","type":"address"}],"outputs":[{"name":"","type":"bool"}]},
+{"type":"function","name":"proofMaturityDelaySeconds","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"disputeGameFinalityDelaySeconds","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"gameCount","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"type":"function","name":"findLatestGames","inputs":[{"name":"_gameType","type":"uint32"},{"name":"_start","type":"uint256"},{"name":"_n","type":"uint256"}],"outputs":[{"name":"games_","type":"tuple[]","components":[{"name":"index","type":"uint256"},{"name":"metadata","type":"bytes32"},{"name":"timestamp","type":"uint64"},{"name":"rootClaim","type":"bytes32"},{"name":"extraData","type":"bytes"}]}]},
+{"type":"function","name":"gameType","inputs":[],"outputs":[{"name":"","type":"uint32"}]},
+{"type":"function","name":"status","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+{"type":"function","name":"createdAt","inputs":[],"outputs":[{"name":"","type":"uint64"}]},
+{"type":"function","name":"resolvedAt","inputs":[],"outputs":[{"name":"","type":"uint64"}]}
+]`
+
+// messagesABI holds the relayMessage call of the withdrawals of the
+// L2CrossDomainMessenger, and the finalizeBridgeETH call it relays.
+const messagesABI = `[
+{"type":"function","name":"relayMessage","inputs":[{"name":"_nonce","type":"uint256"},{"name":"_sender","type":"address"},{"name":"_target","type":"address"},{"name":"_value","type":"uint256"},{"name":"_minGasLimit","type":"uint256"},{"name":"_message","type":"bytes"}],"outputs":[]},
+{"type":"function","name":"finalizeBridgeETH","inputs":[{"name":"_from","type":"address"},{"name":"_to","type":"address"},{"name":"_amount","type":"uint256"},{"name":"_extraData","type":"bytes"}],"outputs":[]}
+]`
+
+const (
+	finalizationPeriod = 3_600
+	// airGap is the time after the resolution of a dispute game before
+	// withdrawals proven against it can be finalized.
+	airGap = 600
+)
+
+// The GameStatus of a dispute game.
+const (
+	gameInProgress uint8 = iota
+	gameChallengerWins
+	gameDefenderWins
+)
+
+var (
+	feeDisburser = common.HexToAddress("0xfeed")
+	portal       = common.HexToAddress("0x0b0b")
+	oracle       = common.HexToAddress("0x0c1e")
+	factory      = common.HexToAddress("0xfac7")
+	l1Wallet     = common.HexToAddress("0x11")
+)
+
+type provenWithdrawal struct {
+	outputRoot common.Hash
+	timestamp  uint64
+	index      *big.Int
+}
+
+type output struct {
+	OutputRoot    [32]byte
+	Timestamp     *big.Int
+	L2BlockNumber *big.Int
+}
+
+// game is the state of the dispute game claiming an output.
+type game struct {
+	status     uint8
+	resolvedAt uint64
+}
+
+// gameResult is the GameSearchResult tuple of the DisputeGameFactory.
+type gameResult struct {
+	Index     *big.Int
+	Metadata  [32]byte
+	Timestamp uint64
+	RootClaim [32]byte
+	ExtraData []byte
+}
+
+func gameProxy(index int) common.Address {
+	return common.BigToAddress(big.NewInt(int64(0x6a00 + index)))
+}
+
+// fakeBridge is a simulated L1 chain on which the OptimismPortal and the
+// L2OutputOracle are faked: calls to them are answered from memory and their
+// transactions, verified like the portal would, are mined as plain calls. If
+// faultProofs is set, the portal is an OptimismPortal2 and every output is
+// claimed by a dispute game instead.
+type fakeBridge struct {
+	simulated.Client
+	t           *testing.T
+	sim         *simulated.Backend
+	abi         abi.ABI
+	faultProofs bool
+	outputs     []output
+	games       []*game
+	proven      map[common.Hash]*provenWithdrawal
+	finalized   map[common.Hash]bool
+}
+
+func (b *fakeBridge) now() uint64 {
+	head, err := b.Client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		b.t.Fatal(err)
+	}
+	return head.Time
+}
+
+func (b *fakeBridge) propose(root common.Hash, l2Block uint64) {
+	b.outputs = append(b.outputs, output{OutputRoot: root, Timestamp: new(big.Int).SetUint64(b.now()), L2BlockNumber: new(big.Int).SetUint64(l2Block)})
+	if !b.faultProofs {
+		return
+	}
+	b.games = append(b.games, &game{})
+}
+
+// resolve resolves the dispute game of the output with the given index in
+// favor of its proposer.
+func (b *fakeBridge) resolve(index int) {
+	b.games[index].status, b.games[index].resolvedAt = gameDefenderWins, b.now()
+}
+
+func (b *fakeBridge) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	switch {
+	case *call.To == portal, *call.To == oracle && !b.faultProofs:
+		return b.callBridge(call)
+	case *call.To == factory && b.faultProofs:
+		return b.callFactory(call)
+	}
+	for i := range b.games {
+		if *call.To == gameProxy(i) {
+			return b.callGame(i, call)
+		}
+	}
+	return b.Client.CallContract(ctx, call, blockNumber)
+}
+
+// unpack returns the method called by data and its arguments.
+func (b *fakeBridge) unpack(data []byte) (*abi.Method, []interface{}, error) {
+	method, err := b.abi.MethodById(data)
+	if err != nil {
+		return nil, nil, errors.New("execution reverted")
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return method, args, nil
+}
+
+// callBridge answers the calls to the OptimismPortal and the L2OutputOracle,
+// or to the OptimismPortal2 if faultProofs is set.
+func (b *fakeBridge) callBridge(call ethereum.CallMsg) ([]byte, error) {
+	method, args, err := b.unpack(call.Data)
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "proveWithdrawalTransaction":
+		return nil, b.prove(args, false)
+	case "finalizeWithdrawalTransaction":
+		return nil, b.finalize(args, false)
+	case "finalizedWithdrawals":
+		return method.Outputs.Pack(b.finalized[args[0].([32]byte)])
+	case "version":
+		if b.faultProofs {
+			return method.Outputs.Pack("3.10.0")
+		}
+		return method.Outputs.Pack("2.8.0")
+	}
+	if b.faultProofs {
+		switch method.Name {
+		case "provenWithdrawals0":
+			if p, ok := b.proven[args[0].([32]byte)]; ok {
+				return method.Outputs.Pack(gameProxy(int(p.index.Int64())), p.timestamp)
+			}
+			return method.Outputs.Pack(common.Address{}, uint64(0))
+		case "disputeGameFactory":
+			return method.Outputs.Pack(factory)
+		case "respectedGameType":
+			return method.Outputs.Pack(uint32(0))
+		case "respectedGameTypeUpdatedAt":
+			return method.Outputs.Pack(uint64(0))
+		case "disputeGameBlacklist":
+			return method.Outputs.Pack(false)
+		case "proofMaturityDelaySeconds":
+			return method.Outputs.Pack(big.NewInt(finalizationPeriod))
+		case "disputeGameFinalityDelaySeconds":
+			return method.Outputs.Pack(big.NewInt(airGap))
+		}
+		return nil, errors.New("execution reverted")
+	}
+	switch method.Name {
+	case "provenWithdrawals":
+		if p, ok := b.proven[args[0].([32]byte)]; ok {
+			return method.Outputs.Pack(p.outputRoot, new(big.Int).SetUint64(p.timestamp), p.index)
+		}
+		return method.Outputs.Pack(common.Hash{}, new(big.Int), new(big.Int))
+	case "FINALIZATION_PERIOD_SECONDS":
+		return method.Outputs.Pack(big.NewInt(finalizationPeriod))
+	case "latestBlockNumber":
+		if len(b.outputs) == 0 {
+			return method.Outputs.Pack(new(big.Int))
+		}
+		return method.Outputs.Pack(b.outputs[len(b.outputs)-1].L2BlockNumber)
+	case "getL2OutputIndexAfter":
+		for i, o := range b.outputs {
+			if o.L2BlockNumber.Cmp(args[0].(*big.Int)) >= 0 {
+				return method.Outputs.Pack(big.NewInt(int64(i)))
+			}
+		}
+	case "getL2Output":
+		if index := args[0].(*big.Int); index.IsInt64() && index.Int64() < int64(len(b.outputs)) {
+			return method.Outputs.Pack(b.outputs[index.Int64()])
+		}
+	}
+	return nil, errors.New("execution reverted")
+}
+
+// callFactory answers the calls to the DisputeGameFactory, which created a
+// game for every output.
+func (b *fakeBridge) callFactory(call ethereum.CallMsg) ([]byte, error) {
+	method, args, err := b.unpack(call.Data)
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "gameCount":
+		return method.Outputs.Pack(big.NewInt(int64(len(b.games))))
+	case "findLatestGames":
+		found := []gameResult{}
+		for i := int(args[1].(*big.Int).Int64()); i >= 0 && len(found) < int(args[2].(*big.Int).Int64()); i-- {
+			var metadata [32]byte
+			copy(metadata[12:], gameProxy(i).Bytes())
+			found = append(found, gameResult{
+				Index:     big.NewInt(int64(i)),
+				Metadata:  metadata,
+				Timestamp: b.outputs[i].Timestamp.Uint64(),
+				RootClaim: b.outputs[i].OutputRoot,
+				ExtraData: common.BigToHash(b.outputs[i].L2BlockNumber).Bytes(),
+			})
+		}
+		return method.Outputs.Pack(found)
+	}
+	return nil, errors.New("execution reverted")
+}
+
+// callGame answers the calls to the dispute game claiming the output with
+// the given index.
+func (b *fakeBridge) callGame(index int, call ethereum.CallMsg) ([]byte, error) {
+	method, _, err := b.unpack(call.Data)
+	if err != nil {
+		return nil, err
+	}
+	g := b.games[index]
+	switch method.Name {
+	case "gameType":
+		return method.Outputs.Pack(uint32(0))
+	case "createdAt":
+		return method.Outputs.Pack(b.outputs[index].Timestamp.Uint64())
+	case "status":
+		return method.Outputs.Pack(g.status)
+	case "resolvedAt":
+		return method.Outputs.Pack(g.resolvedAt)
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func (b *fakeBridge) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	b.sim.Commit()
+	if *tx.To() != portal {
+		return nil
+	}
+	method, err := b.abi.MethodById(tx.Data())
+	if err != nil {
+		return err
+	}
+	args, err := method.Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		return err
+	}
+	if method.Name == "proveWithdrawalTransaction" {
+		return b.prove(args, true)
+	}
+	return b.finalize(args, true)
+}
+
+// withdrawalTx is the WithdrawalTransaction tuple of the portal.
+type withdrawalTx struct {
+	Nonce    *big.Int
+	Sender   common.Address
+	Target   common.Address
+	Value    *big.Int
+	GasLimit *big.Int
+	Data     []byte
+}
+
+func withdrawalHash(t *testing.T, tuple interface{}) common.Hash {
+	t.Helper()
+	tx := abi.ConvertType(tuple, new(withdrawalTx)).(*withdrawalTx)
+	w := withdrawals.Withdrawal{Nonce: tx.Nonce, Sender: tx.Sender, Target: tx.Target, Value: tx.Value, GasLimit: tx.GasLimit, Data: tx.Data}
+	hash, err := w.ComputeHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hash
+}
+
+// prove checks the arguments of proveWithdrawalTransaction, recording the
+// proof if commit is set.
+func (b *fakeBridge) prove(args []interface{}, commit bool) error {
+	hash := withdrawalHash(b.t, args[0])
+	index := args[1].(*big.Int)
+	rootProof := *abi.ConvertType(args[2], new(withdrawals.OutputRootProof)).(*withdrawals.OutputRootProof)
+	if !index.IsInt64() || index.Int64() >= int64(len(b.outputs)) || b.outputs[index.Int64()].OutputRoot != rootProof.OutputRoot() {
+		return errors.New("execution reverted: OptimismPortal: invalid output root proof")
+	}
+	db := rawdb.NewMemoryDatabase()
+	for _, node := range args[3].([][]byte) {
+		if err := db.Put(crypto.Keccak256(node), node); err != nil {
+			return err
+		}
+	}
+	slot := crypto.Keccak256(hash[:], common.Hash{}.Bytes())
+	if value, err := trie.VerifyProof(rootProof.MessagePasserStorageRoot, crypto.Keccak256(slot), db); err != nil || len(value) == 0 {
+		return errors.New("execution reverted: MerkleTrie: invalid withdrawal inclusion proof")
+	}
+	if commit {
+		b.proven[hash] = &provenWithdrawal{outputRoot: rootProof.OutputRoot(), timestamp: b.now(), index: index}
+	}
+	return nil
+}
+
+// finalize checks that the withdrawal may be finalized, recording it if
+// commit is set.
+func (b *fakeBridge) finalize(args []interface{}, commit bool) error {
+	hash := withdrawalHash(b.t, args[0])
+	p, ok := b.proven[hash]
+	if !ok || b.finalized[hash] {
+		return errors.New("execution reverted: OptimismPortal: withdrawal has not been proven yet")
+	}
+	if b.faultProofs {
+		g := b.games[p.index.Int64()]
+		if g.status != gameDefenderWins {
+			return errors.New("execution reverted: OptimismPortal: output proposal has not been validated")
+		}
+		if b.now() <= p.timestamp+finalizationPeriod || b.now() <= g.resolvedAt+airGap {
+			return errors.New("execution reverted: OptimismPortal: proven withdrawal has not matured yet")
+		}
+	} else if b.now() <= max(p.timestamp, b.outputs[p.index.Int64()].Timestamp.Uint64())+finalizationPeriod {
+		return errors.New("execution reverted: OptimismPortal: proven withdrawal finalization period has not elapsed")
+	}
+	if commit {
+		b.finalized[hash] = true
+	}
+	return nil
+}
+
+// fakeL2 is a simulated L2 chain whose L2ToL1MessagePasser holds the
+// withdrawals initiated by faked FeeDisburser transactions.
+type fakeL2 struct {
+	client   *ethclient.Client
+	logs     []types.Log
+	receipts map[common.Hash]*types.Receipt
+	// unsafe is the number of blocks the head is ahead of the finalized
+	// block, the head of the simulated chain.
+	unsafe uint64
+}
+
+func (c *fakeL2) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	switch {
+	case number == nil:
+		header, err := c.client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return &types.Header{Number: new(big.Int).Add(header.Number, new(big.Int).SetUint64(c.unsafe))}, nil
+	case number.Int64() == int64(rpc.FinalizedBlockNumber):
+		return c.client.HeaderByNumber(ctx, nil)
+	}
+	return c.client.HeaderByNumber(ctx, number)
+}
+
+func (c *fakeL2) Client() *rpc.Client {
+	return c.client.Client()
+}
+
+func (c *fakeL2) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	for _, l := range c.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (c *fakeL2) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if receipt, ok := c.receipts[txHash]; ok {
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+// newFakeL2 starts a simulated L2 chain on which w was initiated by a
+// disbursement in block 1. The chain is dialed over IPC, the client of the
+// simulated backend doesn't expose eth_getProof.
+func newFakeL2(t *testing.T, events abi.ABI, w *withdrawals.Withdrawal) *fakeL2 {
+	t.Helper()
+	ipc := filepath.Join(t.TempDir(), "l2.ipc")
+	slot := crypto.Keccak256Hash(w.Hash[:], common.Hash{}.Bytes())
+	sim := simulated.NewBackend(types.GenesisAlloc{
+		predeploys.L2ToL1MessagePasser: {Code: []byte{0x00}, Balance: new(big.Int), Storage: map[common.Hash]common.Hash{slot: common.BigToHash(big.NewInt(1))}},
+	}, func(nodeConf *node.Config, _ *ethconfig.Config) { nodeConf.IPCPath = ipc })
+	t.Cleanup(func() { sim.Close() })
+	sim.Commit()
+	client, err := rpc.Dial(ipc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Close)
+
+	parsed, err := bindings.FeeDisburserMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	txHash := common.HexToHash("0xd15b")
+	disbursed := types.Log{Address: feeDisburser, Topics: []common.Hash{parsed.Events["FeesDisbursed"].ID}, BlockNumber: 1, TxHash: txHash}
+	// The transaction initiates another withdrawal after the one bridging to
+	// the L1 wallet.
+	other := testWithdrawal(t, 2, common.HexToAddress("0xbeef"))
+	return &fakeL2{
+		client:   ethclient.NewClient(client),
+		logs:     []types.Log{disbursed},
+		receipts: map[common.Hash]*types.Receipt{txHash: {Logs: []*types.Log{messagePassedLog(t, events, w, txHash), &disbursed, messagePassedLog(t, events, other, txHash)}}},
+	}
+}
+
+// testWithdrawal returns a withdrawal of the L2CrossDomainMessenger bridging
+// 1000 wei to recipient through the L2StandardBridge.
+func testWithdrawal(t *testing.T, nonce int64, recipient common.Address) *withdrawals.Withdrawal {
+	t.Helper()
+	messages, err := abi.JSON(strings.NewReader(messagesABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, err := messages.Pack("finalizeBridgeETH", feeDisburser, recipient, big.NewInt(1_000), []byte{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := messages.Pack("relayMessage", big.NewInt(nonce), predeploys.L2StandardBridge, common.HexToAddress("0xb41d"), big.NewInt(1_000), big.NewInt(200_000), message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := &withdrawals.Withdrawal{
+		Nonce:    big.NewInt(nonce),
+		Sender:   predeploys.L2CrossDomainMessenger,
+		Target:   common.HexToAddress("0xce55"),
+		Value:    big.NewInt(1_000),
+		GasLimit: big.NewInt(200_000),
+		Data:     data,
+	}
+	if w.Hash, err = w.ComputeHash(); err != nil {
+		t.Fatal(err)
+	}
+	return w
+}
+
+// messagePassedLog returns the MessagePassed log of w in the L2 transaction.
+func messagePassedLog(t *testing.T, events abi.ABI, w *withdrawals.Withdrawal, txHash common.Hash) *types.Log {
+	t.Helper()
+	data, err := events.Events["MessagePassed"].Inputs.NonIndexed().Pack(w.Value, w.GasLimit, w.Data, w.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &types.Log{
+		Address:     predeploys.L2ToL1MessagePasser,
+		Topics:      []common.Hash{events.Events["MessagePassed"].ID, common.BigToHash(w.Nonce), common.BytesToHash(w.Sender.Bytes()), common.BytesToHash(w.Target.Bytes())},
+		Data:        data,
+		BlockNumber: 1,
+		TxHash:      txHash,
+	}
+}
+
+// outputRoot returns the output root of the L2 block.
+func (c *fakeL2) outputRoot(t *testing.T, block uint64) common.Hash {
+	t.Helper()
+	number := new(big.Int).SetUint64(block)
+	header, err := c.HeaderByNumber(context.Background(), number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := gethclient.New(c.Client()).GetProof(context.Background(), predeploys.L2ToL1MessagePasser, nil, number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof := withdrawals.OutputRootProof{StateRoot: header.Root, MessagePasserStorageRoot: account.StorageHash, LatestBlockhash: header.Hash()}
+	return proof.OutputRoot()
+}
+
+// newTestProver returns a Prover of the withdrawal initiated on the fake L2
+// chain, proving it to the fake L1 chain.
+func newTestProver(t *testing.T, faultProofs bool) (*Prover, *fakeBridge, *fakeL2, *simulated.Backend, *withdrawals.Withdrawal) {
+	t.Helper()
+	events, err := abi.JSON(strings.NewReader(bridgeABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bridge, err := abi.JSON(strings.NewReader(strings.TrimSuffix(bridgeABI, "]") + "," + strings.TrimPrefix(faultProofsABI, "[")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := testWithdrawal(t, 1, l1Wallet)
+	l2 := newFakeL2(t, events, w)
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	sim := simulated.NewBackend(types.GenesisAlloc{from: {Balance: big.NewInt(params.Ether)}})
+	t.Cleanup(func() { sim.Close() })
+	l1 := &fakeBridge{
+		Client:    sim.Client(),
+		t:         t,
+		sim:       sim,
+		abi:       bridge,
+		proven:    make(map[common.Hash]*provenWithdrawal),
+		finalized: make(map[common.Hash]bool),
+	}
+	l1.faultProofs = faultProofs
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+	txm := txmgr.New(l1, opts, txmgr.Config{ResubmitInterval: time.Second, ReceiptPollInterval: 10 * time.Millisecond, FeeBumpPercent: 10}, log.Root())
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	prover, err := NewProver(ProverConfig{
+		FeeDisburser:   feeDisburser,
+		L1Wallet:       l1Wallet,
+		OptimismPortal: portal,
+		L2OutputOracle: oracle,
+		StatePath:      statePath,
+		PollInterval:   time.Minute,
+		ChunkSize:      10,
+	}, l1, l2, txm, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := prover.init(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if prover.portal.FaultProofs() != faultProofs {
+		t.Fatalf("detected fault proofs %v, want %v", prover.portal.FaultProofs(), faultProofs)
+	}
+	return prover, l1, l2, sim, w
+
+}
+
+// stepper runs a step of the prover, checking how long it waits for.
+func stepper(t *testing.T, prover *Prover) func(time.Duration) {
+	return func(wantWait time.Duration) {
+		t.Helper()
+		wait, err := prover.step(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if wait != wantWait {
+			t.Fatalf("step waits %v, want %v", wait, wantWait)
+		}
+	}
+}
+
+func TestProver(t *testing.T) {
+	prover, l1, l2, sim, w := newTestProver(t, false)
+	step := stepper(t, prover)
+
+	step(time.Minute)
+	if len(prover.outstanding) != 1 || prover.outstanding[0].Hash != w.Hash || len(l1.proven) != 0 {
+		t.Fatalf("expected the withdrawal to wait for an output, got %d outstanding and %d proven", len(prover.outstanding), len(l1.proven))
+	}
+
+	l1.propose(l2.outputRoot(t, 1), 1)
+	step(0)
+	if l1.proven[w.Hash] == nil {
+		t.Fatal("expected the withdrawal to be proven")
+	}
+	step(time.Minute)
+	if l1.finalized[w.Hash] {
+		t.Fatal("expected the withdrawal not to be finalized during the challenge period")
+	}
+
+	if err := sim.AdjustTime((finalizationPeriod + 1) * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	step(0)
+	if !l1.finalized[w.Hash] {
+		t.Fatal("expected the withdrawal to be finalized after the challenge period")
+	}
+	step(time.Minute)
+	if len(prover.outstanding) != 0 || prover.sender.pending() {
+		t.Fatalf("expected nothing left to do, got %d outstanding", len(prover.outstanding))
+	}
+}
+
+func TestProverFaultProofs(t *testing.T) {
+	prover, l1, l2, sim, w := newTestProver(t, true)
+	step := stepper(t, prover)
+
+	step(time.Minute)
+	if len(prover.outstanding) != 1 || len(l1.proven) != 0 {
+		t.Fatalf("expected the withdrawal to wait for a dispute game, got %d outstanding and %d proven", len(prover.outstanding), len(l1.proven))
+	}
+
+	root := l2.outputRoot(t, 1)
+	l1.propose(root, 1)
+	step(0)
+	if p := l1.proven[w.Hash]; p == nil || p.index.Int64() != 0 {
+		t.Fatal("expected the withdrawal to be proven against the dispute game")
+	}
+	step(time.Minute)
+
+	// A withdrawal proven against a lost game is proven again.
+	l1.games[0].status = gameChallengerWins
+	l1.propose(root, 1)
+	step(0)
+	if p := l1.proven[w.Hash]; p.index.Int64() != 1 {
+		t.Fatalf("expected the withdrawal to be proven again against the new game, got game %d", p.index)
+	}
+
+	if err := sim.AdjustTime((finalizationPeriod + 1) * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	step(time.Minute)
+	if l1.finalized[w.Hash] {
+		t.Fatal("expected the withdrawal not to be finalized while its dispute game is in progress")
+	}
+	l1.resolve(1)
+	step(time.Minute)
+	if l1.finalized[w.Hash] {
+		t.Fatal("expected the withdrawal not to be finalized during the air gap")
+	}
+	if err := sim.AdjustTime((airGap + 1) * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	step(0)
+	if !l1.finalized[w.Hash] {
+		t.Fatal("expected the withdrawal to be finalized after the air gap")
+	}
+	step(time.Minute)
+	if len(prover.outstanding) != 0 || prover.sender.pending() {
+		t.Fatalf("expected nothing left to do, got %d outstanding", len(prover.outstanding))
+	}
+}
+
+func TestProverScansFinalizedBlocks(t *testing.T) {
+	prover, _, l2, _, w := newTestProver(t, false)
+	parsed, err := bindings.FeeDisburserMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The receipt of the unfinalized disbursement is gone with a reorg.
+	l2.unsafe = 5
+	l2.logs = append(l2.logs, types.Log{Address: feeDisburser, Topics: []common.Hash{parsed.Events["FeesDisbursed"].ID}, BlockNumber: 3, TxHash: common.HexToHash("0x2e06")})
+
+	if _, err := prover.step(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(prover.outstanding) != 1 || prover.outstanding[0].Hash != w.Hash || prover.next != 2 {
+		t.Fatalf("expected only the finalized disbursement to be scanned, got %d outstanding and next block %d", len(prover.outstanding), prover.next)
+	}
+}
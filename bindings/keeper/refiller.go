@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/txmgr"
+)
+
+// RefillerConfig configures a Refiller.
+type RefillerConfig struct {
+	// BalanceTracker is the address of the BalanceTracker contract.
+	BalanceTracker common.Address
+	// StatePath is the file in-flight transactions are persisted to.
+	StatePath string
+	// PollInterval is the time between two checks.
+	PollInterval time.Duration
+	// RefillThresholdPercent triggers processFees once a system address holds
+	// less than this percentage of its target balance.
+	RefillThresholdPercent uint64
+	// SweepThreshold triggers processFees once the BalanceTracker holds at
+	// least this amount, nil to only process fees when a refill is needed.
+	SweepThreshold *big.Int
+}
+
+// Refiller calls BalanceTracker.processFees when a system address needs to be
+// refilled or enough profit accumulated to be swept, and the BalanceTracker
+// holds more than the call costs.
+type Refiller struct {
+	cfg      RefillerConfig
+	backend  Backend
+	contract *bindings.BalanceTracker
+	sender   *sender
+	log      log.Logger
+}
+
+// NewRefiller creates a Refiller, restoring any in-flight call from the state
+// file.
+func NewRefiller(cfg RefillerConfig, backend Backend, txm *txmgr.Manager, logger log.Logger) (*Refiller, error) {
+	contract, err := bindings.NewBalanceTracker(cfg.BalanceTracker, backend)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := newSender(backend, txm, cfg.StatePath, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Refiller{
+		cfg:      cfg,
+		backend:  backend,
+		contract: contract,
+		sender:   sender,
+		log:      logger,
+	}, nil
+}
+
+// Run keeps processing fees until ctx is cancelled.
+func (r *Refiller) Run(ctx context.Context) error {
+	for {
+		if err := r.step(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			r.log.Error("Failed to process fees", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(r.cfg.PollInterval):
+		}
+	}
+}
+
+func (r *Refiller) step(ctx context.Context) error {
+	if r.sender.pending() {
+		receipt, err := r.sender.resume(ctx)
+		if receipt != nil {
+			r.logReceipt(receipt)
+		}
+		return err
+	}
+
+	targets, err := r.contract.Targets(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+	trackerBalance, err := r.backend.BalanceAt(ctx, r.cfg.BalanceTracker, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch BalanceTracker balance: %w", err)
+	}
+	var refill []common.Address
+	// processFees tops up every system address below its target balance, not
+	// only those below the refill threshold.
+	needed := new(big.Int)
+	for _, target := range targets {
+		balance, err := r.backend.BalanceAt(ctx, target.SystemAddress, nil)
+		if err != nil {
+			return fmt.Errorf("failed to fetch balance of %s: %w", target.SystemAddress, err)
+		}
+		if balance.Cmp(target.TargetBalance) < 0 {
+			needed.Add(needed, new(big.Int).Sub(target.TargetBalance, balance))
+		}
+		if belowThreshold(balance, target.TargetBalance, r.cfg.RefillThresholdPercent) {
+			r.log.Info("System address below refill threshold", "address", target.SystemAddress, "balance", balance, "target", target.TargetBalance)
+			refill = append(refill, target.SystemAddress)
+		}
+	}
+	sweep := r.cfg.SweepThreshold != nil && trackerBalance.Cmp(r.cfg.SweepThreshold) >= 0
+	if len(refill) == 0 && !sweep {
+		r.log.Debug("No system address needs a refill", "trackerBalance", trackerBalance)
+		return nil
+	}
+
+	data, err := r.calldata()
+	if err != nil {
+		return err
+	}
+	gasLimit, err := r.sender.simulate(ctx, r.cfg.BalanceTracker, data)
+	if err != nil {
+		r.log.Warn("processFees simulation failed, skipping", "err", err)
+		return nil
+	}
+	head, err := r.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch head: %w", err)
+	}
+	tip, err := r.backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to suggest gas tip cap: %w", err)
+	}
+	amount := expectedAmount(needed, trackerBalance, sweep)
+	if cost := callCost(gasLimit, head.BaseFee, tip); amount.Cmp(cost) <= 0 {
+		r.log.Warn("Amount moved by processFees does not cover its cost, skipping", "amount", amount, "needed", needed, "trackerBalance", trackerBalance, "cost", cost)
+		return nil
+	}
+
+	receipt, err := r.sender.submit(ctx, r.cfg.BalanceTracker, data, gasLimit)
+	if receipt != nil {
+		r.logReceipt(receipt)
+	}
+	return err
+}
+
+func (r *Refiller) logReceipt(receipt *types.Receipt) {
+	logger := r.log.New("tx", receipt.TxHash, "block", receipt.BlockNumber)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		logger.Error("processFees transaction reverted")
+		return
+	}
+	for _, l := range receipt.Logs {
+		if l.Address != r.cfg.BalanceTracker {
+			continue
+		}
+		if processed, err := r.contract.ParseProcessedFunds(*l); err == nil {
+			logger.Info("Processed funds", "address", processed.SystemAddress, "success", processed.Success, "needed", processed.BalanceNeeded, "sent", processed.BalanceSent)
+		}
+		if profit, err := r.contract.ParseSentProfit(*l); err == nil {
+			logger.Info("Sent profit", "wallet", profit.ProfitWallet, "success", profit.Success, "sent", profit.BalanceSent)
+		}
+	}
+}
+
+func (r *Refiller) calldata() ([]byte, error) {
+	parsed, err := bindings.BalanceTrackerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("processFees")
+}
+
+// belowThreshold reports whether balance is below percent of target.
+func belowThreshold(balance, target *big.Int, percent uint64) bool {
+	threshold := new(big.Int).Mul(target, new(big.Int).SetUint64(percent))
+	return new(big.Int).Mul(balance, big.NewInt(100)).Cmp(threshold) < 0
+}
+
+// expectedAmount is the amount processFees is expected to move: the refills
+// of the system addresses, limited by the BalanceTracker balance, or the whole
+// balance when sweeping it to the profit wallet.
+func expectedAmount(needed, trackerBalance *big.Int, sweep bool) *big.Int {
+	if sweep || needed.Cmp(trackerBalance) > 0 {
+		return trackerBalance
+	}
+	return needed
+}
+
+// callCost is the cost of a call using gasLimit at the given base fee and tip.
+func callCost(gasLimit uint64, baseFee, tip *big.Int) *big.Int {
+	price := new(big.Int).Set(tip)
+	if baseFee != nil {
+		price.Add(price, baseFee)
+	}
+	return price.Mul(price, new(big.Int).SetUint64(gasLimit))
+}
@@ -0,0 +1,246 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/txmgr"
+)
+
+func TestBelowThreshold(t *testing.T) {
+	target := big.NewInt(1_000)
+	tests := []struct {
+		balance int64
+		percent uint64
+		want    bool
+	}{
+		{499, 50, true},
+		{500, 50, false},
+		{1_000, 100, false},
+		{999, 100, true},
+		{0, 0, false},
+	}
+	for _, tt := range tests {
+		if got := belowThreshold(big.NewInt(tt.balance), target, tt.percent); got != tt.want {
+			t.Errorf("belowThreshold(%d, %d, %d%%) = %v, want %v", tt.balance, target, tt.percent, got, tt.want)
+		}
+	}
+}
+
+func TestCallCost(t *testing.T) {
+	if got := callCost(21_000, big.NewInt(2), big.NewInt(1)); got.Int64() != 63_000 {
+		t.Fatalf("callCost = %v, want 63000", got)
+	}
+	if got := callCost(21_000, nil, big.NewInt(1)); got.Int64() != 21_000 {
+		t.Fatalf("callCost without base fee = %v, want 21000", got)
+	}
+}
+
+func TestExpectedAmountCoversCost(t *testing.T) {
+	// 100_000 gas at a base fee of 9 and a tip of 1 costs 1_000_000.
+	cost := callCost(100_000, big.NewInt(9), big.NewInt(1))
+	tests := []struct {
+		name           string
+		needed         int64
+		trackerBalance int64
+		sweep          bool
+		want           bool
+	}{
+		{"refill equal to cost", 1_000_000, 5_000_000, false, false},
+		{"refill above cost", 1_000_001, 5_000_000, false, true},
+		{"refill limited by balance", 5_000_000, 1_000_000, false, false},
+		{"refill covering base fee only", 900_001, 5_000_000, false, false},
+		{"sweep above cost", 0, 1_000_001, true, true},
+		{"sweep equal to cost", 0, 1_000_000, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount := expectedAmount(big.NewInt(tt.needed), big.NewInt(tt.trackerBalance), tt.sweep)
+			if got := amount.Cmp(cost) > 0; got != tt.want {
+				t.Fatalf("amount %v against cost %v: got %v, want %v", amount, cost, got, tt.want)
+			}
+		})
+	}
+}
+
+var balanceTracker = common.HexToAddress("0xba1a")
+
+// outOfBoundsError is the JSON-RPC error of a call reverting with
+// Panic(0x32), an out of bounds array access.
+type outOfBoundsError struct{}
+
+func (outOfBoundsError) Error() string { return "execution reverted" }
+
+func (outOfBoundsError) ErrorData() interface{} {
+	return hexutil.Encode(append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], common.BigToHash(big.NewInt(0x32)).Bytes()...))
+}
+
+// fakeBalanceTracker is a simulated chain on which the BalanceTracker is
+// faked: calls to it are answered from memory and processFees is mined as a
+// plain call.
+type fakeBalanceTracker struct {
+	simulated.Client
+	sim             *simulated.Backend
+	systemAddresses []common.Address
+	targetBalances  []*big.Int
+	reverts         bool
+	sent            int
+}
+
+func (b *fakeBalanceTracker) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if *call.To != balanceTracker {
+		return b.Client.CallContract(ctx, call, blockNumber)
+	}
+	parsed, err := bindings.BalanceTrackerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data)
+	if err != nil {
+		return nil, errors.New("execution reverted")
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "MAX_SYSTEM_ADDRESS_COUNT":
+		return method.Outputs.Pack(big.NewInt(20))
+	case "systemAddresses", "targetBalances":
+		i := args[0].(*big.Int).Int64()
+		if i >= int64(len(b.systemAddresses)) {
+			return nil, outOfBoundsError{}
+		}
+		if method.Name == "systemAddresses" {
+			return method.Outputs.Pack(b.systemAddresses[i])
+		}
+		return method.Outputs.Pack(b.targetBalances[i])
+	case "processFees":
+		if b.reverts {
+			return nil, errors.New("execution reverted")
+		}
+		return nil, nil
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func (b *fakeBalanceTracker) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	b.sent++
+	b.sim.Commit()
+	return nil
+}
+
+// newTestRefiller returns a Refiller of a BalanceTracker holding
+// trackerBalance and funding a system address holding balance, with a target
+// balance of target.
+func newTestRefiller(t *testing.T, cfg RefillerConfig, trackerBalance, balance, target *big.Int) (*Refiller, *fakeBalanceTracker) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+	systemAddress := common.HexToAddress("0x5a")
+	sim := simulated.NewBackend(types.GenesisAlloc{
+		opts.From:      {Balance: big.NewInt(params.Ether)},
+		balanceTracker: {Balance: trackerBalance},
+		systemAddress:  {Balance: balance},
+	})
+	t.Cleanup(func() { sim.Close() })
+	backend := &fakeBalanceTracker{
+		Client:          sim.Client(),
+		sim:             sim,
+		systemAddresses: []common.Address{systemAddress},
+		targetBalances:  []*big.Int{target},
+	}
+
+	cfg.BalanceTracker = balanceTracker
+	cfg.StatePath = filepath.Join(t.TempDir(), "state.json")
+	cfg.PollInterval = time.Minute
+	txm := txmgr.New(backend, opts, txmgr.Config{ResubmitInterval: time.Second, ReceiptPollInterval: 10 * time.Millisecond, FeeBumpPercent: 10}, log.Root())
+	r, err := NewRefiller(cfg, backend, txm, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r, backend
+}
+
+func TestRefillerStep(t *testing.T) {
+	ether := big.NewInt(params.Ether)
+	half := new(big.Int).Div(ether, big.NewInt(2))
+	refill := RefillerConfig{RefillThresholdPercent: 50}
+	sweep := RefillerConfig{RefillThresholdPercent: 50, SweepThreshold: ether}
+	tests := []struct {
+		name           string
+		cfg            RefillerConfig
+		trackerBalance *big.Int
+		balance        *big.Int
+		target         *big.Int
+		reverts        bool
+		wantSent       bool
+	}{
+		{"below threshold", refill, ether, big.NewInt(0), ether, false, true},
+		{"above threshold", refill, ether, half, ether, false, false},
+		{"sweep", sweep, ether, ether, ether, false, true},
+		{"below sweep threshold", sweep, half, ether, ether, false, false},
+		// Refilling a single wei doesn't cover the cost of processFees.
+		{"refill below cost", RefillerConfig{RefillThresholdPercent: 100}, ether, new(big.Int).Sub(ether, common.Big1), ether, false, false},
+		{"tracker empty", refill, big.NewInt(0), big.NewInt(0), ether, false, false},
+		{"simulation reverts", refill, ether, big.NewInt(0), ether, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, backend := newTestRefiller(t, tt.cfg, tt.trackerBalance, tt.balance, tt.target)
+			backend.reverts = tt.reverts
+
+			if err := r.step(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if got := backend.sent == 1; got != tt.wantSent {
+				t.Fatalf("sent %d transactions, want processFees sent %v", backend.sent, tt.wantSent)
+			}
+			if r.sender.pending() {
+				t.Fatal("call still pending after step")
+			}
+		})
+	}
+}
+
+func TestRefillerStepResumes(t *testing.T) {
+	r, backend := newTestRefiller(t, RefillerConfig{RefillThresholdPercent: 50}, big.NewInt(params.Ether), big.NewInt(params.Ether), big.NewInt(params.Ether))
+	data, err := r.calldata()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A previous run persisted the call without broadcasting any attempt, it
+	// is sent even though no system address needs a refill anymore.
+	r.sender.state.Pending = &pendingCall{To: balanceTracker, Data: data, Nonce: 0, GasLimit: 100_000}
+	if err := r.step(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if backend.sent != 1 || r.sender.pending() {
+		t.Fatalf("sent %d transactions, pending %v, want the pending call resubmitted", backend.sent, r.sender.pending())
+	}
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/txmgr"
+)
+
+// sender submits one call at a time, persisting the in-flight call to a state
+// file so that a restarted keeper resumes it instead of sending it again.
+type sender struct {
+	backend Backend
+	txmgr   *txmgr.Manager
+	path    string
+	state   callState
+	log     log.Logger
+}
+
+func newSender(backend Backend, txm *txmgr.Manager, path string, logger log.Logger) (*sender, error) {
+	s := &sender{backend: backend, txmgr: txm, path: path, log: logger}
+	if err := loadState(path, &s.state); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// pending reports whether a call left by a previous run must be resumed.
+func (s *sender) pending() bool {
+	return s.state.Pending != nil
+}
+
+// simulate runs the call with eth_call from the sending account and returns
+// its gas estimate. An error means the call would revert if sent now, reverts
+// are decoded into a *bindings.RevertError.
+func (s *sender) simulate(ctx context.Context, to common.Address, data []byte) (uint64, error) {
+	msg := ethereum.CallMsg{From: s.txmgr.From(), To: &to, Data: data}
+	if _, err := s.backend.CallContract(ctx, msg, nil); err != nil {
+		return 0, bindings.DecodeRevert(err)
+	}
+	gas, err := s.backend.EstimateGas(ctx, msg)
+	return gas, bindings.DecodeRevert(err)
+}
+
+// submit sends a new call and waits for it to be mined. The nonce is persisted
+// before anything is broadcast.
+func (s *sender) submit(ctx context.Context, to common.Address, data []byte, gasLimit uint64) (*types.Receipt, error) {
+	nonce, err := s.backend.PendingNonceAt(ctx, s.txmgr.From())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch nonce: %w", err)
+	}
+	s.state.Pending = &pendingCall{To: to, Data: data, Nonce: nonce, GasLimit: gasLimit}
+	if err := saveState(s.path, &s.state); err != nil {
+		return nil, err
+	}
+	return s.send(ctx)
+}
+
+// resume settles the call left pending by a previous run, resubmitting it
+// with the same nonce if none of its attempts was mined yet. It returns a nil
+// receipt if the nonce was used by another transaction.
+func (s *sender) resume(ctx context.Context) (*types.Receipt, error) {
+	pending := s.state.Pending
+	used, receipt, err := s.txmgr.Confirmed(ctx, pending.Nonce, pending.Attempts)
+	if err != nil {
+		return nil, err
+	}
+	if !used {
+		s.log.Info("Resuming pending call", "nonce", pending.Nonce, "to", pending.To)
+		return s.send(ctx)
+	}
+	if receipt == nil {
+		s.log.Warn("Pending call nonce used by another transaction", "nonce", pending.Nonce)
+	}
+	return receipt, s.clear()
+}
+
+func (s *sender) send(ctx context.Context) (*types.Receipt, error) {
+	pending := s.state.Pending
+	candidate := txmgr.Candidate{To: pending.To, Data: pending.Data, GasLimit: pending.GasLimit}
+	receipt, err := s.txmgr.Send(ctx, pending.Nonce, candidate, pending.Attempts, func(tx *types.Transaction) error {
+		pending.Attempts = append(pending.Attempts, tx.Hash())
+		return saveState(s.path, &s.state)
+	})
+	if errors.Is(err, txmgr.ErrNonceConsumed) {
+		s.log.Warn("Pending call nonce used by another transaction", "nonce", pending.Nonce)
+		return nil, s.clear()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to send call: %w", err)
+	}
+	return receipt, s.clear()
+}
+
+func (s *sender) clear() error {
+	s.state.Pending = nil
+	return saveState(s.path, &s.state)
+}
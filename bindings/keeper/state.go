@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// callState is the state persisted by a keeper.
+type callState struct {
+	Pending *pendingCall `json:"pending,omitempty"`
+}
+
+// pendingCall records a call whose transaction may not have been mined yet.
+// All of its attempts share the same nonce, so at most one can be included.
+type pendingCall struct {
+	To       common.Address `json:"to"`
+	Data     hexutil.Bytes  `json:"data"`
+	Nonce    uint64         `json:"nonce"`
+	GasLimit uint64         `json:"gasLimit"`
+	Attempts []common.Hash  `json:"attempts"`
+}
+
+// loadState reads the JSON state file at path into v. A missing file leaves v
+// untouched.
+func loadState(path string, v interface{}) error {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read state file: %w", err)
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("failed to parse state file %s: %w", path, err)
+	}
+	return nil
+}
+
+// saveState atomically replaces the state file at path with v.
+func saveState(path string, v interface{}) error {
+	raw, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode state: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create state file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write state file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync state file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close state file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace state file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+// Package multisig is the Go counterpart of script/universal/MultisigBuilder.sol.
+// It batches calls into a Multicall3 aggregate3 delegatecall from a Safe,
+// computes the data owners sign, checks the collected signatures and encodes
+// the execTransaction call, so signers don't need forge.
+package multisig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/signatures"
+	"github.com/base-org/contracts/bindings/simulate"
+)
+
+// Multicall3 is the address Multicall3 is deployed at on every chain.
+var Multicall3 = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// multicall3ABI holds aggregate3 of Multicall3.
+const multicall3ABI = `[{"type":"function","name":"aggregate3","stateMutability":"payable","inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],"outputs":[{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`
+
+// Call3 is a call batched by Multicall3.aggregate3.
+type Call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// Aggregate3 packs the aggregate3 call batching calls.
+func Aggregate3(calls []Call3) ([]byte, error) {
+	parsed, err := abi.JSON(strings.NewReader(multicall3ABI))
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("aggregate3", calls)
+}
+
+// Config configures a Builder.
+type Config struct {
+	// Safe is the Safe executing the calls.
+	Safe common.Address
+	// Nonce is the nonce of the Safe transaction, nil for the current nonce
+	// of the Safe plus NonceOffset. See NonceFromEnv.
+	Nonce *big.Int
+	// NonceOffset is added to the current nonce of the Safe, to sign a
+	// transaction queued behind others like SetGasLimitBuilder._nonceOffset.
+	NonceOffset uint64
+	// SimulationOverrides are applied to the simulations on top of the
+	// overrides of the Safe, like MultisigBase._simulationOverrides.
+	SimulationOverrides simulate.Overrides
+}
+
+// Backend is the chain access needed by a Builder. ethclient.Client
+// implements it.
+type Backend interface {
+	simulate.Backend
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// Builder builds the Safe transaction executing a batch of calls.
+type Builder struct {
+	backend Backend
+	cfg     Config
+	calls   []Call3
+	safe    *bindings.IGnosisSafeCaller
+	// approves is the Builder whose transaction this one approves, if it was
+	// returned by Approval.
+	approves *Builder
+}
+
+// New creates a Builder executing calls from the Safe of cfg.
+func New(cfg Config, backend Backend, calls []Call3) (*Builder, error) {
+	if cfg.Safe == (common.Address{}) {
+		return nil, errors.New("multisig: safe cannot be zero address")
+	}
+	if len(calls) == 0 {
+		return nil, errors.New("multisig: no calls")
+	}
+	safe, err := bindings.NewIGnosisSafeCaller(cfg.Safe, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Builder{backend: backend, cfg: cfg, calls: calls, safe: safe}, nil
+}
+
+// NonceFromEnv returns the nonce override of the forge scripts for the Safe:
+// SAFE_NONCE_{UPPERCASE_SAFE_ADDRESS} or, if readSafeNonce is set, SAFE_NONCE.
+// It returns nil if neither is set.
+func NonceFromEnv(safe common.Address, readSafeNonce bool) (*big.Int, error) {
+	names := []string{"SAFE_NONCE_" + strings.ToUpper(safe.Hex())}
+	if readSafeNonce {
+		names = append(names, "SAFE_NONCE")
+	}
+	for _, name := range names {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		nonce, ok := new(big.Int).SetString(value, 0)
+		if !ok || nonce.Sign() < 0 {
+			return nil, fmt.Errorf("invalid %s %q", name, value)
+		}
+		return nonce, nil
+	}
+	return nil, nil
+}
+
+// Nonce returns the nonce of the Safe transaction.
+func (b *Builder) Nonce(ctx context.Context) (*big.Int, error) {
+	if b.cfg.Nonce != nil {
+		return new(big.Int).Set(b.cfg.Nonce), nil
+	}
+	nonce, err := b.safe.Nonce(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch nonce of Safe %s: %w", b.cfg.Safe, err)
+	}
+	return nonce.Add(nonce, new(big.Int).SetUint64(b.cfg.NonceOffset)), nil
+}
+
+// SafeTx returns the Safe transaction delegatecalling Multicall3.aggregate3.
+func (b *Builder) SafeTx(ctx context.Context) (*bindings.SafeTx, error) {
+	data, err := Aggregate3(b.calls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack aggregate3: %w", err)
+	}
+	nonce, err := b.Nonce(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &bindings.SafeTx{
+		To:        Multicall3,
+		Data:      data,
+		Operation: bindings.SafeOperationDelegateCall,
+		Nonce:     nonce,
+	}, nil
+}
+
+// DataToSign returns the data the owners sign and its hash, which owners
+// submitting onchain pass to approveHash.
+func (b *Builder) DataToSign(ctx context.Context) ([]byte, common.Hash, error) {
+	tx, err := b.SafeTx(ctx)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+	chainID, err := b.backend.ChainID(ctx)
+	if err != nil {
+		return nil, common.Hash{}, fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	data := tx.EncodeTransactionData(chainID, b.cfg.Safe)
+	return data, crypto.Keccak256Hash(data), nil
+}
+
+// Verify checks that the signatures, concatenated in any order, together with
+// the approvals of owners having called approveHash are enough to execute the
+// transaction. It returns them as the Safe expects them, sorted by owner with
+// duplicates and signatures of non-owners left out.
+func (b *Builder) Verify(ctx context.Context, sigs []byte) ([]byte, error) {
+	data, hash, err := b.DataToSign(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sorted, err := signatures.PrepareSignatures(ctx, b.backend, b.cfg.Safe, hash, sigs)
+	if err != nil {
+		return nil, err
+	}
+	// Like execTransaction, pass the signed data for EIP-1271 signers.
+	if err := b.safe.CheckSignatures(&bind.CallOpts{Context: ctx}, hash, data, sorted); err != nil {
+		return nil, fmt.Errorf("invalid signatures: %w", bindings.DecodeRevert(err))
+	}
+	return sorted, nil
+}
+
+// Status reports how close the signatures and approvals are to the threshold
+// of the Safe.
+func (b *Builder) Status(ctx context.Context, sigs []byte) (*signatures.Report, error) {
+	_, hash, err := b.DataToSign(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return signatures.Prepare(ctx, b.backend, b.cfg.Safe, hash, sigs)
+}
+
+// ExecTransaction returns the calldata of execTransaction executing the
+// transaction with the signatures, which are verified first.
+func (b *Builder) ExecTransaction(ctx context.Context, sigs []byte) ([]byte, error) {
+	sorted, err := b.Verify(ctx, sigs)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := b.SafeTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return execTransaction(tx, sorted)
+}
+
+// SignerPayload returns the simulation of the transaction as signed by from:
+// the Safe is overridden to have a threshold of 1, from as an owner and the
+// nonce of the transaction, and from signs with a prevalidated signature.
+func (b *Builder) SignerPayload(ctx context.Context, from common.Address) (simulate.Payload, error) {
+	tx, err := b.SafeTx(ctx)
+	if err != nil {
+		return simulate.Payload{}, err
+	}
+	overrides, err := simulate.OverrideSafeThresholdOwnerAndNonce(ctx, b.backend, b.cfg.Safe, from, tx.Nonce)
+	if err != nil {
+		return simulate.Payload{}, err
+	}
+	overrides.Merge(b.cfg.SimulationOverrides)
+	data, err := execTransaction(tx, signatures.GenPrevalidatedSignature(from))
+	if err != nil {
+		return simulate.Payload{}, err
+	}
+	return simulate.Payload{From: from, To: b.cfg.Safe, Data: data, Overrides: overrides}, nil
+}
+
+// ExecPayload returns the simulation of from executing the transaction with
+// the signatures. The nonce of the Safe is overridden if the transaction
+// doesn't use the current one.
+func (b *Builder) ExecPayload(ctx context.Context, from common.Address, sigs []byte) (simulate.Payload, error) {
+	tx, err := b.SafeTx(ctx)
+	if err != nil {
+		return simulate.Payload{}, err
+	}
+	overrides := make(simulate.Overrides)
+	if err := simulate.AddNonceOverride(ctx, b.backend, b.cfg.Safe, overrides, tx.Nonce); err != nil {
+		return simulate.Payload{}, err
+	}
+	overrides.Merge(b.cfg.SimulationOverrides)
+	chainID, err := b.backend.ChainID(ctx)
+	if err != nil {
+		return simulate.Payload{}, fmt.Errorf("failed to fetch chain ID: %w", err)
+	}
+	sorted, err := signatures.PrepareSignatures(ctx, b.backend, b.cfg.Safe, tx.Hash(chainID, b.cfg.Safe), sigs)
+	if err != nil {
+		return simulate.Payload{}, err
+	}
+	data, err := execTransaction(tx, sorted)
+	if err != nil {
+		return simulate.Payload{}, err
+	}
+	return simulate.Payload{From: from, To: b.cfg.Safe, Data: data, Overrides: overrides}, nil
+}
+
+func execTransaction(tx *bindings.SafeTx, sigs []byte) ([]byte, error) {
+	parsed, err := bindings.IGnosisSafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.Pack("execTransaction", tx.To, new(big.Int), tx.Data, tx.Operation,
+		new(big.Int), new(big.Int), new(big.Int), common.Address{}, common.Address{}, sigs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack execTransaction: %w", err)
+	}
+	return data, nil
+}
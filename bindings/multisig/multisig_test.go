@@ -0,0 +1,233 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/signatures"
+)
+
+var (
+	safe    = common.BigToAddress(big.NewInt(1001))
+	counter = common.HexToAddress("0x5615dEB798BB3E4dFa0139dFa1b3D433Cc23b72f")
+)
+
+// fakeSafe answers the Safe getters and checkSignatures.
+type fakeSafe struct {
+	nonce     int64
+	threshold int64
+	owners    []common.Address
+	// approved are the owners having approved a hash.
+	approved map[common.Address]common.Hash
+	checked  []byte
+}
+
+func (s *fakeSafe) Client() *rpc.Client { return nil }
+
+func (s *fakeSafe) ChainID(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(31337), nil
+}
+
+func (s *fakeSafe) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (s *fakeSafe) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	parsed, err := bindings.IGnosisSafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "nonce":
+		return method.Outputs.Pack(big.NewInt(s.nonce))
+	case "getThreshold":
+		return method.Outputs.Pack(big.NewInt(s.threshold))
+	case "getOwners":
+		return method.Outputs.Pack(s.owners)
+	case "approvedHashes":
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		if err != nil {
+			return nil, err
+		}
+		if hash, ok := s.approved[args[0].(common.Address)]; ok && hash == args[1].([32]byte) {
+			return method.Outputs.Pack(common.Big1)
+		}
+		return method.Outputs.Pack(common.Big0)
+	case "checkSignatures":
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		if err != nil {
+			return nil, err
+		}
+		s.checked = args[2].([]byte)
+		return nil, nil
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func incrementCalls() []Call3 {
+	return []Call3{{Target: counter, CallData: crypto.Keccak256([]byte("increment()"))[:4]}}
+}
+
+// TestDataToSign checks the vector of test/universal/MultisigBuilder.t.sol.
+func TestDataToSign(t *testing.T) {
+	b, err := New(Config{Safe: safe}, &fakeSafe{}, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, hash, err := b.DataToSign(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := hexutil.MustDecode("0x1901d4bb33110137810c444c1d9617abe97df097d587ecde64e6fcb38d7f49e1280c41dcff2c17a271265df60d1612a7387110475b6fc5178add5518196db5dba6bd")
+	if !bytes.Equal(data, want) || hash != crypto.Keccak256Hash(want) {
+		t.Fatalf("got data to sign %x, want %x", data, want)
+	}
+}
+
+func TestNonce(t *testing.T) {
+	backend := &fakeSafe{nonce: 5}
+	b, err := New(Config{Safe: safe, NonceOffset: 2}, backend, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonce, err := b.Nonce(context.Background()); err != nil || nonce.Int64() != 7 {
+		t.Fatalf("got nonce %v (%v), want 7", nonce, err)
+	}
+
+	t.Setenv("SAFE_NONCE", "9")
+	if nonce, err := NonceFromEnv(safe, false); err != nil || nonce != nil {
+		t.Fatalf("expected SAFE_NONCE to be ignored, got %v (%v)", nonce, err)
+	}
+	if nonce, err := NonceFromEnv(safe, true); err != nil || nonce.Int64() != 9 {
+		t.Fatalf("got nonce %v (%v), want 9", nonce, err)
+	}
+	t.Setenv("SAFE_NONCE_0X00000000000000000000000000000000000003E9", "11")
+	nonce, err := NonceFromEnv(safe, true)
+	if err != nil || nonce.Int64() != 11 {
+		t.Fatalf("got nonce %v (%v), want 11", nonce, err)
+	}
+
+	b, err = New(Config{Safe: safe, Nonce: nonce, NonceOffset: 2}, backend, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nonce, err := b.Nonce(context.Background()); err != nil || nonce.Int64() != 11 {
+		t.Fatalf("got nonce %v (%v), want the override", nonce, err)
+	}
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, hash common.Hash, ethSign bool) []byte {
+	t.Helper()
+	if ethSign {
+		hash = crypto.Keccak256Hash([]byte("\x19Ethereum Signed Message:\n32"), hash.Bytes())
+	}
+	sig, err := crypto.Sign(hash.Bytes(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[64] += 27
+	if ethSign {
+		sig[64] += 4
+	}
+	return sig
+}
+
+func TestVerify(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 3)
+	addrs := make([]common.Address, 3)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	backend := &fakeSafe{threshold: 2, owners: addrs[:2]}
+	b, err := New(Config{Safe: safe}, backend, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, hash, err := b.DataToSign(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig0, sig1 := sign(t, keys[0], hash, false), sign(t, keys[1], hash, true)
+	nonOwner := sign(t, keys[2], hash, false)
+
+	want := append(append([]byte{}, sig0...), sig1...)
+	if bytes.Compare(addrs[1].Bytes(), addrs[0].Bytes()) < 0 {
+		want = append(append([]byte{}, sig1...), sig0...)
+	}
+	sorted, err := b.Verify(context.Background(), bytes.Join([][]byte{sig1, nonOwner, sig0}, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Signatures of non-owners are left out of the threshold sorted ones,
+	// like Signatures.sol the remaining bytes are kept and ignored by the Safe.
+	if !bytes.Equal(sorted[:130], want) || !bytes.Equal(backend.checked, sorted) {
+		t.Fatalf("got signatures %x, want %x", sorted, want)
+	}
+
+	if _, err := b.Verify(context.Background(), append(sig0, nonOwner...)); !errors.Is(err, signatures.ErrNotEnoughSignatures) {
+		t.Fatalf("expected ErrNotEnoughSignatures, got %v", err)
+	}
+
+	calldata, err := b.ExecTransaction(context.Background(), append(sig0, sig1...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := bindings.IGnosisSafeMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	args, err := parsed.Methods["execTransaction"].Inputs.Unpack(calldata[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args[0].(common.Address) != Multicall3 || args[3].(uint8) != bindings.SafeOperationDelegateCall || !bytes.Equal(args[9].([]byte), want) {
+		t.Fatalf("unexpected execTransaction arguments: %v", args)
+	}
+}
+
+func TestSignerPayload(t *testing.T) {
+	signer := common.HexToAddress("0x0a")
+	backend := &fakeSafe{nonce: 3, threshold: 2, owners: []common.Address{common.HexToAddress("0x0b")}}
+	b, err := New(Config{Safe: safe, NonceOffset: 1}, backend, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := b.SignerPayload(context.Background(), signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload.From != signer || payload.To != safe || len(payload.Overrides) != 1 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	// The threshold, owner count, owners and nonce are overridden.
+	if diff := payload.Overrides[safe].StateDiff; len(diff) != 5 || diff[common.BigToHash(big.NewInt(5))] != common.BigToHash(big.NewInt(4)) {
+		t.Fatalf("unexpected overrides: %+v", diff)
+	}
+	parsed, err := bindings.IGnosisSafeMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	args, err := parsed.Methods["execTransaction"].Inputs.Unpack(payload.Data[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(args[9].([]byte), signatures.GenPrevalidatedSignature(signer)) {
+		t.Fatalf("got signatures %x, want the prevalidated signature of the signer", args[9])
+	}
+}
@@ -0,0 +1,124 @@
+package multisig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/signatures"
+	"github.com/base-org/contracts/bindings/simulate"
+)
+
+// ApproveCall returns the call approving the transaction on the Safe.
+func (b *Builder) ApproveCall(ctx context.Context) (Call3, error) {
+	_, hash, err := b.DataToSign(ctx)
+	if err != nil {
+		return Call3{}, err
+	}
+	parsed, err := bindings.IGnosisSafeMetaData.GetAbi()
+	if err != nil {
+		return Call3{}, err
+	}
+	data, err := parsed.Pack("approveHash", hash)
+	if err != nil {
+		return Call3{}, fmt.Errorf("failed to pack approveHash: %w", err)
+	}
+	return Call3{Target: b.cfg.Safe, CallData: data}, nil
+}
+
+// Approval returns the Builder of the transaction of the owner Safe of cfg
+// approving the transaction, for Safes owned by other Safes like in
+// NestedMultisigBuilder.sol and DoubleNestedMultisigBuilder.sol. Once enough
+// owner Safes executed their approval, the transaction is executed without
+// signatures, see ExecTransaction.
+//
+// For a Safe owned by intermediate Safes themselves owned by signer Safes:
+//
+//	intermediate, err := top.Approval(ctx, multisig.Config{Safe: intermediateSafe})
+//	signer, err := intermediate.Approval(ctx, multisig.Config{Safe: signerSafe})
+//
+// The owners of the signer Safe sign the data returned by signer.DataToSign,
+// and the approvals are then executed in turn with signer.ExecTransaction,
+// intermediate.ExecTransaction and top.ExecTransaction.
+func (b *Builder) Approval(ctx context.Context, cfg Config) (*Builder, error) {
+	call, err := b.ApproveCall(ctx)
+	if err != nil {
+		return nil, err
+	}
+	approval, err := New(cfg, b.backend, []Call3{call})
+	if err != nil {
+		return nil, err
+	}
+	approval.approves = b
+	return approval, nil
+}
+
+// Approved reports whether owner approved the transaction.
+func (b *Builder) Approved(ctx context.Context, owner common.Address) (bool, error) {
+	_, hash, err := b.DataToSign(ctx)
+	if err != nil {
+		return false, err
+	}
+	approved, err := b.safe.ApprovedHashes(&bind.CallOpts{Context: ctx}, owner, hash)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch approval of %s: %w", owner, err)
+	}
+	return approved.Cmp(common.Big1) == 0, nil
+}
+
+// NestedPayload returns the simulation of the transaction as approved through
+// signer, a Builder returned by Approval on the Builder or, for doubly nested
+// Safes, on one of its approvals: Multicall3, called by from, executes the
+// approvals from the one of the signer Safe down and then the transaction,
+// like DoubleNestedMultisigBuilder.sol. Every Safe is overridden to have a
+// threshold of 1 and the nonce of its transaction, and Multicall3 to be an
+// owner of the signer Safe.
+func (b *Builder) NestedPayload(ctx context.Context, signer *Builder, from common.Address) (simulate.Payload, error) {
+	// chain holds the Builders from signer to b, each approving the next.
+	var chain []*Builder
+	for c := signer; c != b; c = c.approves {
+		if c == nil {
+			return simulate.Payload{}, fmt.Errorf("multisig: %s does not approve the transaction of %s", signer.cfg.Safe, b.cfg.Safe)
+		}
+		chain = append(chain, c)
+	}
+	chain = append(chain, b)
+
+	overrides := make(simulate.Overrides)
+	calls := make([]Call3, len(chain))
+	for i, c := range chain {
+		tx, err := c.SafeTx(ctx)
+		if err != nil {
+			return simulate.Payload{}, err
+		}
+		// Multicall3 isn't an owner past the signer Safe, the prevalidated
+		// signature of the owner Safe is accepted thanks to its approval.
+		owner := Multicall3
+		var state simulate.Overrides
+		if i == 0 {
+			state, err = simulate.OverrideSafeThresholdOwnerAndNonce(ctx, b.backend, c.cfg.Safe, owner, tx.Nonce)
+		} else {
+			owner = chain[i-1].cfg.Safe
+			state, err = simulate.OverrideSafeThresholdAndNonce(ctx, b.backend, c.cfg.Safe, tx.Nonce)
+		}
+		if err != nil {
+			return simulate.Payload{}, err
+		}
+		overrides.Merge(state)
+		data, err := execTransaction(tx, signatures.GenPrevalidatedSignature(owner))
+		if err != nil {
+			return simulate.Payload{}, err
+		}
+		calls[i] = Call3{Target: c.cfg.Safe, CallData: data}
+	}
+	overrides.Merge(b.cfg.SimulationOverrides)
+
+	data, err := Aggregate3(calls)
+	if err != nil {
+		return simulate.Payload{}, fmt.Errorf("failed to pack aggregate3: %w", err)
+	}
+	return simulate.Payload{From: from, To: Multicall3, Data: data, Overrides: overrides}, nil
+}
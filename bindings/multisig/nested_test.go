@@ -0,0 +1,212 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/signatures"
+)
+
+var (
+	safe1 = common.BigToAddress(big.NewInt(1001))
+	safe2 = common.BigToAddress(big.NewInt(1002))
+	safe3 = common.BigToAddress(big.NewInt(1003))
+	safe4 = common.BigToAddress(big.NewInt(1004))
+)
+
+func checkDataToSign(t *testing.T, b *Builder, want string) {
+	t.Helper()
+	data, _, err := b.DataToSign(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hexutil.Encode(data) != want {
+		t.Fatalf("got data to sign %x, want %s", data, want)
+	}
+}
+
+// TestNestedDataToSign checks the vectors of
+// test/universal/NestedMultisigBuilder.t.sol, where Safe 1003 is owned by
+// Safes 1001 and 1002.
+func TestNestedDataToSign(t *testing.T) {
+	top, err := New(Config{Safe: safe3}, &fakeSafe{}, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	approval1, err := top.Approval(context.Background(), Config{Safe: safe1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDataToSign(t, approval1, "0x1901d4bb33110137810c444c1d9617abe97df097d587ecde64e6fcb38d7f49e1280c3afd48ea8b0056e1028951ba44695d612396f4a1c3851f4b8a262c53ee1f2503")
+	approval2, err := top.Approval(context.Background(), Config{Safe: safe2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDataToSign(t, approval2, "0x190132640243d7aade8c72f3d90d2dbf359e9897feba5fce1453bc8d9e7ba10d17153afd48ea8b0056e1028951ba44695d612396f4a1c3851f4b8a262c53ee1f2503")
+}
+
+// TestDoubleNestedDataToSign checks the vectors of
+// test/universal/DoubleNestedMultisigBuilder.t.sol, where Safe 1004 is owned
+// by Safe 1003, itself owned by Safes 1001 and 1002.
+func TestDoubleNestedDataToSign(t *testing.T) {
+	top, err := New(Config{Safe: safe4}, &fakeSafe{}, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediate, err := top.Approval(context.Background(), Config{Safe: safe3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer1, err := intermediate.Approval(context.Background(), Config{Safe: safe1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDataToSign(t, signer1, "0x1901d4bb33110137810c444c1d9617abe97df097d587ecde64e6fcb38d7f49e1280c32a807b9689901dd0dbb7352e9e6c5265e3f6a68667de4be988f03f6a88511f7")
+	signer2, err := intermediate.Approval(context.Background(), Config{Safe: safe2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkDataToSign(t, signer2, "0x190132640243d7aade8c72f3d90d2dbf359e9897feba5fce1453bc8d9e7ba10d171532a807b9689901dd0dbb7352e9e6c5265e3f6a68667de4be988f03f6a88511f7")
+}
+
+func TestExecuteApproved(t *testing.T) {
+	backend := &fakeSafe{threshold: 2, owners: []common.Address{safe2, safe1}}
+	top, err := New(Config{Safe: safe3}, backend, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, hash, err := top.DataToSign(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backend.approved = map[common.Address]common.Hash{safe2: hash}
+	if _, err := top.ExecTransaction(context.Background(), nil); !errors.Is(err, signatures.ErrNotEnoughSignatures) {
+		t.Fatalf("expected ErrNotEnoughSignatures, got %v", err)
+	}
+	if approved, err := top.Approved(context.Background(), safe1); err != nil || approved {
+		t.Fatalf("expected Safe 1001 not to have approved, got %v (%v)", approved, err)
+	}
+
+	backend.approved[safe1] = hash
+	if _, err := top.ExecTransaction(context.Background(), nil); err != nil {
+		t.Fatal(err)
+	}
+	want := append(signatures.GenPrevalidatedSignature(safe1), signatures.GenPrevalidatedSignature(safe2)...)
+	if !bytes.Equal(backend.checked, want) {
+		t.Fatalf("got signatures %x, want the sorted prevalidated signatures %x", backend.checked, want)
+	}
+}
+
+func TestNestedPayload(t *testing.T) {
+	from := common.HexToAddress("0x0a")
+	backend := &fakeSafe{threshold: 2, owners: []common.Address{safe1, safe2}}
+	top, err := New(Config{Safe: safe3}, backend, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	approval, err := top.Approval(context.Background(), Config{Safe: safe1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := top.NestedPayload(context.Background(), approval, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload.From != from || payload.To != Multicall3 || len(payload.Overrides) != 2 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+	if _, ok := payload.Overrides[safe1]; !ok {
+		t.Fatalf("owner Safe not overridden: %+v", payload.Overrides)
+	}
+	if _, ok := payload.Overrides[safe3]; !ok {
+		t.Fatalf("Safe not overridden: %+v", payload.Overrides)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(multicall3ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	args, err := parsed.Methods["aggregate3"].Inputs.Unpack(payload.Data[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := args[0].([]struct {
+		Target       common.Address `json:"target"`
+		AllowFailure bool           `json:"allowFailure"`
+		CallData     []byte         `json:"callData"`
+	})
+	if len(calls) != 2 || calls[0].Target != safe1 || calls[1].Target != safe3 {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+}
+
+func TestDoubleNestedPayload(t *testing.T) {
+	from := common.HexToAddress("0x0a")
+	backend := &fakeSafe{threshold: 2, owners: []common.Address{safe1, safe2}}
+	top, err := New(Config{Safe: safe4}, backend, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediate, err := top.Approval(context.Background(), Config{Safe: safe3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := intermediate.Approval(context.Background(), Config{Safe: safe1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := top.NestedPayload(context.Background(), signer, from)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payload.Overrides) != 3 {
+		t.Fatalf("got %d overridden accounts, want the 3 Safes", len(payload.Overrides))
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(multicall3ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	args, err := parsed.Methods["aggregate3"].Inputs.Unpack(payload.Data[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := args[0].([]struct {
+		Target       common.Address `json:"target"`
+		AllowFailure bool           `json:"allowFailure"`
+		CallData     []byte         `json:"callData"`
+	})
+	if len(calls) != 3 || calls[0].Target != safe1 || calls[1].Target != safe3 || calls[2].Target != safe4 {
+		t.Fatalf("unexpected calls: %+v", calls)
+	}
+	// The intermediate Safe accepts the signature of the signer Safe.
+	safe, err := abi.JSON(strings.NewReader(bindings.IGnosisSafeMetaData.ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	exec, err := safe.Methods["execTransaction"].Inputs.Unpack(calls[1].CallData[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig := exec[9].([]byte); !bytes.Equal(sig, signatures.GenPrevalidatedSignature(safe1)) {
+		t.Fatalf("got signature %x, want the prevalidated signature of the signer Safe", sig)
+	}
+
+	other, err := New(Config{Safe: safe2}, backend, incrementCalls())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.NestedPayload(context.Background(), signer, from); err == nil {
+		t.Fatal("expected an error for a signer not approving the transaction")
+	}
+}
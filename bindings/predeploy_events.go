@@ -0,0 +1,22 @@
+package bindings
+
+import "github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+// The predeploys a disbursement goes through have no binding in this package.
+// Their events are registered from these ABIs, and decoded into Args only.
+var (
+	// L2StandardBridgeEventsMetaData holds the events of the L2StandardBridge.
+	L2StandardBridgeEventsMetaData = &bind.MetaData{
+		ABI: `[{"type":"event","name":"WithdrawalInitiated","anonymous":false,"inputs":[{"name":"l1Token","type":"address","indexed":true},{"name":"l2Token","type":"address","indexed":true},{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":false},{"name":"amount","type":"uint256","indexed":false},{"name":"extraData","type":"bytes","indexed":false}]},{"type":"event","name":"DepositFinalized","anonymous":false,"inputs":[{"name":"l1Token","type":"address","indexed":true},{"name":"l2Token","type":"address","indexed":true},{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":false},{"name":"amount","type":"uint256","indexed":false},{"name":"extraData","type":"bytes","indexed":false}]},{"type":"event","name":"ETHBridgeInitiated","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"extraData","type":"bytes","indexed":false}]},{"type":"event","name":"ETHBridgeFinalized","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"extraData","type":"bytes","indexed":false}]},{"type":"event","name":"ERC20BridgeInitiated","anonymous":false,"inputs":[{"name":"localToken","type":"address","indexed":true},{"name":"remoteToken","type":"address","indexed":true},{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":false},{"name":"amount","type":"uint256","indexed":false},{"name":"extraData","type":"bytes","indexed":false}]},{"type":"event","name":"ERC20BridgeFinalized","anonymous":false,"inputs":[{"name":"localToken","type":"address","indexed":true},{"name":"remoteToken","type":"address","indexed":true},{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":false},{"name":"amount","type":"uint256","indexed":false},{"name":"extraData","type":"bytes","indexed":false}]}]`,
+	}
+	// L2CrossDomainMessengerEventsMetaData holds the events of the
+	// L2CrossDomainMessenger.
+	L2CrossDomainMessengerEventsMetaData = &bind.MetaData{
+		ABI: `[{"type":"event","name":"SentMessage","anonymous":false,"inputs":[{"name":"target","type":"address","indexed":true},{"name":"sender","type":"address","indexed":false},{"name":"message","type":"bytes","indexed":false},{"name":"messageNonce","type":"uint256","indexed":false},{"name":"gasLimit","type":"uint256","indexed":false}]},{"type":"event","name":"SentMessageExtension1","anonymous":false,"inputs":[{"name":"sender","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},{"type":"event","name":"RelayedMessage","anonymous":false,"inputs":[{"name":"msgHash","type":"bytes32","indexed":true}]},{"type":"event","name":"FailedRelayedMessage","anonymous":false,"inputs":[{"name":"msgHash","type":"bytes32","indexed":true}]}]`,
+	}
+	// L2ToL1MessagePasserEventsMetaData holds the events of the
+	// L2ToL1MessagePasser.
+	L2ToL1MessagePasserEventsMetaData = &bind.MetaData{
+		ABI: `[{"type":"event","name":"MessagePassed","anonymous":false,"inputs":[{"name":"nonce","type":"uint256","indexed":true},{"name":"sender","type":"address","indexed":true},{"name":"target","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false},{"name":"gasLimit","type":"uint256","indexed":false},{"name":"data","type":"bytes","indexed":false},{"name":"withdrawalHash","type":"bytes32","indexed":false}]},{"type":"event","name":"WithdrawerBalanceBurnt","anonymous":false,"inputs":[{"name":"amount","type":"uint256","indexed":true}]}]`,
+	}
+)
@@ -0,0 +1,26 @@
+// Package predeploys holds the addresses of the OP Stack L2 predeploys the
+// revenue-share contracts interact with, mirroring Predeploys.sol.
+package predeploys
+
+import "github.com/ethereum/go-ethereum/common"
+
+var (
+	// L2CrossDomainMessenger sends the messages of the L2StandardBridge to L1.
+	L2CrossDomainMessenger = common.HexToAddress("0x4200000000000000000000000000000000000007")
+	// L2StandardBridge bridges the L1 share of disbursed fees to L1.
+	L2StandardBridge = common.HexToAddress("0x4200000000000000000000000000000000000010")
+	// SequencerFeeVault collects the priority fees paid to the sequencer.
+	SequencerFeeVault = common.HexToAddress("0x4200000000000000000000000000000000000011")
+	// L2ToL1MessagePasser stores the withdrawals initiated on L2.
+	L2ToL1MessagePasser = common.HexToAddress("0x4200000000000000000000000000000000000016")
+	// ProxyAdmin is the admin of the predeploy proxies.
+	ProxyAdmin = common.HexToAddress("0x4200000000000000000000000000000000000018")
+	// BaseFeeVault collects the base fees.
+	BaseFeeVault = common.HexToAddress("0x4200000000000000000000000000000000000019")
+	// L1FeeVault collects the L1 data fees.
+	L1FeeVault = common.HexToAddress("0x420000000000000000000000000000000000001A")
+)
+
+// FeeVaults lists the fee vaults withdrawn by FeeDisburser.disburseFees, in
+// the order they are withdrawn.
+var FeeVaults = []common.Address{SequencerFeeVault, BaseFeeVault, L1FeeVault}
@@ -0,0 +1,170 @@
+// Package reconcile checks that the L1 share of every disbursement of a
+// FeeDisburser reached L1: each FeesDisbursed event, as indexed by the
+// indexer, is paired with the withdrawal bridging TotalFeesDisbursed -
+// PaidToOptimism to the L1 wallet, and the withdrawal is flagged if it isn't
+// proven or finalized in time, or if the amount doesn't match.
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/base-org/contracts/bindings/indexer"
+	"github.com/base-org/contracts/bindings/withdrawals"
+)
+
+var (
+	// ErrNoWithdrawal is reported when a disbursement initiated no withdrawal
+	// bridging ether to the L1 wallet.
+	ErrNoWithdrawal = errors.New("reconcile: no withdrawal initiated")
+	// ErrAmountMismatch is reported when the withdrawal doesn't bridge the L1
+	// share of the disbursement.
+	ErrAmountMismatch = errors.New("reconcile: withdrawal amount doesn't match the L1 share")
+	// ErrNotProven is reported when a withdrawal isn't proven past the prove
+	// deadline.
+	ErrNotProven = errors.New("reconcile: withdrawal stuck unproven")
+	// ErrNotFinalized is reported when a proven withdrawal isn't finalized
+	// past the finalize deadline.
+	ErrNotFinalized = errors.New("reconcile: withdrawal stuck unfinalized")
+	// ErrFinalizationFailed is reported when a withdrawal was finalized
+	// without being executed successfully.
+	ErrFinalizationFailed = errors.New("reconcile: withdrawal finalized unsuccessfully")
+	// ErrFinalizedAmountMismatch is reported when the ether released on L1
+	// doesn't match the L1 share of the disbursement.
+	ErrFinalizedAmountMismatch = errors.New("reconcile: finalized amount doesn't match the L1 share")
+)
+
+// L2Backend is the L2 chain access needed by a Reconciler. ethclient.Client
+// implements it.
+type L2Backend interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// Config configures a Reconciler.
+type Config struct {
+	// L1Wallet is the L1_WALLET of the FeeDisburser, the recipient of the L1
+	// share on L1.
+	L1Wallet common.Address
+	// ProveDeadline is how long after a disbursement its withdrawal may stay
+	// unproven before being flagged.
+	ProveDeadline time.Duration
+	// FinalizeDeadline is how long after its proof a withdrawal may stay
+	// unfinalized before being flagged, longer than the challenge period.
+	FinalizeDeadline time.Duration
+}
+
+// Entry is a disbursement and the withdrawal of its L1 share.
+type Entry struct {
+	Disbursement indexer.Disbursement
+	// Withdrawal is the withdrawal initiated by the disbursement, nil if
+	// there is none.
+	Withdrawal *withdrawals.Withdrawal
+	// Status is the progress of the withdrawal on L1, nil if there is none.
+	Status *withdrawals.Status
+	// Problems are the errors found, wrapping the Err variables of this
+	// package.
+	Problems []error
+}
+
+// OK reports whether no problem was found.
+func (e *Entry) OK() bool {
+	return len(e.Problems) == 0
+}
+
+// Reconciler pairs disbursements with their withdrawals.
+type Reconciler struct {
+	cfg     Config
+	l2      L2Backend
+	tracker *withdrawals.Tracker
+}
+
+// New creates a Reconciler.
+func New(cfg Config, l2 L2Backend, tracker *withdrawals.Tracker) *Reconciler {
+	return &Reconciler{cfg: cfg, l2: l2, tracker: tracker}
+}
+
+// Reconcile checks the disbursements, e.g. returned by indexer.DB.Disbursements,
+// against the L1 chain as of l1Head, the latest block if nil.
+func (r *Reconciler) Reconcile(ctx context.Context, disbursements []indexer.Disbursement, l1Head *types.Header) ([]*Entry, error) {
+	entries := make([]*Entry, len(disbursements))
+	var hashes []common.Hash
+	for i, d := range disbursements {
+		entries[i] = &Entry{Disbursement: d}
+		receipt, err := r.l2.TransactionReceipt(ctx, d.TxHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch receipt of disbursement %s: %w", d.TxHash, err)
+		}
+		ws, err := withdrawals.FromReceipt(receipt)
+		if err != nil {
+			return nil, fmt.Errorf("disbursement %s: %w", d.TxHash, err)
+		}
+		// disburseFees bridges the L1 share with a single withdrawal of the
+		// L2CrossDomainMessenger.
+		w := withdrawals.BridgingETHTo(ws, r.cfg.L1Wallet)
+		if w == nil {
+			entries[i].Problems = append(entries[i].Problems, ErrNoWithdrawal)
+			continue
+		}
+		entries[i].Withdrawal = w
+		hashes = append(hashes, entries[i].Withdrawal.Hash)
+	}
+
+	if l1Head == nil {
+		var err error
+		if l1Head, err = r.tracker.Head(ctx); err != nil {
+			return nil, err
+		}
+	}
+	statuses, err := r.tracker.Statuses(ctx, l1Head, hashes)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.Withdrawal == nil {
+			continue
+		}
+		e.Status = statuses[e.Withdrawal.Hash]
+		e.Problems = append(e.Problems, r.check(e, l1Head.Time)...)
+	}
+	return entries, nil
+}
+
+// check returns the problems of an entry with a withdrawal at L1 time now.
+func (r *Reconciler) check(e *Entry, now uint64) []error {
+	var problems []error
+	l1Share := e.Disbursement.L1Share
+	if e.Withdrawal.Value.Cmp(l1Share) != 0 {
+		problems = append(problems, fmt.Errorf("%w: bridged %s, L1 share %s", ErrAmountMismatch, e.Withdrawal.Value, l1Share))
+	}
+	s := e.Status
+	switch {
+	case s.Finalized:
+		if !s.Success {
+			problems = append(problems, ErrFinalizationFailed)
+		} else if s.FinalizedAmount.Cmp(l1Share) != 0 {
+			problems = append(problems, fmt.Errorf("%w: finalized %s, L1 share %s", ErrFinalizedAmountMismatch, s.FinalizedAmount, l1Share))
+		}
+	case s.Proven:
+		if since := elapsed(s.ProvenTime, now); since > r.cfg.FinalizeDeadline {
+			problems = append(problems, fmt.Errorf("%w: proven %s ago", ErrNotFinalized, since))
+		}
+	default:
+		if since := elapsed(e.Disbursement.Time, now); since > r.cfg.ProveDeadline {
+			problems = append(problems, fmt.Errorf("%w: initiated %s ago", ErrNotProven, since))
+		}
+	}
+	return problems
+}
+
+// elapsed returns the time from the timestamp then to now.
+func elapsed(then, now uint64) time.Duration {
+	if now <= then {
+		return 0
+	}
+	return time.Duration(now-then) * time.Second
+}
@@ -0,0 +1,250 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/base-org/contracts/bindings/indexer"
+	"github.com/base-org/contracts/bindings/predeploys"
+	"github.com/base-org/contracts/bindings/withdrawals"
+)
+
+const day = 24 * 60 * 60
+
+var (
+	portal   = common.HexToAddress("0x0b0b")
+	bridge   = common.HexToAddress("0xb41d")
+	l1Wallet = common.HexToAddress("0x11")
+)
+
+const eventsABI = `[
+{"type":"event","name":"MessagePassed","inputs":[{"name":"nonce","type":"uint256","indexed":true},{"name":"sender","type":"address","indexed":true},{"name":"target","type":"address","indexed":true},{"name":"value","type":"uint256"},{"name":"gasLimit","type":"uint256"},{"name":"data","type":"bytes"},{"name":"withdrawalHash","type":"bytes32"}]},
+{"type":"event","name":"WithdrawalProven","inputs":[{"name":"withdrawalHash","type":"bytes32","indexed":true},{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true}]},
+{"type":"event","name":"WithdrawalFinalized","inputs":[{"name":"withdrawalHash","type":"bytes32","indexed":true},{"name":"success","type":"bool"}]},
+{"type":"event","name":"ETHBridgeFinalized","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"amount","type":"uint256"},{"name":"extraData","type":"bytes"}]}
+]`
+
+// messagesABI holds the relayMessage call of the withdrawals of the
+// L2CrossDomainMessenger, and the finalizeBridgeETH call it relays.
+const messagesABI = `[
+{"type":"function","name":"relayMessage","inputs":[{"name":"_nonce","type":"uint256"},{"name":"_sender","type":"address"},{"name":"_target","type":"address"},{"name":"_value","type":"uint256"},{"name":"_minGasLimit","type":"uint256"},{"name":"_message","type":"bytes"}],"outputs":[]},
+{"type":"function","name":"finalizeBridgeETH","inputs":[{"name":"_from","type":"address"},{"name":"_to","type":"address"},{"name":"_amount","type":"uint256"},{"name":"_extraData","type":"bytes"}],"outputs":[]}
+]`
+
+// fakeChains serves the L2 receipts of the disbursements and the L1 logs and
+// receipts of the OptimismPortal.
+type fakeChains struct {
+	t        *testing.T
+	events   abi.ABI
+	messages abi.ABI
+	l1Head   uint64
+	l1Logs   []types.Log
+	receipts map[common.Hash]*types.Receipt
+}
+
+func newFakeChains(t *testing.T) *fakeChains {
+	parsed, err := abi.JSON(strings.NewReader(eventsABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages, err := abi.JSON(strings.NewReader(messagesABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &fakeChains{t: t, events: parsed, messages: messages, l1Head: 1_000, receipts: make(map[common.Hash]*types.Receipt)}
+}
+
+func (c *fakeChains) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	n := c.l1Head
+	if number != nil {
+		n = number.Uint64()
+	}
+	// One L1 block per hour.
+	return &types.Header{Number: new(big.Int).SetUint64(n), Time: 3_600 * n}, nil
+}
+
+func (c *fakeChains) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	for _, l := range c.l1Logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (c *fakeChains) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if receipt, ok := c.receipts[txHash]; ok {
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *fakeChains) pack(event string, args ...interface{}) []byte {
+	c.t.Helper()
+	data, err := c.events.Events[event].Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return data
+}
+
+// disburse adds the receipt of a disbursement at L2 time bridging value to
+// the L1 wallet, and returns the disbursement and its withdrawal hash.
+func (c *fakeChains) disburse(id int64, time uint64, l1Share, value int64) (indexer.Disbursement, common.Hash) {
+	c.t.Helper()
+	txHash := common.BigToHash(big.NewInt(id))
+	hash := c.withdraw(txHash, id, l1Wallet, value)
+	return indexer.Disbursement{
+		Location:      indexer.Location{TxHash: txHash},
+		Time:          time,
+		Total:         big.NewInt(l1Share + 100),
+		OptimismShare: big.NewInt(100),
+		L1Share:       big.NewInt(l1Share),
+	}, hash
+}
+
+// withdraw adds to the receipt of txHash the withdrawal bridging value to
+// recipient, and returns its hash.
+func (c *fakeChains) withdraw(txHash common.Hash, nonce int64, recipient common.Address, value int64) common.Hash {
+	c.t.Helper()
+	message, err := c.messages.Pack("finalizeBridgeETH", common.HexToAddress("0xfd"), recipient, big.NewInt(value), []byte{})
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	data, err := c.messages.Pack("relayMessage", big.NewInt(nonce), predeploys.L2StandardBridge, bridge, big.NewInt(value), big.NewInt(200_000), message)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	w := &withdrawals.Withdrawal{
+		Nonce:    big.NewInt(nonce),
+		Sender:   predeploys.L2CrossDomainMessenger,
+		Target:   common.HexToAddress("0xce55"),
+		Value:    big.NewInt(value),
+		GasLimit: big.NewInt(200_000),
+		Data:     data,
+	}
+	hash, err := w.ComputeHash()
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	receipt, ok := c.receipts[txHash]
+	if !ok {
+		receipt = new(types.Receipt)
+		c.receipts[txHash] = receipt
+	}
+	receipt.Logs = append(receipt.Logs, &types.Log{
+		Address: predeploys.L2ToL1MessagePasser,
+		Topics:  []common.Hash{c.events.Events["MessagePassed"].ID, common.BigToHash(w.Nonce), common.BytesToHash(w.Sender.Bytes()), common.BytesToHash(w.Target.Bytes())},
+		Data:    c.pack("MessagePassed", w.Value, w.GasLimit, w.Data, hash),
+		TxHash:  txHash,
+		Index:   uint(len(receipt.Logs)),
+	})
+	return hash
+}
+
+func (c *fakeChains) prove(hash common.Hash, block uint64) {
+	c.l1Logs = append(c.l1Logs, types.Log{
+		Address:     portal,
+		Topics:      []common.Hash{c.events.Events["WithdrawalProven"].ID, hash, {}, {}},
+		BlockNumber: block,
+	})
+}
+
+func (c *fakeChains) finalize(hash common.Hash, block uint64, amount int64) {
+	txHash := common.BytesToHash(append([]byte("finalize"), hash[:8]...))
+	finalized := types.Log{
+		Address:     portal,
+		Topics:      []common.Hash{c.events.Events["WithdrawalFinalized"].ID, hash},
+		Data:        c.pack("WithdrawalFinalized", true),
+		BlockNumber: block,
+		TxHash:      txHash,
+		Index:       1,
+	}
+	c.l1Logs = append(c.l1Logs, finalized)
+	c.receipts[txHash] = &types.Receipt{Logs: []*types.Log{
+		{Address: bridge, Topics: []common.Hash{c.events.Events["ETHBridgeFinalized"].ID, {}, {}}, Data: c.pack("ETHBridgeFinalized", big.NewInt(amount), []byte{}), Index: 0},
+		&finalized,
+	}}
+}
+
+func TestReconcile(t *testing.T) {
+	chains := newFakeChains(t)
+	now := chains.l1Head * 3_600
+
+	var disbursements []indexer.Disbursement
+	add := func(d indexer.Disbursement, hash common.Hash) common.Hash {
+		disbursements = append(disbursements, d)
+		return hash
+	}
+	finalized := add(chains.disburse(1, now-30*day, 500, 500))
+	chains.prove(finalized, chains.l1Head-28*24)
+	chains.finalize(finalized, chains.l1Head-20*24, 500)
+
+	add(chains.disburse(2, now-30*day, 500, 400))
+	add(chains.disburse(3, now-3*day, 500, 500))
+	stuck := add(chains.disburse(4, now-30*day, 500, 500))
+	chains.prove(stuck, chains.l1Head-20*24)
+	wrong := add(chains.disburse(5, now-30*day, 500, 500))
+	chains.prove(wrong, chains.l1Head-28*24)
+	chains.finalize(wrong, chains.l1Head-20*24, 499)
+	// The withdrawal of the disbursement is not the last of its transaction.
+	paired := add(chains.disburse(6, now-3_600, 500, 500))
+	chains.withdraw(common.BigToHash(big.NewInt(6)), 60, common.HexToAddress("0xbeef"), 1)
+	noWithdrawal := indexer.Disbursement{Location: indexer.Location{TxHash: common.HexToHash("0x70")}, Time: now - 30*day, L1Share: big.NewInt(1)}
+	chains.receipts[noWithdrawal.TxHash] = &types.Receipt{}
+	disbursements = append(disbursements, noWithdrawal)
+	// Only a withdrawal bridging to another recipient.
+	elsewhere := indexer.Disbursement{Location: indexer.Location{TxHash: common.HexToHash("0x80")}, Time: now - 3_600, L1Share: big.NewInt(1)}
+	chains.withdraw(elsewhere.TxHash, 80, common.HexToAddress("0xbeef"), 1)
+	disbursements = append(disbursements, elsewhere)
+
+	tracker, err := withdrawals.NewTracker(withdrawals.TrackerConfig{OptimismPortal: portal, L1StandardBridge: bridge, ChunkSize: 500}, chains)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := New(Config{L1Wallet: l1Wallet, ProveDeadline: 2 * 24 * time.Hour, FinalizeDeadline: 8 * 24 * time.Hour}, chains, tracker)
+	entries, err := r.Reconcile(context.Background(), disbursements, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]error{
+		nil,
+		{ErrAmountMismatch, ErrNotProven},
+		{ErrNotProven},
+		{ErrNotFinalized},
+		{ErrFinalizedAmountMismatch},
+		nil,
+		{ErrNoWithdrawal},
+		{ErrNoWithdrawal},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(want))
+	}
+	for i, e := range entries {
+		if len(e.Problems) != len(want[i]) {
+			t.Fatalf("entry %d: got problems %v, want %v", i, e.Problems, want[i])
+		}
+		for j, err := range want[i] {
+			if !errors.Is(e.Problems[j], err) {
+				t.Fatalf("entry %d: got problems %v, want %v", i, e.Problems, want[i])
+			}
+		}
+	}
+	if !entries[0].OK() || !entries[0].Status.Finalized || entries[0].Status.FinalizedAmount.Int64() != 500 {
+		t.Fatalf("unexpected finalized entry %+v", entries[0].Status)
+	}
+	if entries[5].Withdrawal.Hash != paired {
+		t.Fatalf("got withdrawal %s, want the one bridging to the L1 wallet", entries[5].Withdrawal.Hash)
+	}
+}
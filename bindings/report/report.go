@@ -0,0 +1,286 @@
+// Package report builds revenue reports of a FeeDisburser from the events
+// stored by the indexer: the fees received from every fee vault, and how each
+// disbursement split them between Optimism and the L1 wallet.
+package report
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/base-org/contracts/bindings/indexer"
+	"github.com/base-org/contracts/bindings/predeploys"
+	"github.com/base-org/contracts/bindings/revshare"
+)
+
+// The vaults fees are received from. Fees sent to the FeeDisburser by any
+// other account are attributed to VaultOther.
+const (
+	VaultSequencer = "sequencer"
+	VaultBase      = "base"
+	VaultL1        = "l1"
+	VaultOther     = "other"
+)
+
+// Vaults lists the vaults in the order of the report columns.
+var Vaults = []string{VaultSequencer, VaultBase, VaultL1, VaultOther}
+
+// The branches of disburseFees, named after the revenue share paid to
+// Optimism.
+const (
+	BranchNet   = "net"
+	BranchGross = "gross"
+)
+
+// VaultOf returns the vault the fees sent by sender are attributed to.
+func VaultOf(sender common.Address) string {
+	switch sender {
+	case predeploys.SequencerFeeVault:
+		return VaultSequencer
+	case predeploys.BaseFeeVault:
+		return VaultBase
+	case predeploys.L1FeeVault:
+		return VaultL1
+	}
+	return VaultOther
+}
+
+// Source is the indexed events a report is built from. indexer.DB implements
+// it.
+type Source interface {
+	Disbursements(ctx context.Context, contract common.Address, from, to uint64) ([]indexer.Disbursement, error)
+	FeesReceived(ctx context.Context, contract common.Address, from, to uint64) ([]indexer.FeeReceipt, error)
+}
+
+// Receipt is a FeesReceived event.
+type Receipt struct {
+	BlockNumber uint64         `json:"blockNumber"`
+	TxHash      common.Hash    `json:"txHash"`
+	LogIndex    uint           `json:"logIndex"`
+	Sender      common.Address `json:"sender"`
+	Vault       string         `json:"vault"`
+	Amount      *big.Int       `json:"amount"`
+}
+
+// Disbursement is a FeesDisbursed event, with the fees received since the
+// previous disbursement and the revenue share recomputed from them.
+type Disbursement struct {
+	BlockNumber uint64      `json:"blockNumber"`
+	BlockHash   common.Hash `json:"blockHash"`
+	TxHash      common.Hash `json:"txHash"`
+	LogIndex    uint        `json:"logIndex"`
+	// Timestamp is the block timestamp of the disbursement.
+	Timestamp uint64 `json:"timestamp"`
+	// Received is the sum of the fees received from every vault since the
+	// previous disbursement.
+	Received map[string]*big.Int `json:"received"`
+	// NetRevenue is the fees received from the sequencer and base fee vaults.
+	NetRevenue *big.Int `json:"netRevenue"`
+	// GrossRevenue is the fee balance that was disbursed.
+	GrossRevenue *big.Int `json:"grossRevenue"`
+	NetShare     *big.Int `json:"netShare"`
+	GrossShare   *big.Int `json:"grossShare"`
+	// Branch is BranchNet if Optimism was paid its share of the net revenue,
+	// BranchGross if it was paid its share of the gross revenue.
+	Branch string `json:"branch"`
+	// OptimismShare and L1Share are the amounts paid, as emitted.
+	OptimismShare *big.Int `json:"optimismShare"`
+	L1Share       *big.Int `json:"l1Share"`
+	// Consistent reports whether the recomputed split matches the amounts
+	// paid. It doesn't if fees were received before the first indexed block.
+	Consistent bool      `json:"consistent"`
+	Receipts   []Receipt `json:"receipts"`
+}
+
+// Report covers the disbursements with a timestamp in [From, To).
+type Report struct {
+	FeeDisburser common.Address  `json:"feeDisburser"`
+	From         time.Time       `json:"from"`
+	To           time.Time       `json:"to"`
+	Params       revshare.Params `json:"params"`
+	// Received is the sum of the fees disbursed in the period by vault.
+	Received      map[string]*big.Int `json:"received"`
+	GrossRevenue  *big.Int            `json:"grossRevenue"`
+	OptimismShare *big.Int            `json:"optimismShare"`
+	L1Share       *big.Int            `json:"l1Share"`
+	Disbursements []*Disbursement     `json:"disbursements"`
+}
+
+// Build builds the report of the FeeDisburser at contract for the
+// disbursements with a timestamp in [from, to), whose split is recomputed with
+// params.
+func Build(ctx context.Context, src Source, contract common.Address, params revshare.Params, from, to time.Time) (*Report, error) {
+	r := &Report{
+		FeeDisburser:  contract,
+		From:          from.UTC(),
+		To:            to.UTC(),
+		Params:        params,
+		Received:      newReceived(),
+		GrossRevenue:  new(big.Int),
+		OptimismShare: new(big.Int),
+		L1Share:       new(big.Int),
+		Disbursements: []*Disbursement{},
+	}
+	all, err := src.Disbursements(ctx, contract, 0, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+	first, last := -1, -1
+	for i, d := range all {
+		t := time.Unix(int64(d.Time), 0)
+		if !t.Before(from) && t.Before(to) {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+	if first < 0 {
+		return r, nil
+	}
+
+	// The fees disbursed first were received after the previous disbursement.
+	var prev *indexer.Location
+	start := uint64(0)
+	if first > 0 {
+		prev = &all[first-1].Location
+		start = prev.BlockNumber
+	}
+	receipts, err := src.FeesReceived(ctx, contract, start, all[last].BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	for i := first; i <= last; i++ {
+		d := &all[i]
+		entry := &Disbursement{
+			BlockNumber:   d.BlockNumber,
+			BlockHash:     d.BlockHash,
+			TxHash:        d.TxHash,
+			LogIndex:      d.LogIndex,
+			Timestamp:     d.Time,
+			Received:      newReceived(),
+			NetRevenue:    new(big.Int),
+			GrossRevenue:  d.Total,
+			OptimismShare: d.OptimismShare,
+			L1Share:       d.L1Share,
+			Receipts:      []Receipt{},
+		}
+		for len(receipts) > 0 && before(receipts[0].Location, d.Location) {
+			rc := receipts[0]
+			receipts = receipts[1:]
+			if prev != nil && !before(*prev, rc.Location) {
+				continue
+			}
+			vault := VaultOf(rc.Sender)
+			entry.Receipts = append(entry.Receipts, Receipt{
+				BlockNumber: rc.BlockNumber,
+				TxHash:      rc.TxHash,
+				LogIndex:    rc.LogIndex,
+				Sender:      rc.Sender,
+				Vault:       vault,
+				Amount:      rc.Amount,
+			})
+			entry.Received[vault].Add(entry.Received[vault], rc.Amount)
+			// receive() only adds the fees of the sequencer and base fee
+			// vaults to netFeeRevenue.
+			if vault == VaultSequencer || vault == VaultBase {
+				entry.NetRevenue.Add(entry.NetRevenue, rc.Amount)
+			}
+		}
+		prev = &d.Location
+
+		split, err := params.Split(entry.NetRevenue, entry.GrossRevenue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to recompute disbursement %s: %w", d.TxHash, err)
+		}
+		entry.NetShare, entry.GrossShare = split.NetShare, split.GrossShare
+		// Math.max picks the net share when both are equal.
+		entry.Branch = BranchNet
+		if split.GrossShare.Cmp(split.NetShare) > 0 {
+			entry.Branch = BranchGross
+		}
+		entry.Consistent = split.OptimismShare.Cmp(d.OptimismShare) == 0 && split.L1Share.Cmp(d.L1Share) == 0
+
+		for _, vault := range Vaults {
+			r.Received[vault].Add(r.Received[vault], entry.Received[vault])
+		}
+		r.GrossRevenue.Add(r.GrossRevenue, d.Total)
+		r.OptimismShare.Add(r.OptimismShare, d.OptimismShare)
+		r.L1Share.Add(r.L1Share, d.L1Share)
+		r.Disbursements = append(r.Disbursements, entry)
+	}
+	return r, nil
+}
+
+func newReceived() map[string]*big.Int {
+	received := make(map[string]*big.Int, len(Vaults))
+	for _, vault := range Vaults {
+		received[vault] = new(big.Int)
+	}
+	return received
+}
+
+// before reports whether the event at a was emitted before the one at b.
+func before(a, b indexer.Location) bool {
+	if a.BlockNumber != b.BlockNumber {
+		return a.BlockNumber < b.BlockNumber
+	}
+	return a.LogIndex < b.LogIndex
+}
+
+// WriteJSON writes the report as indented JSON. Amounts are in wei.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV writes one row per disbursement, with the fees received from every
+// vault in wei.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"block_number", "block_hash", "tx_hash", "log_index", "timestamp", "time"}
+	for _, vault := range Vaults {
+		header = append(header, vault+"_fees")
+	}
+	header = append(header, "net_revenue", "gross_revenue", "net_share", "gross_share", "branch", "optimism_share", "l1_share", "consistent")
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, d := range r.Disbursements {
+		row := []string{
+			strconv.FormatUint(d.BlockNumber, 10),
+			d.BlockHash.Hex(),
+			d.TxHash.Hex(),
+			strconv.FormatUint(uint64(d.LogIndex), 10),
+			strconv.FormatUint(d.Timestamp, 10),
+			time.Unix(int64(d.Timestamp), 0).UTC().Format(time.RFC3339),
+		}
+		for _, vault := range Vaults {
+			row = append(row, d.Received[vault].String())
+		}
+		row = append(row,
+			d.NetRevenue.String(),
+			d.GrossRevenue.String(),
+			d.NetShare.String(),
+			d.GrossShare.String(),
+			d.Branch,
+			d.OptimismShare.String(),
+			d.L1Share.String(),
+			strconv.FormatBool(d.Consistent),
+		)
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
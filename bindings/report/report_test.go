@@ -0,0 +1,145 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/base-org/contracts/bindings/indexer"
+	"github.com/base-org/contracts/bindings/predeploys"
+	"github.com/base-org/contracts/bindings/revshare"
+)
+
+var feeDisburser = common.HexToAddress("0xfeed")
+
+// fakeSource serves indexed events, oldest first.
+type fakeSource struct {
+	disbursements []indexer.Disbursement
+	receipts      []indexer.FeeReceipt
+}
+
+func (s *fakeSource) Disbursements(ctx context.Context, contract common.Address, from, to uint64) ([]indexer.Disbursement, error) {
+	var out []indexer.Disbursement
+	for _, d := range s.disbursements {
+		if d.BlockNumber >= from && d.BlockNumber <= to {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (s *fakeSource) FeesReceived(ctx context.Context, contract common.Address, from, to uint64) ([]indexer.FeeReceipt, error) {
+	var out []indexer.FeeReceipt
+	for _, r := range s.receipts {
+		if r.BlockNumber >= from && r.BlockNumber <= to {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (s *fakeSource) receive(block uint64, logIndex uint, sender common.Address, amount int64) {
+	s.receipts = append(s.receipts, indexer.FeeReceipt{
+		Location: indexer.Location{BlockNumber: block, LogIndex: logIndex, TxHash: common.BigToHash(new(big.Int).SetUint64(block))},
+		Sender:   sender,
+		Amount:   big.NewInt(amount),
+	})
+}
+
+func (s *fakeSource) disburse(block uint64, logIndex uint, t time.Time, total, optimismShare int64) {
+	s.disbursements = append(s.disbursements, indexer.Disbursement{
+		Location:      indexer.Location{BlockNumber: block, LogIndex: logIndex, TxHash: common.BigToHash(new(big.Int).SetUint64(block))},
+		Time:          uint64(t.Unix()),
+		Total:         big.NewInt(total),
+		OptimismShare: big.NewInt(optimismShare),
+		L1Share:       big.NewInt(total - optimismShare),
+	})
+}
+
+func TestBuild(t *testing.T) {
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	src := &fakeSource{}
+	other := common.HexToAddress("0x0123")
+
+	// Disbursed before the period.
+	src.receive(10, 0, predeploys.SequencerFeeVault, 1_000_000)
+	src.disburse(10, 1, from.Add(-time.Hour), 1_000_000, 150_000)
+
+	// Gross branch: 15% of 2_000 is less than 2.5% of 102_000.
+	src.receive(20, 0, predeploys.SequencerFeeVault, 1_000)
+	src.receive(20, 1, predeploys.BaseFeeVault, 1_000)
+	src.receive(20, 2, predeploys.L1FeeVault, 100_000)
+	src.disburse(20, 3, from.Add(time.Hour), 102_000, 2_550)
+
+	// Net branch, with fees sent by another account and received in an
+	// earlier block. The paid share doesn't match the recomputed one.
+	src.receive(25, 0, other, 10)
+	src.receive(30, 0, predeploys.SequencerFeeVault, 100_000)
+	src.receive(30, 1, predeploys.BaseFeeVault, 50_000)
+	src.receive(30, 2, predeploys.L1FeeVault, 1_000)
+	src.disburse(30, 3, to.Add(-time.Second), 151_010, 22_499)
+
+	// Disbursed after the period.
+	src.receive(40, 0, predeploys.BaseFeeVault, 5_000)
+	src.disburse(40, 1, to, 5_000, 750)
+
+	params := revshare.Params{NetShareBasisPoints: big.NewInt(1_500), GrossShareBasisPoints: big.NewInt(250), BasisPointScale: big.NewInt(10_000)}
+	r, err := Build(context.Background(), src, feeDisburser, params, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Disbursements) != 2 {
+		t.Fatalf("got %d disbursements, want 2", len(r.Disbursements))
+	}
+
+	gross, net := r.Disbursements[0], r.Disbursements[1]
+	if gross.Branch != BranchGross || gross.NetShare.Int64() != 300 || gross.GrossShare.Int64() != 2_550 || !gross.Consistent || len(gross.Receipts) != 3 {
+		t.Fatalf("unexpected gross branch disbursement %+v", gross)
+	}
+	if net.Branch != BranchNet || net.NetRevenue.Int64() != 150_000 || net.NetShare.Int64() != 22_500 || net.Consistent || len(net.Receipts) != 4 {
+		t.Fatalf("unexpected net branch disbursement %+v", net)
+	}
+	if net.Received[VaultOther].Int64() != 10 || net.Received[VaultL1].Int64() != 1_000 {
+		t.Fatalf("unexpected fees received %v", net.Received)
+	}
+	want := map[string]int64{VaultSequencer: 101_000, VaultBase: 51_000, VaultL1: 101_000, VaultOther: 10}
+	for vault, amount := range want {
+		if r.Received[vault].Int64() != amount {
+			t.Fatalf("received %s from %s vault, want %d", r.Received[vault], vault, amount)
+		}
+	}
+	if r.GrossRevenue.Int64() != 253_010 || r.OptimismShare.Int64() != 25_049 || r.L1Share.Int64() != 227_961 {
+		t.Fatalf("unexpected totals gross=%s optimism=%s l1=%s", r.GrossRevenue, r.OptimismShare, r.L1Share)
+	}
+
+	var buf bytes.Buffer
+	if err := r.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 || rows[1][0] != "20" || rows[1][5] != "2026-09-01T01:00:00Z" || rows[2][14] != BranchNet {
+		t.Fatalf("unexpected CSV %v", rows)
+	}
+
+	buf.Reset()
+	if err := r.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Disbursements) != 2 || decoded.Disbursements[1].Receipts[0].Sender != other || decoded.Params.NetShareBasisPoints.Int64() != 1_500 {
+		t.Fatalf("unexpected JSON %s", buf.String())
+	}
+}
@@ -0,0 +1,171 @@
+package bindings
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Revert reasons of the FeeDisburser contract.
+var (
+	ErrFeeDisburserOptimismWalletZero  = errors.New("FeeDisburser: OptimismWallet cannot be address(0)")
+	ErrFeeDisburserL1WalletZero        = errors.New("FeeDisburser: L1Wallet cannot be address(0)")
+	ErrFeeDisburserIntervalTooShort    = errors.New("FeeDisburser: FeeDisbursementInterval cannot be less than 24 hours")
+	ErrFeeDisburserIntervalNotReached  = errors.New("FeeDisburser: Disbursement interval not reached")
+	ErrFeeDisburserOptimismSendFailed  = errors.New("FeeDisburser: Failed to send funds to Optimism")
+	ErrFeeDisburserUnauthorizedSender  = errors.New("FeeDisburser: Only FeeVaults can send ETH to FeeDisburser")
+	ErrFeeDisburserVaultNotL2          = errors.New("FeeDisburser: FeeVault must withdraw to L2")
+	ErrFeeDisburserVaultWrongRecipient = errors.New("FeeDisburser: FeeVault must withdraw to FeeDisburser contract")
+)
+
+// Revert reasons of the BalanceTracker contract.
+var (
+	ErrBalanceTrackerProfitWalletZero  = errors.New("BalanceTracker: PROFIT_WALLET cannot be address(0)")
+	ErrBalanceTrackerNoSystemAddresses = errors.New("BalanceTracker: systemAddresses cannot have a length of zero")
+	ErrBalanceTrackerTooManyAddresses  = errors.New("BalanceTracker: systemAddresses cannot have a length greater than 20")
+	ErrBalanceTrackerLengthMismatch    = errors.New("BalanceTracker: systemAddresses and targetBalances length must be equal")
+	ErrBalanceTrackerSystemAddressZero = errors.New("BalanceTracker: systemAddresses cannot contain address(0)")
+	ErrBalanceTrackerTargetBalanceZero = errors.New("BalanceTracker: targetBalances cannot contain 0 target")
+)
+
+// Revert reasons of the Challenger1of2 and Vetoer1of2 contracts. The failed to
+// execute reasons are only used when the forwarded call reverted without data,
+// otherwise its revert data is bubbled up.
+var (
+	ErrChallenger1of2OpSignerZero      = errors.New("Challenger1of2: opSigner cannot be zero address")
+	ErrChallenger1of2OtherSignerZero   = errors.New("Challenger1of2: otherSigner cannot be zero address")
+	ErrChallenger1of2OracleNotContract = errors.New("Challenger1of2: l2OutputOracleProxy must be a contract")
+	ErrChallenger1of2NotApprovedSigner = errors.New("Challenger1of2: must be an approved signer to execute")
+	ErrChallenger1of2ExecutionFailed   = errors.New("Challenger1of2: failed to execute")
+	ErrVetoer1of2ExecutionFailed       = errors.New("Vetoer1of2: failed to execute")
+)
+
+// revertReasons maps Error(string) reasons to their sentinel error.
+var revertReasons = make(map[string]error)
+
+func init() {
+	for _, err := range []error{
+		ErrFeeDisburserOptimismWalletZero,
+		ErrFeeDisburserL1WalletZero,
+		ErrFeeDisburserIntervalTooShort,
+		ErrFeeDisburserIntervalNotReached,
+		ErrFeeDisburserOptimismSendFailed,
+		ErrFeeDisburserUnauthorizedSender,
+		ErrFeeDisburserVaultNotL2,
+		ErrFeeDisburserVaultWrongRecipient,
+		ErrBalanceTrackerProfitWalletZero,
+		ErrBalanceTrackerNoSystemAddresses,
+		ErrBalanceTrackerTooManyAddresses,
+		ErrBalanceTrackerLengthMismatch,
+		ErrBalanceTrackerSystemAddressZero,
+		ErrBalanceTrackerTargetBalanceZero,
+		ErrChallenger1of2OpSignerZero,
+		ErrChallenger1of2OtherSignerZero,
+		ErrChallenger1of2OracleNotContract,
+		ErrChallenger1of2NotApprovedSigner,
+		ErrChallenger1of2ExecutionFailed,
+		ErrVetoer1of2ExecutionFailed,
+	} {
+		revertReasons[err.Error()] = err
+	}
+}
+
+// customErrorUnpackers decode the custom errors of every contract declaring
+// some.
+var customErrorUnpackers = []func([]byte) (error, bool){
+	UnpackSmartEscrowError,
+	UnpackVetoer1of2Error,
+}
+
+// RevertError is a decoded revert.
+type RevertError struct {
+	// Reason is the Error(string) reason or the description of a Panic(uint256)
+	// code, empty for custom errors.
+	Reason string
+	// Err is the sentinel error of Reason or the typed custom error, nil if the
+	// revert is unknown to this package.
+	Err error
+	// Data is the raw revert data.
+	Data []byte
+
+	// cause is the error the revert was decoded from.
+	cause error
+}
+
+func (e *RevertError) Error() string {
+	switch {
+	case e.Err != nil:
+		return "execution reverted: " + e.Err.Error()
+	case e.Reason != "":
+		return "execution reverted: " + e.Reason
+	default:
+		return fmt.Sprintf("execution reverted: %x", e.Data)
+	}
+}
+
+// Unwrap returns the decoded error and the error it was decoded from, so that
+// errors.Is matches the sentinel errors and errors.As the custom errors.
+func (e *RevertError) Unwrap() []error {
+	var errs []error
+	if e.Err != nil {
+		errs = append(errs, e.Err)
+	}
+	if e.cause != nil {
+		errs = append(errs, e.cause)
+	}
+	return errs
+}
+
+// UnpackRevert decodes revert data into a RevertError.
+func UnpackRevert(data []byte) *RevertError {
+	revert := &RevertError{Data: data}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		revert.Reason = reason
+		revert.Err = revertReasons[reason]
+		return revert
+	}
+	for _, unpack := range customErrorUnpackers {
+		if err, ok := unpack(data); ok {
+			revert.Err = err
+			break
+		}
+	}
+	return revert
+}
+
+// DecodeRevert turns the error of a reverted call or gas estimation into a
+// RevertError wrapping it. The revert data is taken from the JSON-RPC error
+// data, falling back to the reason in the error message for nodes that don't
+// return any. Errors that are no revert are returned unchanged.
+//
+//	_, err := contract.DisburseFees(opts)
+//	if errors.Is(bindings.DecodeRevert(err), bindings.ErrFeeDisburserIntervalNotReached) {
+func DecodeRevert(err error) error {
+	if err == nil {
+		return nil
+	}
+	var revert *RevertError
+	if errors.As(err, &revert) {
+		return err
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if hex, ok := dataErr.ErrorData().(string); ok {
+			if data, decodeErr := hexutil.Decode(hex); decodeErr == nil {
+				revert = UnpackRevert(data)
+				revert.cause = err
+				return revert
+			}
+		}
+	}
+	const prefix = "execution reverted: "
+	if i := strings.Index(err.Error(), prefix); i >= 0 {
+		reason := err.Error()[i+len(prefix):]
+		return &RevertError{Reason: reason, Err: revertReasons[reason], cause: err}
+	}
+	return err
+}
@@ -0,0 +1,94 @@
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// dataError is a JSON-RPC error carrying revert data, as returned by eth_call
+// and eth_estimateGas.
+type dataError struct {
+	data string
+}
+
+func (e *dataError) Error() string          { return "execution reverted" }
+func (e *dataError) ErrorData() interface{} { return e.data }
+
+func encodeRevert(t *testing.T, signature string, args ...interface{}) []byte {
+	t.Helper()
+	var inputs abi.Arguments
+	for _, arg := range args {
+		typ := "string"
+		if _, ok := arg.(*big.Int); ok {
+			typ = "uint256"
+		}
+		ty, err := abi.NewType(typ, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs = append(inputs, abi.Argument{Type: ty})
+	}
+	packed, err := inputs.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(crypto.Keccak256([]byte(signature))[:4], packed...)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	data := encodeRevert(t, "Error(string)", "FeeDisburser: Disbursement interval not reached")
+	err := DecodeRevert(&dataError{data: hexutil.Encode(data)})
+	if !errors.Is(err, ErrFeeDisburserIntervalNotReached) {
+		t.Fatalf("expected ErrFeeDisburserIntervalNotReached, got %v", err)
+	}
+	var cause *dataError
+	if !errors.As(err, &cause) {
+		t.Fatal("expected the JSON-RPC error to be wrapped")
+	}
+	if err.Error() != "execution reverted: FeeDisburser: Disbursement interval not reached" {
+		t.Fatalf("unexpected message %q", err)
+	}
+
+	parsed, err := SmartEscrowMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	abiErr := parsed.Errors["StartTimeAfterEndTime"]
+	args, err := abiErr.Inputs.Pack(big.NewInt(200), big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = DecodeRevert(&dataError{data: hexutil.Encode(append(abiErr.ID[:4:4], args...))})
+	var startAfterEnd *SmartEscrowStartTimeAfterEndTimeError
+	if !errors.As(err, &startAfterEnd) || startAfterEnd.StartTimestamp.Int64() != 200 || startAfterEnd.EndTimestamp.Int64() != 100 {
+		t.Fatalf("expected StartTimeAfterEndTime(200, 100), got %v", err)
+	}
+
+	selector := crypto.Keccak256([]byte("SenderIsNotWhitelistedSigner()"))[:4]
+	var notWhitelisted *Vetoer1of2SenderIsNotWhitelistedSignerError
+	if err := DecodeRevert(&dataError{data: hexutil.Encode(selector)}); !errors.As(err, &notWhitelisted) {
+		t.Fatalf("expected SenderIsNotWhitelistedSigner, got %v", err)
+	}
+
+	var revert *RevertError
+	err = DecodeRevert(&dataError{data: hexutil.Encode(encodeRevert(t, "Panic(uint256)", big.NewInt(0x11)))})
+	if !errors.As(err, &revert) || revert.Err != nil || revert.Reason == "" {
+		t.Fatalf("expected an arithmetic panic, got %v", err)
+	}
+
+	// Nodes returning no revert data still report the reason in the message.
+	err = DecodeRevert(errors.New("execution reverted: BalanceTracker: systemAddresses cannot have a length of zero"))
+	if !errors.Is(err, ErrBalanceTrackerNoSystemAddresses) {
+		t.Fatalf("expected ErrBalanceTrackerNoSystemAddresses, got %v", err)
+	}
+
+	other := errors.New("connection refused")
+	if err := DecodeRevert(other); err != other {
+		t.Fatalf("expected non-revert errors to be returned unchanged, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package revshare
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+// Values of FeeVault.WithdrawalNetwork.
+const (
+	WithdrawalNetworkL1 uint8 = 0
+	WithdrawalNetworkL2 uint8 = 1
+)
+
+// Violation is a fee vault configuration making
+// FeeDisburser.feeVaultWithdrawal, and so disburseFees, revert.
+type Violation struct {
+	// Vault is the address of the misconfigured fee vault.
+	Vault common.Address
+	// Err is the revert reason of feeVaultWithdrawal, either
+	// bindings.ErrFeeDisburserVaultNotL2 or
+	// bindings.ErrFeeDisburserVaultWrongRecipient.
+	Err error
+	// Got is the configured WITHDRAWAL_NETWORK or RECIPIENT.
+	Got string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("fee vault %s: %v, got %s", v.Vault, v.Err, v.Got)
+}
+
+func (v *Violation) Unwrap() error {
+	return v.Err
+}
+
+// Audit checks that every fee vault withdrawn by the FeeDisburser withdraws
+// to L2 and to the FeeDisburser, the requirements of feeVaultWithdrawal. It
+// returns every violation found rather than stopping at the first one.
+func (r *Reader) Audit(opts *bind.CallOpts) ([]*Violation, error) {
+	var violations []*Violation
+	for i, vault := range r.feeVaults {
+		address := predeploys.FeeVaults[i]
+		network, err := vault.WITHDRAWALNETWORK(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch WITHDRAWAL_NETWORK of fee vault %s: %w", address, err)
+		}
+		if network != WithdrawalNetworkL2 {
+			violations = append(violations, &Violation{
+				Vault: address,
+				Err:   bindings.ErrFeeDisburserVaultNotL2,
+				Got:   withdrawalNetworkName(network),
+			})
+		}
+		recipient, err := vault.RECIPIENT(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch RECIPIENT of fee vault %s: %w", address, err)
+		}
+		if recipient != r.feeDisburser {
+			violations = append(violations, &Violation{
+				Vault: address,
+				Err:   bindings.ErrFeeDisburserVaultWrongRecipient,
+				Got:   recipient.Hex(),
+			})
+		}
+	}
+	return violations, nil
+}
+
+func withdrawalNetworkName(network uint8) string {
+	switch network {
+	case WithdrawalNetworkL1:
+		return "L1"
+	case WithdrawalNetworkL2:
+		return "L2"
+	}
+	return fmt.Sprintf("unknown network %d", network)
+}
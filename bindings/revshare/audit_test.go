@@ -0,0 +1,93 @@
+package revshare
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+// vaultConfig is the configuration of a fake fee vault.
+type vaultConfig struct {
+	network   uint8
+	recipient common.Address
+}
+
+// fakeVaults answers the FeeVault getters of the predeploy vaults.
+type fakeVaults struct {
+	vaults map[common.Address]vaultConfig
+}
+
+func (f *fakeVaults) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (f *fakeVaults) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	parsed, err := bindings.FeeVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	vault, ok := f.vaults[*call.To]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+	switch method.Name {
+	case "WITHDRAWAL_NETWORK":
+		return method.Outputs.Pack(vault.network)
+	case "RECIPIENT":
+		return method.Outputs.Pack(vault.recipient)
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func (f *fakeVaults) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return new(big.Int), nil
+}
+
+func TestAudit(t *testing.T) {
+	feeDisburser := common.HexToAddress("0xfd")
+	backend := &fakeVaults{vaults: map[common.Address]vaultConfig{
+		predeploys.SequencerFeeVault: {WithdrawalNetworkL2, feeDisburser},
+		predeploys.BaseFeeVault:      {WithdrawalNetworkL1, feeDisburser},
+		predeploys.L1FeeVault:        {WithdrawalNetworkL1, common.HexToAddress("0xbad")},
+	}}
+	reader, err := NewReader(feeDisburser, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	violations, err := reader.Audit(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		vault common.Address
+		err   error
+	}{
+		{predeploys.BaseFeeVault, bindings.ErrFeeDisburserVaultNotL2},
+		{predeploys.L1FeeVault, bindings.ErrFeeDisburserVaultNotL2},
+		{predeploys.L1FeeVault, bindings.ErrFeeDisburserVaultWrongRecipient},
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("got %d violations, want %d: %v", len(violations), len(want), violations)
+	}
+	for i, w := range want {
+		if violations[i].Vault != w.vault || !errors.Is(violations[i], w.err) {
+			t.Fatalf("violation %d: got %v, want %v of %s", i, violations[i], w.err, w.vault)
+		}
+	}
+
+	delete(backend.vaults, predeploys.L1FeeVault)
+	if _, err := reader.Audit(nil); err == nil {
+		t.Fatal("expected an error for a vault failing to answer")
+	}
+}
@@ -0,0 +1,121 @@
+package revshare
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+// Backend is the chain access needed to read the revenue share state.
+type Backend interface {
+	bind.ContractCaller
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// Reader reads the revenue share parameters and state of a FeeDisburser.
+type Reader struct {
+	feeDisburser common.Address
+	backend      Backend
+	contract     *bindings.FeeDisburserCaller
+	feeVaults    []*bindings.FeeVaultCaller
+}
+
+// NewReader creates a Reader for the FeeDisburser at the given address.
+func NewReader(feeDisburser common.Address, backend Backend) (*Reader, error) {
+	contract, err := bindings.NewFeeDisburserCaller(feeDisburser, backend)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reader{
+		feeDisburser: feeDisburser,
+		backend:      backend,
+		contract:     contract,
+	}
+	for _, vault := range predeploys.FeeVaults {
+		caller, err := bindings.NewFeeVaultCaller(vault, backend)
+		if err != nil {
+			return nil, err
+		}
+		r.feeVaults = append(r.feeVaults, caller)
+	}
+	return r, nil
+}
+
+// Params reads the revenue share constants.
+func (r *Reader) Params(opts *bind.CallOpts) (Params, error) {
+	net, err := r.contract.OPTIMISMNETREVENUESHAREBASISPOINTS(opts)
+	if err != nil {
+		return Params{}, fmt.Errorf("failed to fetch OPTIMISM_NET_REVENUE_SHARE_BASIS_POINTS: %w", err)
+	}
+	gross, err := r.contract.OPTIMISMGROSSREVENUESHAREBASISPOINTS(opts)
+	if err != nil {
+		return Params{}, fmt.Errorf("failed to fetch OPTIMISM_GROSS_REVENUE_SHARE_BASIS_POINTS: %w", err)
+	}
+	scale, err := r.contract.BASISPOINTSCALE(opts)
+	if err != nil {
+		return Params{}, fmt.Errorf("failed to fetch BASIS_POINT_SCALE: %w", err)
+	}
+	return Params{
+		NetShareBasisPoints:   net,
+		GrossShareBasisPoints: gross,
+		BasisPointScale:       new(big.Int).SetUint64(uint64(scale)),
+	}, nil
+}
+
+// State reads the FeeDisburser and fee vault state at opts.BlockNumber.
+func (r *Reader) State(opts *bind.CallOpts) (State, error) {
+	if opts == nil {
+		opts = new(bind.CallOpts)
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	netFeeRevenue, err := r.contract.NetFeeRevenue(opts)
+	if err != nil {
+		return State{}, fmt.Errorf("failed to fetch netFeeRevenue: %w", err)
+	}
+	balance, err := r.backend.BalanceAt(ctx, r.feeDisburser, opts.BlockNumber)
+	if err != nil {
+		return State{}, fmt.Errorf("failed to fetch FeeDisburser balance: %w", err)
+	}
+	state := State{NetFeeRevenue: netFeeRevenue, Balance: balance}
+	for i, vault := range r.feeVaults {
+		address := predeploys.FeeVaults[i]
+		balance, err := r.backend.BalanceAt(ctx, address, opts.BlockNumber)
+		if err != nil {
+			return State{}, fmt.Errorf("failed to fetch balance of fee vault %s: %w", address, err)
+		}
+		minWithdrawalAmount, err := vault.MINWITHDRAWALAMOUNT(opts)
+		if err != nil {
+			return State{}, fmt.Errorf("failed to fetch MIN_WITHDRAWAL_AMOUNT of fee vault %s: %w", address, err)
+		}
+		state.Vaults = append(state.Vaults, Vault{
+			Address:             address,
+			Balance:             balance,
+			MinWithdrawalAmount: minWithdrawalAmount,
+			Net:                 address != predeploys.L1FeeVault,
+		})
+	}
+	return state, nil
+}
+
+// Predict reads the current parameters and state and returns what a
+// disburseFees call would do.
+func (r *Reader) Predict(opts *bind.CallOpts) (*Prediction, error) {
+	params, err := r.Params(opts)
+	if err != nil {
+		return nil, err
+	}
+	state, err := r.State(opts)
+	if err != nil {
+		return nil, err
+	}
+	return params.Predict(state)
+}
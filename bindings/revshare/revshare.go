@@ -0,0 +1,158 @@
+// Package revshare reproduces the revenue share accounting of
+// FeeDisburser.disburseFees, using the same uint256 integer math as the
+// contract so that its results match the chain to the wei.
+package revshare
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+var (
+	// ErrOverflow is returned when an intermediate value exceeds uint256, in
+	// which case disburseFees reverts.
+	ErrOverflow = errors.New("revshare: uint256 overflow")
+	// ErrZeroScale is returned for a zero basis point scale, in which case
+	// disburseFees reverts.
+	ErrZeroScale = errors.New("revshare: basis point scale is zero")
+	// ErrShareExceedsBalance is returned when the Optimism share is larger than
+	// the fee balance, in which case sending it fails and disburseFees reverts.
+	ErrShareExceedsBalance = errors.New("revshare: Optimism share exceeds fee balance")
+)
+
+// Params are the revenue share constants of a FeeDisburser.
+type Params struct {
+	// NetShareBasisPoints is OPTIMISM_NET_REVENUE_SHARE_BASIS_POINTS.
+	NetShareBasisPoints *big.Int `json:"netShareBasisPoints"`
+	// GrossShareBasisPoints is OPTIMISM_GROSS_REVENUE_SHARE_BASIS_POINTS.
+	GrossShareBasisPoints *big.Int `json:"grossShareBasisPoints"`
+	// BasisPointScale is BASIS_POINT_SCALE.
+	BasisPointScale *big.Int `json:"basisPointScale"`
+}
+
+// Split is how a disbursement divides the fee balance between Optimism and
+// the L1 wallet.
+type Split struct {
+	// NetRevenue is the net fee revenue, the fees withdrawn from the sequencer
+	// and base fee vaults.
+	NetRevenue *big.Int
+	// GrossRevenue is the fee balance, all fees held by the FeeDisburser.
+	GrossRevenue *big.Int
+	// NetShare is the share of the net revenue owed to Optimism.
+	NetShare *big.Int
+	// GrossShare is the share of the gross revenue owed to Optimism.
+	GrossShare *big.Int
+	// OptimismShare is the larger of NetShare and GrossShare, paid to the
+	// Optimism wallet on L2.
+	OptimismShare *big.Int
+	// L1Share is the remainder bridged to the L1 wallet.
+	L1Share *big.Int
+}
+
+// Split divides the fee balance the way disburseFees does: Optimism receives
+// the maximum of its net and gross revenue share, each computed by
+// multiplying before dividing, and the rest is bridged to L1.
+func (p Params) Split(netRevenue, grossRevenue *big.Int) (*Split, error) {
+	if p.BasisPointScale.Sign() == 0 {
+		return nil, ErrZeroScale
+	}
+	netShare, err := share(netRevenue, p.NetShareBasisPoints, p.BasisPointScale)
+	if err != nil {
+		return nil, err
+	}
+	grossShare, err := share(grossRevenue, p.GrossShareBasisPoints, p.BasisPointScale)
+	if err != nil {
+		return nil, err
+	}
+	optimismShare := netShare
+	if grossShare.Cmp(netShare) > 0 {
+		optimismShare = grossShare
+	}
+	if optimismShare.Cmp(grossRevenue) > 0 {
+		return nil, ErrShareExceedsBalance
+	}
+	return &Split{
+		NetRevenue:    new(big.Int).Set(netRevenue),
+		GrossRevenue:  new(big.Int).Set(grossRevenue),
+		NetShare:      netShare,
+		GrossShare:    grossShare,
+		OptimismShare: new(big.Int).Set(optimismShare),
+		L1Share:       new(big.Int).Sub(grossRevenue, optimismShare),
+	}, nil
+}
+
+// share computes amount * basisPoints / scale with checked uint256 math.
+func share(amount, basisPoints, scale *big.Int) (*big.Int, error) {
+	product := new(big.Int).Mul(amount, basisPoints)
+	if product.Cmp(math.MaxBig256) > 0 {
+		return nil, ErrOverflow
+	}
+	return product.Div(product, scale), nil
+}
+
+// Vault is the state of a fee vault withdrawn by disburseFees.
+type Vault struct {
+	Address common.Address
+	// Balance is the balance of the vault.
+	Balance *big.Int
+	// MinWithdrawalAmount is MIN_WITHDRAWAL_AMOUNT of the vault.
+	MinWithdrawalAmount *big.Int
+	// Net is set for the sequencer and base fee vaults, whose withdrawals are
+	// counted as net fee revenue.
+	Net bool
+}
+
+// State is the state of a FeeDisburser and its fee vaults.
+type State struct {
+	// NetFeeRevenue is netFeeRevenue of the FeeDisburser.
+	NetFeeRevenue *big.Int
+	// Balance is the balance of the FeeDisburser.
+	Balance *big.Int
+	// Vaults are the fee vaults, in the order they are withdrawn.
+	Vaults []Vault
+}
+
+// Prediction is the outcome of a disburseFees call.
+type Prediction struct {
+	// Withdrawn lists the vaults holding at least their minimum withdrawal
+	// amount, which are emptied into the FeeDisburser.
+	Withdrawn []common.Address
+	// Split is the resulting disbursement, nil if no fees were collected and
+	// NoFeesCollected is emitted instead.
+	Split *Split
+}
+
+// Predict returns what disburseFees would do if called in the given state.
+// Every vault holding at least its minimum withdrawal amount sends its whole
+// balance to the FeeDisburser; only withdrawals from the net vaults add to
+// the net fee revenue.
+func (p Params) Predict(s State) (*Prediction, error) {
+	net := new(big.Int).Set(s.NetFeeRevenue)
+	gross := new(big.Int).Set(s.Balance)
+	prediction := new(Prediction)
+	for _, vault := range s.Vaults {
+		if vault.Balance.Cmp(vault.MinWithdrawalAmount) < 0 {
+			continue
+		}
+		prediction.Withdrawn = append(prediction.Withdrawn, vault.Address)
+		gross.Add(gross, vault.Balance)
+		if vault.Net {
+			net.Add(net, vault.Balance)
+		}
+	}
+	if gross.Sign() == 0 {
+		return prediction, nil
+	}
+	if net.Cmp(math.MaxBig256) > 0 || gross.Cmp(math.MaxBig256) > 0 {
+		return nil, ErrOverflow
+	}
+	split, err := p.Split(net, gross)
+	if err != nil {
+		return nil, err
+	}
+	prediction.Split = split
+	return prediction, nil
+}
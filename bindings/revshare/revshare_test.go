@@ -0,0 +1,199 @@
+package revshare
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+// feeDisburserParams are the constants FeeDisburser is deployed with.
+var feeDisburserParams = Params{
+	NetShareBasisPoints:   big.NewInt(1_500),
+	GrossShareBasisPoints: big.NewInt(250),
+	BasisPointScale:       big.NewInt(10_000),
+}
+
+// evmSplit transcribes the disburseFees arithmetic with EVM uint256
+// semantics: checked multiplication followed by truncating division.
+func evmSplit(p Params, net, gross *big.Int) (optimismShare, l1Share *big.Int, reverted bool) {
+	n, _ := uint256.FromBig(net)
+	g, _ := uint256.FromBig(gross)
+	netBP, _ := uint256.FromBig(p.NetShareBasisPoints)
+	grossBP, _ := uint256.FromBig(p.GrossShareBasisPoints)
+	scale, _ := uint256.FromBig(p.BasisPointScale)
+	if scale.IsZero() {
+		return nil, nil, true
+	}
+
+	netShare, overflow := new(uint256.Int).MulOverflow(n, netBP)
+	if overflow {
+		return nil, nil, true
+	}
+	netShare.Div(netShare, scale)
+	grossShare, overflow := new(uint256.Int).MulOverflow(g, grossBP)
+	if overflow {
+		return nil, nil, true
+	}
+	grossShare.Div(grossShare, scale)
+
+	share := netShare
+	if grossShare.Gt(netShare) {
+		share = grossShare
+	}
+	if share.Gt(g) {
+		return nil, nil, true
+	}
+	return share.ToBig(), new(uint256.Int).Sub(g, share).ToBig(), false
+}
+
+// randomAmount returns a value of random bit length up to 256 bits, so that
+// both small amounts and amounts close to overflowing are covered.
+func randomAmount(r *rand.Rand) *big.Int {
+	bits := r.Intn(257)
+	if bits == 0 {
+		return new(big.Int)
+	}
+	v := new(big.Int).Rand(r, new(big.Int).Lsh(common.Big1, uint(bits)))
+	return v.SetBit(v, bits-1, 1)
+}
+
+func TestSplitMatchesEVM(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20_000; i++ {
+		p := feeDisburserParams
+		if i%2 == 1 {
+			scale := big.NewInt(r.Int63n(1_000_000) + 1)
+			p = Params{
+				NetShareBasisPoints:   new(big.Int).Rand(r, new(big.Int).Add(scale, common.Big1)),
+				GrossShareBasisPoints: new(big.Int).Rand(r, new(big.Int).Add(scale, common.Big1)),
+				BasisPointScale:       scale,
+			}
+		}
+		gross := randomAmount(r)
+		net := new(big.Int).Rand(r, new(big.Int).Add(gross, common.Big1))
+
+		wantOptimism, wantL1, reverted := evmSplit(p, net, gross)
+		split, err := p.Split(net, gross)
+		if reverted {
+			if !errors.Is(err, ErrOverflow) {
+				t.Fatalf("Split(%v, %v) with %+v: expected ErrOverflow, got %v", net, gross, p, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Split(%v, %v) with %+v: %v", net, gross, p, err)
+		}
+		if split.OptimismShare.Cmp(wantOptimism) != 0 || split.L1Share.Cmp(wantL1) != 0 {
+			t.Fatalf("Split(%v, %v) with %+v = (%v, %v), want (%v, %v)", net, gross, p, split.OptimismShare, split.L1Share, wantOptimism, wantL1)
+		}
+		if sum := new(big.Int).Add(split.OptimismShare, split.L1Share); sum.Cmp(gross) != 0 {
+			t.Fatalf("shares add up to %v, want %v", sum, gross)
+		}
+	}
+}
+
+func TestSplitErrors(t *testing.T) {
+	zeroScale := Params{NetShareBasisPoints: common.Big1, GrossShareBasisPoints: common.Big1, BasisPointScale: new(big.Int)}
+	if _, err := zeroScale.Split(common.Big1, common.Big1); !errors.Is(err, ErrZeroScale) {
+		t.Fatalf("expected ErrZeroScale, got %v", err)
+	}
+	if _, err := feeDisburserParams.Split(big.NewInt(1_000_000), big.NewInt(100)); !errors.Is(err, ErrShareExceedsBalance) {
+		t.Fatalf("expected ErrShareExceedsBalance, got %v", err)
+	}
+}
+
+// TestPredict mirrors test_disburseFees_success_netRevenueMax and
+// test_disburseFees_success_grossRevenueMax of FeeDisburser.t.sol.
+func TestPredict(t *testing.T) {
+	minimum := new(big.Int).Mul(big.NewInt(10), big.NewInt(params.Ether))
+	times := func(n int64) *big.Int { return new(big.Int).Mul(minimum, big.NewInt(n)) }
+	vaults := func(sequencer, base, l1 *big.Int) []Vault {
+		return []Vault{
+			{Address: predeploys.SequencerFeeVault, Balance: sequencer, MinWithdrawalAmount: minimum, Net: true},
+			{Address: predeploys.BaseFeeVault, Balance: base, MinWithdrawalAmount: minimum, Net: true},
+			{Address: predeploys.L1FeeVault, Balance: l1, MinWithdrawalAmount: minimum},
+		}
+	}
+	share := func(amount *big.Int, bp int64) *big.Int {
+		v := new(big.Int).Mul(amount, big.NewInt(bp))
+		return v.Div(v, big.NewInt(10_000))
+	}
+
+	tests := []struct {
+		name      string
+		state     State
+		withdrawn int
+		optimism  *big.Int
+		total     *big.Int
+	}{
+		{
+			name:      "net revenue max",
+			state:     State{NetFeeRevenue: new(big.Int), Balance: new(big.Int), Vaults: vaults(minimum, minimum, times(9))},
+			withdrawn: 3,
+			optimism:  share(times(2), 1_500),
+			total:     times(11),
+		},
+		{
+			name:      "gross revenue max",
+			state:     State{NetFeeRevenue: new(big.Int), Balance: new(big.Int), Vaults: vaults(minimum, minimum, times(11))},
+			withdrawn: 3,
+			optimism:  share(times(13), 250),
+			total:     times(13),
+		},
+		{
+			name:      "vault below minimum",
+			state:     State{NetFeeRevenue: new(big.Int), Balance: new(big.Int), Vaults: vaults(times(4), new(big.Int).Sub(minimum, common.Big1), new(big.Int))},
+			withdrawn: 1,
+			optimism:  share(times(4), 1_500),
+			total:     times(4),
+		},
+		{
+			name:      "fees already received",
+			state:     State{NetFeeRevenue: times(2), Balance: times(3), Vaults: vaults(new(big.Int), new(big.Int), new(big.Int))},
+			withdrawn: 0,
+			optimism:  share(times(2), 1_500),
+			total:     times(3),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prediction, err := feeDisburserParams.Predict(tt.state)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(prediction.Withdrawn) != tt.withdrawn {
+				t.Fatalf("withdrew %d vaults, want %d", len(prediction.Withdrawn), tt.withdrawn)
+			}
+			if prediction.Split.OptimismShare.Cmp(tt.optimism) != 0 {
+				t.Fatalf("Optimism share = %v, want %v", prediction.Split.OptimismShare, tt.optimism)
+			}
+			if prediction.Split.GrossRevenue.Cmp(tt.total) != 0 {
+				t.Fatalf("total = %v, want %v", prediction.Split.GrossRevenue, tt.total)
+			}
+			if l1 := new(big.Int).Sub(tt.total, tt.optimism); prediction.Split.L1Share.Cmp(l1) != 0 {
+				t.Fatalf("L1 share = %v, want %v", prediction.Split.L1Share, l1)
+			}
+		})
+	}
+}
+
+func TestPredictNoFees(t *testing.T) {
+	prediction, err := feeDisburserParams.Predict(State{
+		NetFeeRevenue: new(big.Int),
+		Balance:       new(big.Int),
+		Vaults:        []Vault{{Address: predeploys.SequencerFeeVault, Balance: common.Big1, MinWithdrawalAmount: common.Big2, Net: true}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prediction.Split != nil || len(prediction.Withdrawn) != 0 {
+		t.Fatalf("expected no fees to be collected, got %+v", prediction)
+	}
+}
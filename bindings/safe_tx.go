@@ -0,0 +1,83 @@
+package bindings
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Operations of a Safe transaction, Enum.Operation in Solidity.
+const (
+	SafeOperationCall         uint8 = 0
+	SafeOperationDelegateCall uint8 = 1
+)
+
+var (
+	// safeDomainSeparatorTypeHash is DOMAIN_SEPARATOR_TYPEHASH of Safe v1.3.0
+	// and later, whose domain includes the chain ID.
+	safeDomainSeparatorTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(uint256 chainId,address verifyingContract)"))
+	// safeTxTypeHash is SAFE_TX_TYPEHASH.
+	safeTxTypeHash = crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation,uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+)
+
+// SafeTx is a Safe transaction, the arguments of execTransaction besides the
+// signatures. Nil amounts are zero.
+type SafeTx struct {
+	To             common.Address
+	Value          *big.Int
+	Data           []byte
+	Operation      uint8
+	SafeTxGas      *big.Int
+	BaseGas        *big.Int
+	GasPrice       *big.Int
+	GasToken       common.Address
+	RefundReceiver common.Address
+	Nonce          *big.Int
+}
+
+// SafeDomainSeparator returns the EIP-712 domain separator of the Safe at
+// address on the chain, as returned by domainSeparator.
+func SafeDomainSeparator(chainID *big.Int, safe common.Address) common.Hash {
+	return crypto.Keccak256Hash(safeDomainSeparatorTypeHash.Bytes(), word(chainID), common.LeftPadBytes(safe.Bytes(), 32))
+}
+
+// StructHash returns the EIP-712 hash of the SafeTx struct.
+func (tx *SafeTx) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		safeTxTypeHash.Bytes(),
+		common.LeftPadBytes(tx.To.Bytes(), 32),
+		word(tx.Value),
+		crypto.Keccak256(tx.Data),
+		word(new(big.Int).SetUint64(uint64(tx.Operation))),
+		word(tx.SafeTxGas),
+		word(tx.BaseGas),
+		word(tx.GasPrice),
+		common.LeftPadBytes(tx.GasToken.Bytes(), 32),
+		common.LeftPadBytes(tx.RefundReceiver.Bytes(), 32),
+		word(tx.Nonce),
+	)
+}
+
+// EncodeTransactionData returns the data signed by the Safe owners, as returned
+// by encodeTransactionData of the Safe at address on the chain.
+func (tx *SafeTx) EncodeTransactionData(chainID *big.Int, safe common.Address) []byte {
+	data := make([]byte, 0, 66)
+	data = append(data, 0x19, 0x01)
+	data = append(data, SafeDomainSeparator(chainID, safe).Bytes()...)
+	return append(data, tx.StructHash().Bytes()...)
+}
+
+// Hash returns the hash the Safe owners sign, as returned by getTransactionHash
+// of the Safe at address on the chain.
+func (tx *SafeTx) Hash(chainID *big.Int, safe common.Address) common.Hash {
+	return crypto.Keccak256Hash(tx.EncodeTransactionData(chainID, safe))
+}
+
+// word encodes a uint256, nil being zero.
+func word(v *big.Int) []byte {
+	if v == nil {
+		return make([]byte, 32)
+	}
+	return common.BigToHash(v).Bytes()
+}
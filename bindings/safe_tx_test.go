@@ -0,0 +1,69 @@
+package bindings
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestSafeTypeHashes(t *testing.T) {
+	// DOMAIN_SEPARATOR_TYPEHASH and SAFE_TX_TYPEHASH of GnosisSafe.sol.
+	if want := common.HexToHash("0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218"); safeDomainSeparatorTypeHash != want {
+		t.Fatalf("got domain separator type hash %s, want %s", safeDomainSeparatorTypeHash, want)
+	}
+	if want := common.HexToHash("0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8"); safeTxTypeHash != want {
+		t.Fatalf("got SafeTx type hash %s, want %s", safeTxTypeHash, want)
+	}
+}
+
+// TestSafeTxHash checks the vector of test/universal/MultisigBuilder.t.sol: a
+// Multicall3 delegatecall incrementing a counter, from the Safe at address
+// 1001 on chain 31337.
+func TestSafeTxHash(t *testing.T) {
+	multicall, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"aggregate3","stateMutability":"payable","inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],"outputs":[]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	type call3 struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	}
+	data, err := multicall.Pack("aggregate3", []call3{{
+		Target:   common.HexToAddress("0x5615dEB798BB3E4dFa0139dFa1b3D433Cc23b72f"),
+		CallData: crypto.Keccak256([]byte("increment()"))[:4],
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := &SafeTx{
+		To:        common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
+		Data:      data,
+		Operation: SafeOperationDelegateCall,
+		Nonce:     new(big.Int),
+	}
+	chainID, safe := big.NewInt(31337), common.BigToAddress(big.NewInt(1001))
+	want := hexutil.MustDecode("0x1901d4bb33110137810c444c1d9617abe97df097d587ecde64e6fcb38d7f49e1280c41dcff2c17a271265df60d1612a7387110475b6fc5178add5518196db5dba6bd")
+	if got := tx.EncodeTransactionData(chainID, safe); !bytes.Equal(got, want) {
+		t.Fatalf("got transaction data %x, want %x", got, want)
+	}
+	if got := SafeDomainSeparator(chainID, safe); !bytes.Equal(got.Bytes(), want[2:34]) {
+		t.Fatalf("got domain separator %s, want %x", got, want[2:34])
+	}
+	if got := tx.Hash(chainID, safe); got != crypto.Keccak256Hash(want) {
+		t.Fatalf("got hash %s, want %s", got, crypto.Keccak256Hash(want))
+	}
+
+	// The hash commits to the nonce.
+	tx.Nonce = big.NewInt(1)
+	if tx.Hash(chainID, safe) == crypto.Keccak256Hash(want) {
+		t.Fatal("expected the hash to change with the nonce")
+	}
+}
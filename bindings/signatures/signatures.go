@@ -0,0 +1,285 @@
+// Package signatures is the Go counterpart of script/universal/Signatures.sol.
+// It turns the signatures collected from the owners of a Safe into the
+// signatures argument of execTransaction: signatures are merged, their owners
+// recovered, duplicates and non-owners left out, the approvals made onchain
+// with approveHash prepended and the result sorted by owner.
+package signatures
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+// Length is the length of the static part of a signature.
+const Length = 65
+
+// ErrNotEnoughSignatures is returned when the signatures and approvals of
+// fewer owners than the threshold of the Safe were provided.
+var ErrNotEnoughSignatures = errors.New("signatures: not enough signatures")
+
+// Split returns the v, r and s of the signature at pos, like signatureSplit.
+func Split(signatures []byte, pos int) (v uint8, r, s common.Hash) {
+	sig := signatures[pos*Length : (pos+1)*Length]
+	return sig[64], common.BytesToHash(sig[:32]), common.BytesToHash(sig[32:64])
+}
+
+func join(v uint8, r, s common.Hash) []byte {
+	sig := make([]byte, 0, Length)
+	sig = append(sig, r.Bytes()...)
+	sig = append(sig, s.Bytes()...)
+	return append(sig, v)
+}
+
+// ExtractOwner returns the owner having produced the signature of the hash.
+// v is 0 for contract signatures and 1 for approved hashes, where r holds
+// the owner, and is increased by 4 for eth_sign signatures of the hash.
+func ExtractOwner(hash common.Hash, v uint8, r, s common.Hash) (common.Address, error) {
+	if v <= 1 {
+		return common.BytesToAddress(r.Bytes()), nil
+	}
+	if v > 30 {
+		hash = crypto.Keccak256Hash([]byte("\x19Ethereum Signed Message:\n32"), hash.Bytes())
+		v -= 4
+	}
+	if v != 27 && v != 28 {
+		return common.Address{}, fmt.Errorf("signatures: invalid v %d", v)
+	}
+	pub, err := crypto.SigToPub(hash.Bytes(), join(v-27, r, s))
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// GenPrevalidatedSignature returns the signature of an owner calling
+// execTransaction itself or having approved the hash.
+func GenPrevalidatedSignature(owner common.Address) []byte {
+	return join(1, common.BytesToHash(owner.Bytes()), common.Hash{})
+}
+
+// GenPrevalidatedSignatures returns the prevalidated signatures of the
+// owners, sorted by owner.
+func GenPrevalidatedSignatures(owners []common.Address) []byte {
+	sorted := append([]common.Address{}, owners...)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i].Bytes(), sorted[j].Bytes()) < 0 })
+	var signatures []byte
+	for _, owner := range sorted {
+		signatures = append(signatures, GenPrevalidatedSignature(owner)...)
+	}
+	return signatures
+}
+
+// staticLength returns the length of the static part of signatures, which
+// ends where the data of the first contract signature starts.
+func staticLength(signatures []byte) int {
+	end := len(signatures) - len(signatures)%Length
+	for pos := 0; (pos+1)*Length <= end; pos++ {
+		if v, _, s := Split(signatures, pos); v == 0 && s.Big().IsInt64() && int(s.Big().Int64()) < end {
+			end = int(s.Big().Int64())
+		}
+	}
+	return end - end%Length
+}
+
+// Merge concatenates the signatures collected from several signers. The data
+// of contract signatures is moved after the static parts of all of them, and
+// their offsets updated.
+func Merge(blobs ...[]byte) []byte {
+	var static, dynamic []byte
+	total := 0
+	for _, blob := range blobs {
+		total += staticLength(blob)
+	}
+	for _, blob := range blobs {
+		n := staticLength(blob)
+		for pos := 0; pos < n/Length; pos++ {
+			v, r, s := Split(blob, pos)
+			if v == 0 {
+				s = common.BigToHash(new(big.Int).Add(s.Big(), big.NewInt(int64(total+len(dynamic)-n))))
+			}
+			static = append(static, join(v, r, s)...)
+		}
+		dynamic = append(dynamic, blob[n:]...)
+	}
+	return append(static, dynamic...)
+}
+
+// Report tells how close signatures are to executing a Safe transaction.
+type Report struct {
+	// Threshold is the threshold of the Safe.
+	Threshold uint64
+	// Signers are the owners whose signature or approval was found, in
+	// the order of the signatures.
+	Signers []common.Address
+	// Approvers are the signers having approved the hash onchain.
+	Approvers []common.Address
+	// NonOwners are the signers of signatures left out as they aren't owners,
+	// the zero address for invalid signatures.
+	NonOwners []common.Address
+	// Signatures are the signatures to pass to execTransaction, sorted by
+	// owner. Only set if the threshold is reached.
+	Signatures []byte
+}
+
+// Missing returns the number of signatures still needed.
+func (r *Report) Missing() uint64 {
+	if n := uint64(len(r.Signers)); n < r.Threshold {
+		return r.Threshold - n
+	}
+	return 0
+}
+
+// Complete reports whether the threshold is reached.
+func (r *Report) Complete() bool {
+	return r.Missing() == 0
+}
+
+// Prepare prepends the prevalidated signatures of the owners having approved
+// the hash onchain to the signatures and sorts them, like prepareSignatures.
+// The report is returned whether the threshold is reached or not.
+func Prepare(ctx context.Context, backend bind.ContractCaller, safe common.Address, hash common.Hash, signatures []byte) (*Report, error) {
+	caller, err := bindings.NewIGnosisSafeCaller(safe, backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	threshold, err := caller.GetThreshold(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch threshold of Safe %s: %w", safe, err)
+	}
+	owners, err := caller.GetOwners(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch owners of Safe %s: %w", safe, err)
+	}
+	report := &Report{Threshold: threshold.Uint64()}
+	if report.Approvers, err = approvers(ctx, caller, owners, hash, report.Threshold); err != nil {
+		return nil, err
+	}
+	prevalidated := GenPrevalidatedSignatures(report.Approvers)
+	count := (len(prevalidated) + staticLength(signatures)) / Length
+	signatures = append(prevalidated, signatures...)
+
+	isOwner := make(map[common.Address]bool, len(owners))
+	for _, owner := range owners {
+		isOwner[owner] = true
+	}
+	seen := make(map[common.Address]bool)
+	// indexes of the signatures of the first threshold owners.
+	var indexes []int
+	for i := 0; i < count; i++ {
+		v, r, s := Split(signatures, i)
+		// Like ecrecover, invalid signatures recover to the zero address.
+		owner, _ := ExtractOwner(hash, v, r, s)
+		if !isOwner[owner] {
+			report.NonOwners = append(report.NonOwners, owner)
+			continue
+		}
+		if seen[owner] {
+			continue
+		}
+		seen[owner] = true
+		report.Signers = append(report.Signers, owner)
+		if uint64(len(indexes)) < report.Threshold {
+			indexes = append(indexes, i)
+		}
+	}
+	if !report.Complete() {
+		return report, nil
+	}
+	report.Signatures = sortUniqueSignatures(signatures, hash, indexes, len(prevalidated))
+	return report, nil
+}
+
+// PrepareSignatures is Prepare returning the sorted signatures, or
+// ErrNotEnoughSignatures if the threshold isn't reached.
+func PrepareSignatures(ctx context.Context, backend bind.ContractCaller, safe common.Address, hash common.Hash, signatures []byte) ([]byte, error) {
+	report, err := Prepare(ctx, backend, safe, hash, signatures)
+	if err != nil {
+		return nil, err
+	}
+	if !report.Complete() {
+		return nil, fmt.Errorf("%w: %d of %d", ErrNotEnoughSignatures, len(report.Signers), report.Threshold)
+	}
+	return report.Signatures, nil
+}
+
+// sortUniqueSignatures sorts the signatures at indexes by owner, adding
+// dynamicOffset to the offsets of contract signatures, and appends the
+// remaining bytes of signatures, like sortUniqueSignatures.
+func sortUniqueSignatures(signatures []byte, hash common.Hash, indexes []int, dynamicOffset int) []byte {
+	type signature struct {
+		owner common.Address
+		index int
+	}
+	sorted := make([]signature, len(indexes))
+	for i, index := range indexes {
+		v, r, s := Split(signatures, index)
+		// The owners were recovered already.
+		owner, _ := ExtractOwner(hash, v, r, s)
+		sorted[i] = signature{owner, index}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i].owner.Bytes(), sorted[j].owner.Bytes()) < 0 })
+
+	var out []byte
+	for _, sig := range sorted {
+		v, r, s := Split(signatures, sig.index)
+		if v == 0 {
+			// The s of contract signatures is the offset of their data.
+			s = common.BigToHash(new(big.Int).Add(s.Big(), big.NewInt(int64(dynamicOffset))))
+		}
+		out = append(out, join(v, r, s)...)
+	}
+	// Append the non-static part, holding the data of contract signatures.
+	if len(signatures) > len(out) {
+		out = append(out, signatures[len(out):]...)
+	}
+	return out
+}
+
+// approvers returns the owners having approved the hash, at most threshold
+// of them, like getApprovers.
+func approvers(ctx context.Context, caller *bindings.IGnosisSafeCaller, owners []common.Address, hash common.Hash, threshold uint64) ([]common.Address, error) {
+	var approvers []common.Address
+	for _, owner := range owners {
+		approved, err := caller.ApprovedHashes(&bind.CallOpts{Context: ctx}, owner, hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch approval of %s: %w", owner, err)
+		}
+		if approved.Cmp(common.Big1) == 0 {
+			approvers = append(approvers, owner)
+			if uint64(len(approvers)) == threshold {
+				break
+			}
+		}
+	}
+	return approvers, nil
+}
+
+// GetApprovers returns the owners of the Safe having approved the hash, at
+// most as many as the threshold.
+func GetApprovers(ctx context.Context, backend bind.ContractCaller, safe common.Address, hash common.Hash) ([]common.Address, error) {
+	caller, err := bindings.NewIGnosisSafeCaller(safe, backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	threshold, err := caller.GetThreshold(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch threshold of Safe %s: %w", safe, err)
+	}
+	owners, err := caller.GetOwners(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch owners of Safe %s: %w", safe, err)
+	}
+	return approvers(ctx, caller, owners, hash, threshold.Uint64())
+}
@@ -0,0 +1,210 @@
+package signatures
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+var (
+	safe = common.HexToAddress("0x5afe")
+	hash = crypto.Keccak256Hash([]byte("safe tx"))
+)
+
+// fakeSafe answers the Safe getters.
+type fakeSafe struct {
+	threshold int64
+	owners    []common.Address
+	approved  map[common.Address]bool
+}
+
+func (s *fakeSafe) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (s *fakeSafe) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	parsed, err := bindings.IGnosisSafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "getThreshold":
+		return method.Outputs.Pack(big.NewInt(s.threshold))
+	case "getOwners":
+		return method.Outputs.Pack(s.owners)
+	case "approvedHashes":
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		if err != nil {
+			return nil, err
+		}
+		if s.approved[args[0].(common.Address)] && args[1].([32]byte) == hash {
+			return method.Outputs.Pack(common.Big1)
+		}
+		return method.Outputs.Pack(common.Big0)
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func newKeys(t *testing.T, n int) ([]*ecdsa.PrivateKey, []common.Address) {
+	t.Helper()
+	keys := make([]*ecdsa.PrivateKey, n)
+	addrs := make([]common.Address, n)
+	for i := range keys {
+		var err error
+		if keys[i], err = crypto.GenerateKey(); err != nil {
+			t.Fatal(err)
+		}
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	return keys, addrs
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, ethSign bool) []byte {
+	t.Helper()
+	h := hash
+	if ethSign {
+		h = crypto.Keccak256Hash([]byte("\x19Ethereum Signed Message:\n32"), hash.Bytes())
+	}
+	sig, err := crypto.Sign(h.Bytes(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[64] += 27
+	if ethSign {
+		sig[64] += 4
+	}
+	return sig
+}
+
+func TestExtractOwner(t *testing.T) {
+	keys, addrs := newKeys(t, 1)
+	for _, ethSign := range []bool{false, true} {
+		v, r, s := Split(sign(t, keys[0], ethSign), 0)
+		if owner, err := ExtractOwner(hash, v, r, s); err != nil || owner != addrs[0] {
+			t.Fatalf("eth_sign %v: got owner %s (%v), want %s", ethSign, owner, err, addrs[0])
+		}
+	}
+	v, r, s := Split(GenPrevalidatedSignature(addrs[0]), 0)
+	if owner, err := ExtractOwner(hash, v, r, s); err != nil || owner != addrs[0] {
+		t.Fatalf("got owner %s (%v) of the prevalidated signature, want %s", owner, err, addrs[0])
+	}
+	if _, err := ExtractOwner(hash, 29, r, s); err == nil {
+		t.Fatal("expected an invalid v to be rejected")
+	}
+}
+
+func TestGenPrevalidatedSignatures(t *testing.T) {
+	a, b := common.HexToAddress("0x0a"), common.HexToAddress("0x0b")
+	got := GenPrevalidatedSignatures([]common.Address{b, a})
+	want := append(GenPrevalidatedSignature(a), GenPrevalidatedSignature(b)...)
+	if !bytes.Equal(got, want) {
+		t.Fatalf("got %x, want %x", got, want)
+	}
+}
+
+// contractSignature returns a contract signature of owner, whose data is
+// at offset.
+func contractSignature(owner common.Address, offset int64, data []byte) []byte {
+	sig := append(common.LeftPadBytes(owner.Bytes(), 32), common.BigToHash(big.NewInt(offset)).Bytes()...)
+	sig = append(sig, 0)
+	sig = append(sig, common.BigToHash(big.NewInt(int64(len(data)))).Bytes()...)
+	return append(sig, data...)
+}
+
+func TestMerge(t *testing.T) {
+	keys, _ := newKeys(t, 1)
+	ecdsaSig := sign(t, keys[0], false)
+	contract := common.HexToAddress("0xc0")
+	merged := Merge(contractSignature(contract, Length, []byte{0xde, 0xad}), ecdsaSig)
+
+	if len(merged) != 2*Length+32+2 || !bytes.Equal(merged[Length:2*Length], ecdsaSig) {
+		t.Fatalf("unexpected merged signatures %x", merged)
+	}
+	v, r, s := Split(merged, 0)
+	if v != 0 || common.BytesToAddress(r.Bytes()) != contract || s.Big().Int64() != 2*Length {
+		t.Fatalf("unexpected contract signature v %d r %s s %s", v, r, s)
+	}
+	if !bytes.Equal(merged[2*Length+32:], []byte{0xde, 0xad}) {
+		t.Fatalf("contract signature data not moved: %x", merged[2*Length:])
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	keys, addrs := newKeys(t, 4)
+	backend := &fakeSafe{threshold: 3, owners: addrs[:3], approved: map[common.Address]bool{addrs[2]: true}}
+	sig0, sig1, nonOwner := sign(t, keys[0], false), sign(t, keys[1], true), sign(t, keys[3], false)
+
+	report, err := Prepare(context.Background(), backend, safe, hash, bytes.Join([][]byte{sig0, nonOwner, sig0}, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Complete() || report.Missing() != 1 || report.Signatures != nil {
+		t.Fatalf("expected a missing signature, got %+v", report)
+	}
+	if len(report.Approvers) != 1 || report.Approvers[0] != addrs[2] {
+		t.Fatalf("unexpected approvers %v", report.Approvers)
+	}
+	if len(report.Signers) != 2 || report.Signers[0] != addrs[2] || report.Signers[1] != addrs[0] {
+		t.Fatalf("unexpected signers %v", report.Signers)
+	}
+	if len(report.NonOwners) != 1 || report.NonOwners[0] != addrs[3] {
+		t.Fatalf("unexpected non-owners %v", report.NonOwners)
+	}
+	if _, err := PrepareSignatures(context.Background(), backend, safe, hash, sig0); !errors.Is(err, ErrNotEnoughSignatures) {
+		t.Fatalf("expected ErrNotEnoughSignatures, got %v", err)
+	}
+
+	sorted, err := PrepareSignatures(context.Background(), backend, safe, hash, append(sig1, sig0...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bySigner := map[common.Address][]byte{addrs[0]: sig0, addrs[1]: sig1, addrs[2]: GenPrevalidatedSignature(addrs[2])}
+	var prev common.Address
+	for i := 0; i < 3; i++ {
+		v, r, s := Split(sorted, i)
+		owner, err := ExtractOwner(hash, v, r, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Compare(prev.Bytes(), owner.Bytes()) >= 0 {
+			t.Fatalf("signatures not sorted by owner: %x", sorted)
+		}
+		if !bytes.Equal(sorted[i*Length:(i+1)*Length], bySigner[owner]) {
+			t.Fatalf("unexpected signature of %s: %x", owner, sorted[i*Length:(i+1)*Length])
+		}
+		prev = owner
+	}
+}
+
+func TestPrepareContractSignature(t *testing.T) {
+	contract := common.HexToAddress("0xc0")
+	approver := common.HexToAddress("0x0a")
+	backend := &fakeSafe{threshold: 2, owners: []common.Address{approver, contract}, approved: map[common.Address]bool{approver: true}}
+
+	sorted, err := PrepareSignatures(context.Background(), backend, safe, hash, contractSignature(contract, Length, []byte{0xde, 0xad}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The prevalidated signature is prepended, shifting the data of the
+	// contract signature by its length.
+	v, _, s := Split(sorted, 1)
+	if v != 0 || s.Big().Int64() != 2*Length {
+		t.Fatalf("got contract signature v %d s %s, want its offset shifted", v, s)
+	}
+	if !bytes.Equal(sorted[2*Length+32:], []byte{0xde, 0xad}) {
+		t.Fatalf("contract signature data not appended: %x", sorted)
+	}
+}
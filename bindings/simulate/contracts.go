@@ -0,0 +1,122 @@
+package simulate
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+// BalanceChange is the simulated balance change of an account paid by a
+// contract.
+type BalanceChange struct {
+	// Name is the role of the account in the contract, e.g. OPTIMISM_WALLET
+	// or systemAddresses[0].
+	Name    string
+	Account common.Address
+	// Delta is the change of the L2 balance, or the ether bridged to the
+	// account for L1 accounts.
+	Delta *big.Int
+	L1    bool
+}
+
+// Report is a simulated call together with the balance changes of the
+// accounts paid by the called contract. The accounts are read from the latest
+// block, without the overrides.
+type Report struct {
+	*Result
+	Balances []BalanceChange
+}
+
+// DisburseFees simulates FeeDisburser.disburseFees sent by from.
+func (s *Simulator) DisburseFees(ctx context.Context, feeDisburser, from common.Address, overrides Overrides) (*Report, error) {
+	contract, err := bindings.NewFeeDisburserCaller(feeDisburser, s.backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	optimismWallet, err := contract.OPTIMISMWALLET(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OPTIMISM_WALLET: %w", err)
+	}
+	l1Wallet, err := contract.L1WALLET(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1_WALLET: %w", err)
+	}
+	call, err := NewCall(bindings.FeeDisburserMetaData, from, feeDisburser, "disburseFees")
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Simulate(ctx, call, overrides)
+	if err != nil {
+		return nil, err
+	}
+	return &Report{
+		Result: result,
+		Balances: []BalanceChange{
+			{Name: "OPTIMISM_WALLET", Account: optimismWallet, Delta: result.Delta(optimismWallet)},
+			{Name: "L1_WALLET", Account: l1Wallet, Delta: result.BridgedTo(l1Wallet), L1: true},
+		},
+	}, nil
+}
+
+// ProcessFees simulates BalanceTracker.processFees sent by from.
+func (s *Simulator) ProcessFees(ctx context.Context, balanceTracker, from common.Address, overrides Overrides) (*Report, error) {
+	contract, err := bindings.NewBalanceTrackerCaller(balanceTracker, s.backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	profitWallet, err := contract.PROFITWALLET(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch PROFIT_WALLET: %w", err)
+	}
+	targets, err := contract.Targets(opts)
+	if err != nil {
+		return nil, err
+	}
+	call, err := NewCall(bindings.BalanceTrackerMetaData, from, balanceTracker, "processFees")
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Simulate(ctx, call, overrides)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Result: result}
+	for i, target := range targets {
+		report.Balances = append(report.Balances, BalanceChange{
+			Name:    fmt.Sprintf("systemAddresses[%d]", i),
+			Account: target.SystemAddress,
+			Delta:   result.Delta(target.SystemAddress),
+		})
+	}
+	report.Balances = append(report.Balances, BalanceChange{Name: "PROFIT_WALLET", Account: profitWallet, Delta: result.Delta(profitWallet)})
+	return report, nil
+}
+
+// Initialize simulates BalanceTracker.initialize sent by from. It moves no
+// ether, the report is mostly useful for its Err and Events.
+func (s *Simulator) Initialize(ctx context.Context, balanceTracker, from common.Address, systemAddresses []common.Address, targetBalances []*big.Int, overrides Overrides) (*Report, error) {
+	call, err := NewCall(bindings.BalanceTrackerMetaData, from, balanceTracker, "initialize", systemAddresses, targetBalances)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Simulate(ctx, call, overrides)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Result: result}
+	for i, address := range systemAddresses {
+		report.Balances = append(report.Balances, BalanceChange{
+			Name:    fmt.Sprintf("systemAddresses[%d]", i),
+			Account: address,
+			Delta:   result.Delta(address),
+		})
+	}
+	return report, nil
+}
@@ -0,0 +1,82 @@
+package simulate
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// maxLinkLength is the longest simulation link Tenderly accepts, longer links
+// leave out the calldata.
+const maxLinkLength = 7980
+
+// LinkConfig identifies the Tenderly project simulation links open in.
+type LinkConfig struct {
+	Username string
+	Project  string
+}
+
+// LinkConfigFromEnv reads the TENDERLY_USERNAME and TENDERLY_PROJECT
+// environment variables, defaulting to placeholders like the forge scripts.
+func LinkConfigFromEnv() LinkConfig {
+	cfg := LinkConfig{Username: os.Getenv("TENDERLY_USERNAME"), Project: os.Getenv("TENDERLY_PROJECT")}
+	if cfg.Username == "" {
+		cfg.Username = "TENDERLY_USERNAME"
+	}
+	if cfg.Project == "" {
+		cfg.Project = "TENDERLY_PROJECT"
+	}
+	return cfg
+}
+
+// Link returns the Tenderly link simulating payload on the chain. Only the
+// storage overrides are part of the link, sorted by address and slot. Tenderly
+// rejects long URLs, so if the calldata doesn't fit, the returned link leaves
+// it out and rawInput is the hex to paste into the 'Raw input data' field.
+func (cfg LinkConfig) Link(chainID *big.Int, payload Payload) (link string, rawInput string) {
+	addresses := make([]common.Address, 0, len(payload.Overrides))
+	for address, account := range payload.Overrides {
+		if len(account.State)+len(account.StateDiff) > 0 {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool { return bytes.Compare(addresses[i][:], addresses[j][:]) < 0 })
+
+	var overrides strings.Builder
+	overrides.WriteString("%5B")
+	for i, address := range addresses {
+		if i > 0 {
+			overrides.WriteString(",")
+		}
+		fmt.Fprintf(&overrides, "%%7B\"contractAddress\":\"%s\",\"storage\":%%5B", address.Hex())
+		storage := payload.Overrides[address].storage()
+		keys := make([]common.Hash, 0, len(storage))
+		for key := range storage {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+		for j, key := range keys {
+			if j > 0 {
+				overrides.WriteString(",")
+			}
+			fmt.Fprintf(&overrides, "%%7B\"key\":\"%s\",\"value\":\"%s\"%%7D", key.Hex(), storage[key].Hex())
+		}
+		overrides.WriteString("%5D%7D")
+	}
+	overrides.WriteString("%5D")
+
+	link = fmt.Sprintf("https://dashboard.tenderly.co/%s/%s/simulator/new?network=%s&contractAddress=%s&from=%s&stateOverrides=%s",
+		url.PathEscape(cfg.Username), url.PathEscape(cfg.Project), chainID, payload.To.Hex(), payload.From.Hex(), overrides.String())
+	data := hexutil.Encode(payload.Data)
+	if len(link)+len(payload.Data)*2 > maxLinkLength {
+		return link, data
+	}
+	return link + "&rawFunctionInput=" + data, ""
+}
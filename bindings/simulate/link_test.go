@@ -0,0 +1,39 @@
+package simulate
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestLink(t *testing.T) {
+	cfg := LinkConfig{Username: "base", Project: "ops"}
+	payload := Payload{
+		From:      common.HexToAddress("0x0a"),
+		To:        common.HexToAddress("0x5afe"),
+		Data:      []byte{0xde, 0xad},
+		Overrides: make(Overrides),
+	}
+	payload.Overrides.SetStorage(common.HexToAddress("0x5afe"), common.BigToHash(big.NewInt(5)), common.BigToHash(big.NewInt(2)))
+	payload.Overrides.SetStorage(common.HexToAddress("0x5afe"), common.BigToHash(big.NewInt(4)), common.BigToHash(big.NewInt(1)))
+	link, raw := cfg.Link(big.NewInt(8453), payload)
+	want := "https://dashboard.tenderly.co/base/ops/simulator/new?network=8453" +
+		"&contractAddress=0x0000000000000000000000000000000000005aFE&from=0x000000000000000000000000000000000000000A" +
+		"&stateOverrides=%5B%7B\"contractAddress\":\"0x0000000000000000000000000000000000005aFE\",\"storage\":%5B" +
+		"%7B\"key\":\"0x0000000000000000000000000000000000000000000000000000000000000004\"," +
+		"\"value\":\"0x0000000000000000000000000000000000000000000000000000000000000001\"%7D," +
+		"%7B\"key\":\"0x0000000000000000000000000000000000000000000000000000000000000005\"," +
+		"\"value\":\"0x0000000000000000000000000000000000000000000000000000000000000002\"%7D%5D%7D%5D" +
+		"&rawFunctionInput=0xdead"
+	if link != want || raw != "" {
+		t.Fatalf("got link\n%s\nwant\n%s", link, want)
+	}
+
+	payload.Data = make([]byte, maxLinkLength)
+	link, raw = cfg.Link(big.NewInt(8453), payload)
+	if strings.Contains(link, "rawFunctionInput") || len(raw) != 2+2*maxLinkLength {
+		t.Fatalf("expected the calldata to be left out of long links")
+	}
+}
@@ -0,0 +1,117 @@
+package simulate
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+// Storage slots of the Safe singleton.
+var (
+	ownersSlot     = common.BigToHash(big.NewInt(2))
+	ownerCountSlot = common.BigToHash(big.NewInt(3))
+	thresholdSlot  = common.BigToHash(big.NewInt(4))
+	nonceSlot      = common.BigToHash(big.NewInt(5))
+
+	// sentinelOwnerSlot is owners[SENTINEL_OWNERS], keccak256(1 || 2), the
+	// head of the owners linked list.
+	sentinelOwnerSlot = common.HexToHash("0xe90b7bceb6e7df5418fb78d8ee546e97c83a08bbccc01a0644d599ccd2a7c2e0")
+)
+
+// OverrideSafeThresholdAndNonce returns the overrides setting the threshold of
+// the Safe to 1 and its nonce to nonce, omitting those already set.
+func OverrideSafeThresholdAndNonce(ctx context.Context, backend bind.ContractCaller, safe common.Address, nonce *big.Int) (Overrides, error) {
+	overrides := make(Overrides)
+	if err := AddThresholdOverride(ctx, backend, safe, overrides); err != nil {
+		return nil, err
+	}
+	if err := AddNonceOverride(ctx, backend, safe, overrides, nonce); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// OverrideSafeThresholdOwnerAndNonce returns the overrides setting the
+// threshold of the Safe to 1, making owner its only owner unless it already
+// is one, and setting its nonce to nonce.
+func OverrideSafeThresholdOwnerAndNonce(ctx context.Context, backend bind.ContractCaller, safe, owner common.Address, nonce *big.Int) (Overrides, error) {
+	overrides := make(Overrides)
+	if err := AddThresholdOverride(ctx, backend, safe, overrides); err != nil {
+		return nil, err
+	}
+	if err := AddOwnerOverride(ctx, backend, safe, overrides, owner); err != nil {
+		return nil, err
+	}
+	if err := AddNonceOverride(ctx, backend, safe, overrides, nonce); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// AddThresholdOverride adds the override setting the threshold of the Safe to
+// 1 if it isn't already.
+func AddThresholdOverride(ctx context.Context, backend bind.ContractCaller, safe common.Address, overrides Overrides) error {
+	caller, err := bindings.NewIGnosisSafeCaller(safe, backend)
+	if err != nil {
+		return err
+	}
+	threshold, err := caller.GetThreshold(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to fetch threshold of Safe %s: %w", safe, err)
+	}
+	if threshold.Cmp(common.Big1) != 0 {
+		overrides.SetStorage(safe, thresholdSlot, common.BigToHash(common.Big1))
+	}
+	return nil
+}
+
+// AddOwnerOverride adds the overrides making owner the only owner of the Safe
+// if it isn't an owner already.
+func AddOwnerOverride(ctx context.Context, backend bind.ContractCaller, safe common.Address, overrides Overrides, owner common.Address) error {
+	caller, err := bindings.NewIGnosisSafeCaller(safe, backend)
+	if err != nil {
+		return err
+	}
+	owners, err := caller.GetOwners(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to fetch owners of Safe %s: %w", safe, err)
+	}
+	for _, o := range owners {
+		if o == owner {
+			return nil
+		}
+	}
+	overrides.SetStorage(safe, ownerCountSlot, common.BigToHash(common.Big1))
+	// The owners linked list becomes SENTINEL_OWNERS -> owner -> SENTINEL_OWNERS.
+	overrides.SetStorage(safe, sentinelOwnerSlot, common.BytesToHash(owner.Bytes()))
+	overrides.SetStorage(safe, mappingSlot(owner, ownersSlot), common.BigToHash(common.Big1))
+	return nil
+}
+
+// AddNonceOverride adds the override setting the nonce of the Safe to nonce if
+// it differs.
+func AddNonceOverride(ctx context.Context, backend bind.ContractCaller, safe common.Address, overrides Overrides, nonce *big.Int) error {
+	caller, err := bindings.NewIGnosisSafeCaller(safe, backend)
+	if err != nil {
+		return err
+	}
+	current, err := caller.Nonce(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to fetch nonce of Safe %s: %w", safe, err)
+	}
+	if current.Cmp(nonce) != 0 {
+		overrides.SetStorage(safe, nonceSlot, common.BigToHash(nonce))
+	}
+	return nil
+}
+
+// mappingSlot is the slot of key in the address mapping at slot.
+func mappingSlot(key common.Address, slot common.Hash) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(key.Bytes(), 32), slot.Bytes())
+}
@@ -0,0 +1,100 @@
+package simulate
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/base-org/contracts/bindings"
+)
+
+// fakeSafe answers the Safe getters.
+type fakeSafe struct {
+	threshold int64
+	owners    []common.Address
+	nonce     int64
+}
+
+func (s *fakeSafe) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (s *fakeSafe) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	parsed, err := bindings.IGnosisSafeMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "getThreshold":
+		return method.Outputs.Pack(big.NewInt(s.threshold))
+	case "getOwners":
+		return method.Outputs.Pack(s.owners)
+	case "nonce":
+		return method.Outputs.Pack(big.NewInt(s.nonce))
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func TestOverrideSafeThresholdOwnerAndNonce(t *testing.T) {
+	safe := common.HexToAddress("0x5afe")
+	owner := common.HexToAddress("0x0a")
+	backend := &fakeSafe{threshold: 2, owners: []common.Address{common.HexToAddress("0x0b")}, nonce: 7}
+
+	overrides, err := OverrideSafeThresholdOwnerAndNonce(context.Background(), backend, safe, owner, big.NewInt(9))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ownerSlot := crypto.Keccak256Hash(common.LeftPadBytes(owner.Bytes(), 32), common.LeftPadBytes([]byte{2}, 32))
+	want := map[common.Hash]common.Hash{
+		common.BigToHash(big.NewInt(4)): common.BigToHash(big.NewInt(1)),
+		common.BigToHash(big.NewInt(3)): common.BigToHash(big.NewInt(1)),
+		crypto.Keccak256Hash(common.LeftPadBytes([]byte{1}, 32), common.LeftPadBytes([]byte{2}, 32)): common.BytesToHash(owner.Bytes()),
+		ownerSlot:                       common.BigToHash(big.NewInt(1)),
+		common.BigToHash(big.NewInt(5)): common.BigToHash(big.NewInt(9)),
+	}
+	diff := overrides[safe].StateDiff
+	if len(overrides) != 1 || len(diff) != len(want) {
+		t.Fatalf("unexpected overrides: %+v", overrides)
+	}
+	for key, value := range want {
+		if diff[key] != value {
+			t.Fatalf("slot %s: got %s, want %s", key, diff[key], value)
+		}
+	}
+
+	// Nothing is overridden if the Safe is already in the desired state.
+	backend = &fakeSafe{threshold: 1, owners: []common.Address{owner}, nonce: 9}
+	overrides, err = OverrideSafeThresholdOwnerAndNonce(context.Background(), backend, safe, owner, big.NewInt(9))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(overrides) != 0 {
+		t.Fatalf("expected no overrides, got %+v", overrides)
+	}
+}
+
+func TestOverridesMerge(t *testing.T) {
+	safe := common.HexToAddress("0x5afe")
+	overrides := make(Overrides)
+	overrides.SetStorage(safe, common.Hash{4}, common.Hash{1})
+	overrides.SetStorage(safe, common.Hash{5}, common.Hash{2})
+
+	other := Overrides{safe: {Balance: big.NewInt(1), StateDiff: map[common.Hash]common.Hash{{5}: {3}}}}
+	overrides.Merge(other)
+	account := overrides[safe]
+	if account.Balance.Int64() != 1 || len(account.StateDiff) != 2 || account.StateDiff[common.Hash{4}] != (common.Hash{1}) || account.StateDiff[common.Hash{5}] != (common.Hash{3}) {
+		t.Fatalf("unexpected merged overrides: %+v", account)
+	}
+	if other[safe].StateDiff[common.Hash{4}] != (common.Hash{}) {
+		t.Fatal("merge modified the merged overrides")
+	}
+}
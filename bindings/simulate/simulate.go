@@ -0,0 +1,480 @@
+// Package simulate runs transactions against the current chain state without
+// sending them and reports what they would do: the events they would emit,
+// the ether they would move and the gas they would use. It is also the Go
+// counterpart of script/universal/Simulation.sol: it builds the overrides
+// letting a single owner execute a Safe transaction and renders Tenderly
+// simulation links. Calls are simulated with eth_simulateV1, which accepts
+// state overrides and traces transfers of ether. Nodes without eth_simulateV1
+// fall back to eth_call, which reports reverts, return data and gas but
+// neither events nor transfers.
+package simulate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+var (
+	// transferAddress is the address eth_simulateV1 emits traced transfers
+	// of ether from, as ERC-20 Transfer events.
+	transferAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	transferTopic   = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// l2StandardBridgeABI holds the event of the L2StandardBridge recording ether
+// bridged to L1.
+const l2StandardBridgeABI = `[{"type":"event","name":"ETHBridgeInitiated","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"extraData","type":"bytes","indexed":false}]}]`
+
+var ethBridgeInitiated = func() abi.Event {
+	parsed, err := abi.JSON(strings.NewReader(l2StandardBridgeABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed.Events["ETHBridgeInitiated"]
+}()
+
+// ErrSimulateV1Unavailable is returned when several calls are simulated
+// together on a node without eth_simulateV1: eth_call runs every call on the
+// latest block, so later calls can't see the state left by earlier ones.
+var ErrSimulateV1Unavailable = errors.New("simulate: eth_simulateV1 unavailable, required to simulate several calls")
+
+// ErrNoStateChanges is returned when a simulated payload emits no event and
+// moves no ether.
+var ErrNoStateChanges = errors.New("simulate: no state changes")
+
+// Backend is the chain access needed by a Simulator. ethclient.Client
+// implements it.
+type Backend interface {
+	bind.ContractCaller
+	Client() *rpc.Client
+}
+
+// Account overrides the state of an account during a simulation. Nil fields
+// are left unchanged.
+type Account struct {
+	Balance *big.Int
+	Nonce   *uint64
+	Code    []byte
+	// State replaces the whole storage of the account.
+	State map[common.Hash]common.Hash
+	// StateDiff replaces the given storage slots only.
+	StateDiff map[common.Hash]common.Hash
+}
+
+// Overrides are the state overrides of a simulation.
+type Overrides map[common.Address]Account
+
+// SetStorage overrides the storage slot key of the account at address.
+func (o Overrides) SetStorage(address common.Address, key, value common.Hash) {
+	account := o[address]
+	if account.StateDiff == nil {
+		account.StateDiff = make(map[common.Hash]common.Hash)
+	}
+	account.StateDiff[key] = value
+	o[address] = account
+}
+
+// Merge adds the overrides of other, which take precedence. Storage slots are
+// merged, while a State of other replaces the whole storage.
+func (o Overrides) Merge(other Overrides) {
+	for address, account := range other {
+		merged := o[address]
+		if account.Balance != nil {
+			merged.Balance = account.Balance
+		}
+		if account.Nonce != nil {
+			merged.Nonce = account.Nonce
+		}
+		if account.Code != nil {
+			merged.Code = account.Code
+		}
+		if account.State != nil {
+			merged.State, merged.StateDiff = account.State, nil
+		}
+		if len(account.StateDiff) > 0 {
+			diff := make(map[common.Hash]common.Hash, len(merged.StateDiff)+len(account.StateDiff))
+			for key, value := range merged.StateDiff {
+				diff[key] = value
+			}
+			for key, value := range account.StateDiff {
+				diff[key] = value
+			}
+			merged.StateDiff = diff
+		}
+		o[address] = merged
+	}
+}
+
+// storage returns the storage slots set by the account override.
+func (a Account) storage() map[common.Hash]common.Hash {
+	storage := make(map[common.Hash]common.Hash, len(a.State)+len(a.StateDiff))
+	for key, value := range a.State {
+		storage[key] = value
+	}
+	for key, value := range a.StateDiff {
+		storage[key] = value
+	}
+	return storage
+}
+
+// Payload is a call to simulate with its overrides, the Go counterpart of
+// the Payload of script/universal/Simulation.sol.
+type Payload struct {
+	From      common.Address
+	To        common.Address
+	Data      []byte
+	Overrides Overrides
+}
+
+// Call is a transaction to simulate.
+type Call struct {
+	From  common.Address
+	To    common.Address
+	Data  []byte
+	Value *big.Int
+}
+
+// NewCall packs a call of the named method of the contract described by
+// metadata, e.g. NewCall(bindings.FeeDisburserMetaData, from, to, "disburseFees").
+func NewCall(metadata *bind.MetaData, from, to common.Address, method string, args ...interface{}) (Call, error) {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return Call{}, err
+	}
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		return Call{}, fmt.Errorf("failed to pack %s: %w", method, err)
+	}
+	return Call{From: from, To: to, Data: data}, nil
+}
+
+// Result is the outcome of a simulated call.
+type Result struct {
+	// GasUsed is the gas used by the call.
+	GasUsed uint64
+	// ReturnData is the data returned by the call.
+	ReturnData []byte
+	// Err is the decoded revert if the call failed, see bindings.DecodeRevert.
+	Err error
+	// Traced reports whether Events, Deltas and Bridged were recorded. They
+	// are not when the node lacks eth_simulateV1 and the call was simulated
+	// with eth_call, GasUsed is then the gas estimate of the call.
+	Traced bool
+	// Events are the logs the call emitted, decoded if they are known to the
+	// event decoder. They are empty if the call failed.
+	Events []*bindings.Event
+	// Deltas is the change of the L2 balance of every account the call moved
+	// ether from or to.
+	Deltas map[common.Address]*big.Int
+	// Bridged is the ether bridged to every L1 account by the call, credited
+	// once the withdrawals are finalized.
+	Bridged map[common.Address]*big.Int
+}
+
+// Delta returns the change of the L2 balance of account.
+func (r *Result) Delta(account common.Address) *big.Int {
+	if delta, ok := r.Deltas[account]; ok {
+		return new(big.Int).Set(delta)
+	}
+	return new(big.Int)
+}
+
+// BridgedTo returns the ether bridged to the L1 account.
+func (r *Result) BridgedTo(account common.Address) *big.Int {
+	if amount, ok := r.Bridged[account]; ok {
+		return new(big.Int).Set(amount)
+	}
+	return new(big.Int)
+}
+
+// Simulator simulates calls.
+type Simulator struct {
+	backend Backend
+	decoder *bindings.EventDecoder
+	// noSimulateV1 is set once the node reported eth_simulateV1 missing.
+	noSimulateV1 atomic.Bool
+}
+
+// New creates a Simulator decoding the emitted events with decoder, or with
+// bindings.DefaultEventDecoder if it is nil.
+func New(backend Backend, decoder *bindings.EventDecoder) (*Simulator, error) {
+	if decoder == nil {
+		var err error
+		if decoder, err = bindings.DefaultEventDecoder(); err != nil {
+			return nil, err
+		}
+	}
+	return &Simulator{backend: backend, decoder: decoder}, nil
+}
+
+// SimulatePayload runs the payload like Simulation.simulateFromSimPayload: a
+// revert is returned as an error, decoded by bindings.DecodeRevert. A call
+// emitting no event and moving no ether returns ErrNoStateChanges, in place
+// of the empty state diff forge rejects; the check is skipped when the call
+// isn't traced.
+func (s *Simulator) SimulatePayload(ctx context.Context, payload Payload) (*Result, error) {
+	if payload.From == (common.Address{}) {
+		return nil, errors.New("simulate: from address cannot be zero address")
+	}
+	if payload.To == (common.Address{}) {
+		return nil, errors.New("simulate: to address cannot be zero address")
+	}
+	result, err := s.Simulate(ctx, Call{From: payload.From, To: payload.To, Data: payload.Data}, payload.Overrides)
+	if err != nil {
+		return nil, err
+	}
+	if result.Err != nil {
+		return nil, fmt.Errorf("simulation failed: %w", result.Err)
+	}
+	if result.Traced && len(result.Events) == 0 && len(result.Deltas) == 0 {
+		return nil, ErrNoStateChanges
+	}
+	return result, nil
+}
+
+// Simulate runs call on top of the latest block with the given overrides.
+// A reverting call is no error, it is reported in the Err of the Result.
+func (s *Simulator) Simulate(ctx context.Context, call Call, overrides Overrides) (*Result, error) {
+	results, err := s.SimulateCalls(ctx, []Call{call}, overrides)
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// SimulateCalls runs calls one after the other in a single block on top of
+// the latest block with the given overrides, each call seeing the state left
+// by the previous ones. This lets later calls read the state a transaction
+// leaves behind. Reverting calls are no error, they are reported in the Err
+// of their Result. On nodes without eth_simulateV1 a single call is simulated
+// with eth_call, and several calls fail with ErrSimulateV1Unavailable.
+func (s *Simulator) SimulateCalls(ctx context.Context, calls []Call, overrides Overrides) ([]*Result, error) {
+	if !s.noSimulateV1.Load() {
+		results, err := s.simulateV1(ctx, calls, overrides)
+		if !methodNotFound(err) {
+			return results, err
+		}
+		s.noSimulateV1.Store(true)
+	}
+	if len(calls) != 1 {
+		return nil, ErrSimulateV1Unavailable
+	}
+	result, err := s.call(ctx, calls[0], overrides)
+	if err != nil {
+		return nil, err
+	}
+	return []*Result{result}, nil
+}
+
+// simulateV1 simulates calls with eth_simulateV1.
+func (s *Simulator) simulateV1(ctx context.Context, calls []Call, overrides Overrides) ([]*Result, error) {
+	var blocks []simBlock
+	simCalls := make([]simCall, len(calls))
+	for i, call := range calls {
+		simCalls[i] = simCall{From: call.From, To: call.To, Input: call.Data, Value: (*hexutil.Big)(call.Value)}
+	}
+	opts := simOpts{
+		BlockStateCalls: []simBlockCalls{{
+			StateOverrides: encodeOverrides(overrides),
+			Calls:          simCalls,
+		}},
+		TraceTransfers: true,
+	}
+	if err := s.backend.Client().CallContext(ctx, &blocks, "eth_simulateV1", opts, "latest"); err != nil {
+		return nil, fmt.Errorf("failed to simulate call: %w", err)
+	}
+	if len(blocks) != 1 || len(blocks[0].Calls) != len(calls) {
+		return nil, errors.New("simulate: unexpected eth_simulateV1 result")
+	}
+	results := make([]*Result, len(calls))
+	for i, res := range blocks[0].Calls {
+		result, err := s.result(res)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
+// call simulates a single call with eth_call and estimates its gas with
+// eth_estimateGas, both with the overrides.
+func (s *Simulator) call(ctx context.Context, call Call, overrides Overrides) (*Result, error) {
+	args := simCall{From: call.From, To: call.To, Input: call.Data, Value: (*hexutil.Big)(call.Value)}
+	encoded := encodeOverrides(overrides)
+	result := &Result{
+		Deltas:  make(map[common.Address]*big.Int),
+		Bridged: make(map[common.Address]*big.Int),
+	}
+	var data hexutil.Bytes
+	if err := s.backend.Client().CallContext(ctx, &data, "eth_call", args, "latest", encoded); err != nil {
+		if revert := bindings.DecodeRevert(err); isRevert(revert) {
+			result.Err = revert
+			return result, nil
+		}
+		return nil, fmt.Errorf("failed to call: %w", err)
+	}
+	result.ReturnData = data
+	var gas hexutil.Uint64
+	if err := s.backend.Client().CallContext(ctx, &gas, "eth_estimateGas", args, "latest", encoded); err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+	result.GasUsed = uint64(gas)
+	return result, nil
+}
+
+// isRevert reports whether err, as returned by bindings.DecodeRevert, is a
+// revert of the call rather than a failure to run it.
+func isRevert(err error) bool {
+	var revert *bindings.RevertError
+	return errors.As(err, &revert) || strings.Contains(err.Error(), "execution reverted")
+}
+
+// methodNotFound reports whether err is the error of a node not serving the
+// called method.
+func methodNotFound(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32601 {
+		return true
+	}
+	return err != nil && (strings.Contains(err.Error(), "does not exist/is not available") || strings.Contains(err.Error(), "method not found"))
+}
+
+// result decodes the eth_simulateV1 result of a call.
+func (s *Simulator) result(res simCallResult) (*Result, error) {
+	result := &Result{
+		GasUsed:    uint64(res.GasUsed),
+		ReturnData: res.ReturnData,
+		Traced:     true,
+		Deltas:     make(map[common.Address]*big.Int),
+		Bridged:    make(map[common.Address]*big.Int),
+	}
+	if uint64(res.Status) == types.ReceiptStatusFailed {
+		result.Err = revertError(res.Error)
+		return result, nil
+	}
+	for _, log := range res.Logs {
+		if log.Address == transferAddress && len(log.Topics) == 3 && log.Topics[0] == transferTopic {
+			value := new(big.Int).SetBytes(log.Data)
+			addDelta(result.Deltas, common.BytesToAddress(log.Topics[1].Bytes()), new(big.Int).Neg(value))
+			addDelta(result.Deltas, common.BytesToAddress(log.Topics[2].Bytes()), value)
+			continue
+		}
+		if log.Address == predeploys.L2StandardBridge && len(log.Topics) == 3 && log.Topics[0] == ethBridgeInitiated.ID {
+			args, err := ethBridgeInitiated.Inputs.NonIndexed().Unpack(log.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode ETHBridgeInitiated: %w", err)
+			}
+			addDelta(result.Bridged, common.BytesToAddress(log.Topics[2].Bytes()), args[0].(*big.Int))
+		}
+		ev, err := s.decoder.Decode(log)
+		if errors.Is(err, bindings.ErrUnknownEvent) {
+			ev = &bindings.Event{Raw: log}
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode log %d: %w", log.Index, err)
+		}
+		result.Events = append(result.Events, ev)
+	}
+	for account, delta := range result.Deltas {
+		if delta.Sign() == 0 {
+			delete(result.Deltas, account)
+		}
+	}
+	return result, nil
+}
+
+func addDelta(deltas map[common.Address]*big.Int, account common.Address, amount *big.Int) {
+	if _, ok := deltas[account]; !ok {
+		deltas[account] = new(big.Int)
+	}
+	deltas[account].Add(deltas[account], amount)
+}
+
+// revertError decodes the error of a failed call.
+func revertError(err *simError) error {
+	if err == nil {
+		return errors.New("execution reverted")
+	}
+	if len(err.Data) > 0 {
+		if data, decodeErr := hexutil.Decode(err.Data); decodeErr == nil {
+			return bindings.UnpackRevert(data)
+		}
+	}
+	return bindings.DecodeRevert(errors.New(err.Message))
+}
+
+// simOpts and the types below are the eth_simulateV1 request and response.
+type simOpts struct {
+	BlockStateCalls []simBlockCalls `json:"blockStateCalls"`
+	TraceTransfers  bool            `json:"traceTransfers"`
+}
+
+type simBlockCalls struct {
+	StateOverrides map[common.Address]simAccount `json:"stateOverrides,omitempty"`
+	Calls          []simCall                     `json:"calls"`
+}
+
+type simAccount struct {
+	Balance   *hexutil.Big                `json:"balance,omitempty"`
+	Nonce     *hexutil.Uint64             `json:"nonce,omitempty"`
+	Code      hexutil.Bytes               `json:"code,omitempty"`
+	State     map[common.Hash]common.Hash `json:"state,omitempty"`
+	StateDiff map[common.Hash]common.Hash `json:"stateDiff,omitempty"`
+}
+
+type simCall struct {
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Input hexutil.Bytes  `json:"input,omitempty"`
+	Value *hexutil.Big   `json:"value,omitempty"`
+}
+
+type simBlock struct {
+	Calls []simCallResult `json:"calls"`
+}
+
+type simCallResult struct {
+	ReturnData hexutil.Bytes  `json:"returnData"`
+	Logs       []types.Log    `json:"logs"`
+	GasUsed    hexutil.Uint64 `json:"gasUsed"`
+	Status     hexutil.Uint64 `json:"status"`
+	Error      *simError      `json:"error,omitempty"`
+}
+
+type simError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+func encodeOverrides(overrides Overrides) map[common.Address]simAccount {
+	if len(overrides) == 0 {
+		return nil
+	}
+	encoded := make(map[common.Address]simAccount, len(overrides))
+	for address, account := range overrides {
+		encoded[address] = simAccount{
+			Balance:   (*hexutil.Big)(account.Balance),
+			Nonce:     (*hexutil.Uint64)(account.Nonce),
+			Code:      account.Code,
+			State:     account.State,
+			StateDiff: account.StateDiff,
+		}
+	}
+	return encoded
+}
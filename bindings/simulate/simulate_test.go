@@ -0,0 +1,322 @@
+package simulate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/base-org/contracts/bindings"
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+var (
+	feeDisburser   = common.HexToAddress("0xfd")
+	optimismWallet = common.HexToAddress("0x0e")
+	l1Wallet       = common.HexToAddress("0x11")
+	sender         = common.HexToAddress("0x5e")
+)
+
+// ethService serves eth_simulateV1 with a canned result.
+type ethService struct {
+	request json.RawMessage
+	result  []simBlock
+}
+
+func (s *ethService) SimulateV1(ctx context.Context, opts json.RawMessage, block string) ([]simBlock, error) {
+	s.request = opts
+	return s.result, nil
+}
+
+// fakeBackend answers the FeeDisburser wallet getters and serves
+// eth_simulateV1 in process.
+type fakeBackend struct {
+	client *rpc.Client
+}
+
+func (b *fakeBackend) Client() *rpc.Client { return b.client }
+
+func (b *fakeBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (b *fakeBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	parsed, err := bindings.FeeDisburserMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "OPTIMISM_WALLET":
+		return method.Outputs.Pack(optimismWallet)
+	case "L1_WALLET":
+		return method.Outputs.Pack(l1Wallet)
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func newTestSimulator(t *testing.T, service *ethService) *Simulator {
+	t.Helper()
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatal(err)
+	}
+	client := rpc.DialInProc(server)
+	t.Cleanup(func() {
+		client.Close()
+		server.Stop()
+	})
+	s, err := New(&fakeBackend{client: client}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func transferLog(from, to common.Address, value int64) types.Log {
+	return types.Log{
+		Address: transferAddress,
+		Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:    common.BigToHash(big.NewInt(value)).Bytes(),
+	}
+}
+
+func TestDisburseFees(t *testing.T) {
+	parsed, err := bindings.FeeDisburserMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	disbursed := parsed.Events["FeesDisbursed"]
+	disbursedData, err := disbursed.Inputs.Pack(big.NewInt(1_700_000_000), big.NewInt(15), big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bridgedData, err := ethBridgeInitiated.Inputs.NonIndexed().Pack(big.NewInt(85), []byte{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := &ethService{result: []simBlock{{Calls: []simCallResult{{
+		GasUsed: 180_000,
+		Status:  1,
+		Logs: []types.Log{
+			transferLog(predeploys.SequencerFeeVault, feeDisburser, 100),
+			transferLog(feeDisburser, optimismWallet, 15),
+			transferLog(feeDisburser, predeploys.L2StandardBridge, 85),
+			transferLog(predeploys.L2StandardBridge, predeploys.L2ToL1MessagePasser, 85),
+			{
+				Address: predeploys.L2StandardBridge,
+				Topics:  []common.Hash{ethBridgeInitiated.ID, common.BytesToHash(feeDisburser.Bytes()), common.BytesToHash(l1Wallet.Bytes())},
+				Data:    bridgedData,
+			},
+			{Address: feeDisburser, Topics: []common.Hash{disbursed.ID}, Data: disbursedData},
+		},
+	}}}}}
+	s := newTestSimulator(t, service)
+
+	overrides := Overrides{predeploys.SequencerFeeVault: {Balance: big.NewInt(100)}}
+	report, err := s.DisburseFees(context.Background(), feeDisburser, sender, overrides)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Err != nil || report.GasUsed != 180_000 {
+		t.Fatalf("unexpected result: err %v, gas %d", report.Err, report.GasUsed)
+	}
+	if len(report.Balances) != 2 || report.Balances[0].Delta.Int64() != 15 || report.Balances[1].Delta.Int64() != 85 || !report.Balances[1].L1 {
+		t.Fatalf("unexpected balance changes: %+v", report.Balances)
+	}
+	if delta := report.Delta(predeploys.SequencerFeeVault); delta.Int64() != -100 {
+		t.Fatalf("got sequencer fee vault delta %v, want -100", delta)
+	}
+	if _, ok := report.Deltas[feeDisburser]; ok {
+		t.Fatalf("expected the FeeDisburser balance to be unchanged, got %v", report.Deltas[feeDisburser])
+	}
+	if len(report.Events) != 2 || report.Events[0].Value != nil || report.Events[1].Name != "FeesDisbursed" {
+		t.Fatalf("unexpected events: %+v", report.Events)
+	}
+
+	var req simOpts
+	if err := json.Unmarshal(service.request, &req); err != nil {
+		t.Fatal(err)
+	}
+	if !req.TraceTransfers || req.BlockStateCalls[0].Calls[0].From != sender {
+		t.Fatalf("unexpected request: %s", service.request)
+	}
+	if balance := req.BlockStateCalls[0].StateOverrides[predeploys.SequencerFeeVault].Balance; balance == nil || balance.ToInt().Int64() != 100 {
+		t.Fatalf("balance override not sent: %s", service.request)
+	}
+}
+
+func TestSimulateRevert(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reason, err := abi.Arguments{{Type: stringType}}.Pack(bindings.ErrFeeDisburserIntervalNotReached.Error())
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := append(crypto.Keccak256([]byte("Error(string)"))[:4], reason...)
+	service := &ethService{result: []simBlock{{Calls: []simCallResult{{
+		GasUsed: 25_000,
+		Status:  0,
+		Error:   &simError{Code: 3, Message: "execution reverted", Data: hexutil.Encode(data)},
+	}}}}}
+	s := newTestSimulator(t, service)
+
+	call, err := NewCall(bindings.FeeDisburserMetaData, sender, feeDisburser, "disburseFees")
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := s.Simulate(context.Background(), call, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(result.Err, bindings.ErrFeeDisburserIntervalNotReached) {
+		t.Fatalf("expected ErrFeeDisburserIntervalNotReached, got %v", result.Err)
+	}
+	if result.GasUsed != 25_000 || len(result.Events) != 0 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+// callService serves eth_call and eth_estimateGas but not eth_simulateV1, like
+// nodes predating it.
+type callService struct {
+	overrides json.RawMessage
+	revert    []byte
+}
+
+func (s *callService) Call(ctx context.Context, args json.RawMessage, block string, overrides json.RawMessage) (hexutil.Bytes, error) {
+	s.overrides = overrides
+	if s.revert != nil {
+		return nil, &revertRPCError{data: hexutil.Encode(s.revert)}
+	}
+	return common.LeftPadBytes(optimismWallet.Bytes(), 32), nil
+}
+
+func (s *callService) EstimateGas(ctx context.Context, args json.RawMessage, block string, overrides json.RawMessage) (hexutil.Uint64, error) {
+	return 30_000, nil
+}
+
+// revertRPCError is the error geth returns for a reverted eth_call.
+type revertRPCError struct {
+	data string
+}
+
+func (e *revertRPCError) Error() string          { return "execution reverted" }
+func (e *revertRPCError) ErrorCode() int         { return 3 }
+func (e *revertRPCError) ErrorData() interface{} { return e.data }
+
+func TestSimulateFallsBackToCall(t *testing.T) {
+	service := &callService{}
+	server := rpc.NewServer()
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatal(err)
+	}
+	client := rpc.DialInProc(server)
+	defer client.Close()
+	defer server.Stop()
+	s, err := New(&fakeBackend{client: client}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	call, err := NewCall(bindings.FeeDisburserMetaData, sender, feeDisburser, "OPTIMISM_WALLET")
+	if err != nil {
+		t.Fatal(err)
+	}
+	overrides := Overrides{feeDisburser: {Balance: big.NewInt(100)}}
+	result, err := s.Simulate(context.Background(), call, overrides)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Err != nil || result.Traced || result.GasUsed != 30_000 || common.BytesToAddress(result.ReturnData) != optimismWallet {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	var sent map[common.Address]simAccount
+	if err := json.Unmarshal(service.overrides, &sent); err != nil {
+		t.Fatal(err)
+	}
+	if balance := sent[feeDisburser].Balance; balance == nil || balance.ToInt().Int64() != 100 {
+		t.Fatalf("balance override not sent: %s", service.overrides)
+	}
+
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reason, err := abi.Arguments{{Type: stringType}}.Pack(bindings.ErrFeeDisburserIntervalNotReached.Error())
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.revert = append(crypto.Keccak256([]byte("Error(string)"))[:4], reason...)
+	result, err = s.Simulate(context.Background(), call, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(result.Err, bindings.ErrFeeDisburserIntervalNotReached) {
+		t.Fatalf("expected ErrFeeDisburserIntervalNotReached, got %v", result.Err)
+	}
+
+	if _, err := s.SimulateCalls(context.Background(), []Call{call, call}, nil); !errors.Is(err, ErrSimulateV1Unavailable) {
+		t.Fatalf("expected ErrSimulateV1Unavailable, got %v", err)
+	}
+}
+
+func TestSimulatePayload(t *testing.T) {
+	safe := common.HexToAddress("0x5afe")
+	service := &ethService{}
+	s := newTestSimulator(t, service)
+	payload := Payload{From: common.HexToAddress("0x0a"), To: safe, Data: []byte{0x1}, Overrides: make(Overrides)}
+	payload.Overrides.SetStorage(safe, common.Hash{4}, common.Hash{1})
+
+	executed := crypto.Keccak256Hash([]byte("ExecutionSuccess(bytes32,uint256)"))
+	service.result = []simBlock{{Calls: []simCallResult{{GasUsed: 80_000, Status: 1, Logs: []types.Log{{Address: safe, Topics: []common.Hash{executed}, Data: make([]byte, 64)}}}}}}
+	if _, err := s.SimulatePayload(context.Background(), payload); err != nil {
+		t.Fatal(err)
+	}
+	var req simOpts
+	if err := json.Unmarshal(service.request, &req); err != nil {
+		t.Fatal(err)
+	}
+	if diff := req.BlockStateCalls[0].StateOverrides[safe].StateDiff; diff[common.Hash{4}] != (common.Hash{1}) {
+		t.Fatalf("storage override not sent: %s", service.request)
+	}
+
+	service.result = []simBlock{{Calls: []simCallResult{{GasUsed: 30_000, Status: 1}}}}
+	if _, err := s.SimulatePayload(context.Background(), payload); !errors.Is(err, ErrNoStateChanges) {
+		t.Fatalf("expected ErrNoStateChanges, got %v", err)
+	}
+
+	stringType, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("GS013")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := append(crypto.Keccak256([]byte("Error(string)"))[:4], reason...)
+	service.result = []simBlock{{Calls: []simCallResult{{Status: 0, Error: &simError{Code: 3, Message: "execution reverted", Data: hexutil.Encode(data)}}}}}
+	if _, err := s.SimulatePayload(context.Background(), payload); err == nil || !strings.Contains(err.Error(), "GS013") {
+		t.Fatalf("expected the revert reason, got %v", err)
+	}
+
+	payload.From = common.Address{}
+	if _, err := s.SimulatePayload(context.Background(), payload); err == nil {
+		t.Fatal("expected a zero from address to be rejected")
+	}
+}
@@ -0,0 +1,117 @@
+package bindings
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// SmartEscrowAddressIsZeroAddressError is returned when a required address is not set.
+type SmartEscrowAddressIsZeroAddressError struct{}
+
+func (e *SmartEscrowAddressIsZeroAddressError) Error() string {
+	return "SmartEscrow: AddressIsZeroAddress()"
+}
+
+// SmartEscrowStartTimeAfterEndTimeError is returned when the start timestamp is not before the end timestamp.
+type SmartEscrowStartTimeAfterEndTimeError struct {
+	StartTimestamp *big.Int
+	EndTimestamp   *big.Int
+}
+
+func (e *SmartEscrowStartTimeAfterEndTimeError) Error() string {
+	return fmt.Sprintf("SmartEscrow: StartTimeAfterEndTime(%v, %v)", e.StartTimestamp, e.EndTimestamp)
+}
+
+// SmartEscrowCliffStartTimeInvalidError is returned when the cliff starts before the start timestamp.
+type SmartEscrowCliffStartTimeInvalidError struct {
+	CliffStartTimestamp *big.Int
+	StartTime           *big.Int
+}
+
+func (e *SmartEscrowCliffStartTimeInvalidError) Error() string {
+	return fmt.Sprintf("SmartEscrow: CliffStartTimeInvalid(%v, %v)", e.CliffStartTimestamp, e.StartTime)
+}
+
+// SmartEscrowCliffStartTimeAfterEndTimeError is returned when the cliff starts at or after the end timestamp.
+type SmartEscrowCliffStartTimeAfterEndTimeError struct {
+	CliffStartTimestamp *big.Int
+	EndTimestamp        *big.Int
+}
+
+func (e *SmartEscrowCliffStartTimeAfterEndTimeError) Error() string {
+	return fmt.Sprintf("SmartEscrow: CliffStartTimeAfterEndTime(%v, %v)", e.CliffStartTimestamp, e.EndTimestamp)
+}
+
+// SmartEscrowVestingPeriodIsZeroSecondsError is returned when the vesting period is zero.
+type SmartEscrowVestingPeriodIsZeroSecondsError struct{}
+
+func (e *SmartEscrowVestingPeriodIsZeroSecondsError) Error() string {
+	return "SmartEscrow: VestingPeriodIsZeroSeconds()"
+}
+
+// SmartEscrowVestingEventTokensIsZeroError is returned when the number of vesting event tokens is zero.
+type SmartEscrowVestingEventTokensIsZeroError struct{}
+
+func (e *SmartEscrowVestingEventTokensIsZeroError) Error() string {
+	return "SmartEscrow: VestingEventTokensIsZero()"
+}
+
+// SmartEscrowVestingPeriodExceedsContractDurationError is returned when the vesting period is
+// longer than the contract duration.
+type SmartEscrowVestingPeriodExceedsContractDurationError struct {
+	VestingPeriodSeconds *big.Int
+}
+
+func (e *SmartEscrowVestingPeriodExceedsContractDurationError) Error() string {
+	return fmt.Sprintf("SmartEscrow: VestingPeriodExceedsContractDuration(%v)", e.VestingPeriodSeconds)
+}
+
+// SmartEscrowUnevenVestingPeriodError is returned when the vesting period does not evenly divide
+// the contract duration.
+type SmartEscrowUnevenVestingPeriodError struct {
+	VestingPeriodSeconds *big.Int
+	StartTimestamp       *big.Int
+	EndTimestamp         *big.Int
+}
+
+func (e *SmartEscrowUnevenVestingPeriodError) Error() string {
+	return fmt.Sprintf("SmartEscrow: UnevenVestingPeriod(%v, %v, %v)", e.VestingPeriodSeconds, e.StartTimestamp, e.EndTimestamp)
+}
+
+// SmartEscrowContractIsTerminatedError is returned when the contract is terminated, when it should not be.
+type SmartEscrowContractIsTerminatedError struct{}
+
+func (e *SmartEscrowContractIsTerminatedError) Error() string {
+	return "SmartEscrow: ContractIsTerminated()"
+}
+
+// SmartEscrowContractIsNotTerminatedError is returned when the contract is not terminated, when it should be.
+type SmartEscrowContractIsNotTerminatedError struct{}
+
+func (e *SmartEscrowContractIsNotTerminatedError) Error() string {
+	return "SmartEscrow: ContractIsNotTerminated()"
+}
+
+var smartEscrowErrors = map[string]func() error{
+	"AddressIsZeroAddress":                 func() error { return new(SmartEscrowAddressIsZeroAddressError) },
+	"StartTimeAfterEndTime":                func() error { return new(SmartEscrowStartTimeAfterEndTimeError) },
+	"CliffStartTimeInvalid":                func() error { return new(SmartEscrowCliffStartTimeInvalidError) },
+	"CliffStartTimeAfterEndTime":           func() error { return new(SmartEscrowCliffStartTimeAfterEndTimeError) },
+	"VestingPeriodIsZeroSeconds":           func() error { return new(SmartEscrowVestingPeriodIsZeroSecondsError) },
+	"VestingEventTokensIsZero":             func() error { return new(SmartEscrowVestingEventTokensIsZeroError) },
+	"VestingPeriodExceedsContractDuration": func() error { return new(SmartEscrowVestingPeriodExceedsContractDurationError) },
+	"UnevenVestingPeriod":                  func() error { return new(SmartEscrowUnevenVestingPeriodError) },
+	"ContractIsTerminated":                 func() error { return new(SmartEscrowContractIsTerminatedError) },
+	"ContractIsNotTerminated":              func() error { return new(SmartEscrowContractIsNotTerminatedError) },
+}
+
+// UnpackSmartEscrowError decodes revert data returned by a SmartEscrow call into
+// one of the typed SmartEscrow errors. It reports false if the data does not
+// match any custom error declared by the contract.
+func UnpackSmartEscrowError(data []byte) (error, bool) {
+	parsed, err := SmartEscrowMetaData.GetAbi()
+	if err != nil {
+		return nil, false
+	}
+	return copyCustomError(parsed, data, smartEscrowErrors)
+}
@@ -0,0 +1,44 @@
+package bindings
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestUnpackSmartEscrowError(t *testing.T) {
+	parsed, err := SmartEscrowMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	abiErr := parsed.Errors["UnevenVestingPeriod"]
+	args, err := abiErr.Inputs.Pack(big.NewInt(7), big.NewInt(100), big.NewInt(200))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, ok := UnpackSmartEscrowError(append(abiErr.ID[:4:4], args...))
+	if !ok {
+		t.Fatal("expected UnevenVestingPeriod to be decoded")
+	}
+	var uneven *SmartEscrowUnevenVestingPeriodError
+	if !errors.As(decoded, &uneven) {
+		t.Fatalf("unexpected error type %T", decoded)
+	}
+	if uneven.VestingPeriodSeconds.Int64() != 7 || uneven.StartTimestamp.Int64() != 100 || uneven.EndTimestamp.Int64() != 200 {
+		t.Fatalf("unexpected arguments: %v", uneven)
+	}
+
+	terminated := parsed.Errors["ContractIsTerminated"]
+	decoded, ok = UnpackSmartEscrowError(terminated.ID[:4])
+	if !ok {
+		t.Fatal("expected ContractIsTerminated to be decoded")
+	}
+	if _, ok := decoded.(*SmartEscrowContractIsTerminatedError); !ok {
+		t.Fatalf("unexpected error type %T", decoded)
+	}
+
+	if _, ok := UnpackSmartEscrowError([]byte{0xde, 0xad, 0xbe, 0xef}); ok {
+		t.Fatal("expected unknown selector to be rejected")
+	}
+}
@@ -0,0 +1,270 @@
+// Package txmgr sends transactions on behalf of the keeper services. Every
+// attempt for a call reuses the same nonce and only the fees are bumped on
+// resubmission, so retrying can never result in the call being sent twice.
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ErrNonceConsumed is returned when the nonce of a call was used by a
+// transaction that is not one of the attempts made for it.
+var ErrNonceConsumed = errors.New("txmgr: nonce consumed by another transaction")
+
+// Backend is the chain access needed to send transactions and wait for them.
+type Backend interface {
+	bind.ContractTransactor
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// Config configures how transactions are resubmitted.
+type Config struct {
+	// ResubmitInterval is how long an attempt may stay unmined before it is
+	// replaced by one with bumped fees.
+	ResubmitInterval time.Duration
+	// ReceiptPollInterval is how often receipts of the attempts are queried.
+	ReceiptPollInterval time.Duration
+	// FeeBumpPercent is the percentage fees are raised by on every
+	// resubmission. Nodes reject replacements bumped by less than 10%.
+	FeeBumpPercent uint64
+	// MaxFeeCap is the highest fee cap an attempt may use, nil for no limit.
+	MaxFeeCap *big.Int
+}
+
+// DefaultConfig is a Config suitable for chains with a few seconds block time.
+var DefaultConfig = Config{
+	ResubmitInterval:    2 * time.Minute,
+	ReceiptPollInterval: 4 * time.Second,
+	FeeBumpPercent:      15,
+}
+
+// Candidate is a call to be sent.
+type Candidate struct {
+	To    common.Address
+	Data  []byte
+	Value *big.Int
+	// GasLimit of the call, estimated when zero.
+	GasLimit uint64
+}
+
+// Manager signs and sends transactions from a single account.
+type Manager struct {
+	backend Backend
+	from    common.Address
+	signer  bind.SignerFn
+	cfg     Config
+	log     log.Logger
+}
+
+// New creates a Manager sending from the account of opts.
+func New(backend Backend, opts *bind.TransactOpts, cfg Config, logger log.Logger) *Manager {
+	return &Manager{
+		backend: backend,
+		from:    opts.From,
+		signer:  opts.Signer,
+		cfg:     cfg,
+		log:     logger,
+	}
+}
+
+// From returns the account transactions are sent from.
+func (m *Manager) From() common.Address {
+	return m.from
+}
+
+// Send signs the candidate with the given nonce and broadcasts it, replacing it
+// with bumped fees every ResubmitInterval until one of the attempts is mined.
+// The prior attempts, persisted by a previous run for the same call, are waited
+// for as well so that a mined one is not mistaken for another transaction.
+// The sent callback, if set, is invoked with every attempt before it is
+// broadcast so that callers can persist it; an error aborts the send.
+func (m *Manager) Send(ctx context.Context, nonce uint64, c Candidate, prior []common.Hash, sent func(*types.Transaction) error) (*types.Receipt, error) {
+	gasLimit := c.GasLimit
+	if gasLimit == 0 {
+		estimate, err := m.backend.EstimateGas(ctx, ethereum.CallMsg{From: m.from, To: &c.To, Data: c.Data, Value: c.Value})
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+		gasLimit = estimate
+	}
+	tip, feeCap, err := m.suggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tip, feeCap = m.capFees(tip, feeCap)
+
+	attempts := append([]common.Hash(nil), prior...)
+	for {
+		tx, err := m.signer(m.from, types.NewTx(&types.DynamicFeeTx{
+			Nonce:     nonce,
+			GasTipCap: tip,
+			GasFeeCap: feeCap,
+			Gas:       gasLimit,
+			To:        &c.To,
+			Value:     c.Value,
+			Data:      c.Data,
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign transaction: %w", err)
+		}
+		if sent != nil {
+			if err := sent(tx); err != nil {
+				return nil, err
+			}
+		}
+		attempts = append(attempts, tx.Hash())
+
+		logger := m.log.New("tx", tx.Hash(), "nonce", nonce, "tip", tip, "feeCap", feeCap)
+		if err := m.backend.SendTransaction(ctx, tx); err != nil {
+			switch {
+			case strings.Contains(err.Error(), "already known"):
+			case strings.Contains(err.Error(), "replacement transaction underpriced"):
+				var bumped bool
+				if tip, feeCap, bumped = m.bumpFees(tip, feeCap, tip, feeCap); !bumped {
+					return nil, fmt.Errorf("replacement underpriced at max fee cap: %w", err)
+				}
+				logger.Warn("Replacement underpriced, bumping fees")
+				continue
+			case strings.Contains(err.Error(), "nonce too low"):
+				return m.WaitAny(ctx, nonce, attempts)
+			default:
+				return nil, fmt.Errorf("failed to send transaction: %w", err)
+			}
+		}
+		logger.Info("Sent transaction")
+
+		receipt, err := m.wait(ctx, nonce, attempts)
+		if err != nil || receipt != nil {
+			return receipt, err
+		}
+
+		freshTip, freshFeeCap, err := m.suggestFees(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tip, feeCap, _ = m.bumpFees(tip, feeCap, freshTip, freshFeeCap)
+		logger.Warn("Transaction not mined, resubmitting")
+	}
+}
+
+// WaitAny waits for one of the given attempts of a call sent with nonce to be
+// mined. It returns ErrNonceConsumed if the nonce is used by any other
+// transaction.
+func (m *Manager) WaitAny(ctx context.Context, nonce uint64, attempts []common.Hash) (*types.Receipt, error) {
+	for {
+		receipt, err := m.wait(ctx, nonce, attempts)
+		if err != nil || receipt != nil {
+			return receipt, err
+		}
+	}
+}
+
+// Confirmed reports whether nonce has been used by a mined transaction and,
+// if so, returns the receipt of whichever of the attempts was mined. The nonce
+// is read before the receipts, so that an attempt mined in between is found
+// rather than mistaken for another transaction.
+func (m *Manager) Confirmed(ctx context.Context, nonce uint64, attempts []common.Hash) (bool, *types.Receipt, error) {
+	confirmed, err := m.backend.NonceAt(ctx, m.from, nil)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to fetch nonce: %w", err)
+	}
+	for _, hash := range attempts {
+		receipt, err := m.backend.TransactionReceipt(ctx, hash)
+		if err == nil {
+			return true, receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return false, nil, fmt.Errorf("failed to fetch receipt: %w", err)
+		}
+	}
+	return confirmed > nonce, nil, nil
+}
+
+// wait polls for the receipt of any attempt for up to ResubmitInterval. It
+// returns a nil receipt and error if none was mined in that time.
+func (m *Manager) wait(ctx context.Context, nonce uint64, attempts []common.Hash) (*types.Receipt, error) {
+	deadline := time.NewTimer(m.cfg.ResubmitInterval)
+	defer deadline.Stop()
+	ticker := time.NewTicker(m.cfg.ReceiptPollInterval)
+	defer ticker.Stop()
+	for {
+		used, receipt, err := m.Confirmed(ctx, nonce, attempts)
+		if err != nil {
+			m.log.Warn("Failed to check transaction status", "err", err)
+		} else if receipt != nil {
+			return receipt, nil
+		} else if used {
+			return nil, ErrNonceConsumed
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline.C:
+			return nil, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// suggestFees returns the tip and fee cap for a new attempt. The fee cap
+// leaves room for the base fee to double before the attempt gets stuck.
+func (m *Manager) suggestFees(ctx context.Context) (*big.Int, *big.Int, error) {
+	tip, err := m.backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to suggest gas tip cap: %w", err)
+	}
+	head, err := m.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch head: %w", err)
+	}
+	if head.BaseFee == nil {
+		return nil, nil, errors.New("txmgr: chain does not support EIP-1559")
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, common.Big2))
+	return tip, feeCap, nil
+}
+
+// bumpFees raises the fees of the previous attempt by FeeBumpPercent, or to
+// the given minimums if those are higher, without exceeding MaxFeeCap. It
+// reports false if the fee cap could not be raised any further.
+func (m *Manager) bumpFees(tip, feeCap, minTip, minFeeCap *big.Int) (*big.Int, *big.Int, bool) {
+	newTip, newFeeCap := m.capFees(bigMax(m.bump(tip), minTip), bigMax(m.bump(feeCap), minFeeCap))
+	return newTip, newFeeCap, newFeeCap.Cmp(feeCap) > 0
+}
+
+// capFees limits the fee cap to MaxFeeCap, and the tip to the fee cap.
+func (m *Manager) capFees(tip, feeCap *big.Int) (*big.Int, *big.Int) {
+	if m.cfg.MaxFeeCap != nil && feeCap.Cmp(m.cfg.MaxFeeCap) > 0 {
+		feeCap = new(big.Int).Set(m.cfg.MaxFeeCap)
+	}
+	if tip.Cmp(feeCap) > 0 {
+		tip = new(big.Int).Set(feeCap)
+	}
+	return tip, feeCap
+}
+
+// bump raises v by FeeBumpPercent, rounding up so that small values still grow.
+func (m *Manager) bump(v *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(v, new(big.Int).SetUint64(100+m.cfg.FeeBumpPercent))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+func bigMax(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
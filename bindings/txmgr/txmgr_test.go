@@ -0,0 +1,197 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestSendResubmitsWithSameNonce(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{from: {Balance: big.NewInt(params.Ether)}})
+	defer backend.Close()
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := New(backend.Client(), opts, Config{
+		ResubmitInterval:    50 * time.Millisecond,
+		ReceiptPollInterval: 10 * time.Millisecond,
+		FeeBumpPercent:      10,
+	}, log.Root())
+
+	// Only mine once the first attempt has been replaced.
+	var attempts []*types.Transaction
+	sent := func(tx *types.Transaction) error {
+		attempts = append(attempts, tx)
+		if len(attempts) == 2 {
+			go backend.Commit()
+		}
+		return nil
+	}
+	to := common.HexToAddress("0x1234")
+	receipt, err := m.Send(context.Background(), 0, Candidate{To: to, Value: big.NewInt(1)}, nil, sent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(attempts) < 2 {
+		t.Fatalf("expected a resubmission, got %d attempts", len(attempts))
+	}
+	for _, tx := range attempts {
+		if tx.Nonce() != 0 {
+			t.Fatalf("attempt %s used nonce %d", tx.Hash(), tx.Nonce())
+		}
+	}
+	if attempts[1].GasFeeCap().Cmp(attempts[0].GasFeeCap()) <= 0 {
+		t.Fatalf("fee cap not bumped: %v -> %v", attempts[0].GasFeeCap(), attempts[1].GasFeeCap())
+	}
+	if receipt.TxHash != attempts[1].Hash() {
+		t.Fatalf("mined %s, want the replacement %s", receipt.TxHash, attempts[1].Hash())
+	}
+	balance, err := backend.Client().BalanceAt(context.Background(), to, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance.Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("recipient balance %v, want 1", balance)
+	}
+}
+
+func TestSendFindsPriorAttempt(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{from: {Balance: big.NewInt(params.Ether)}})
+	defer backend.Close()
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := New(backend.Client(), opts, Config{
+		ResubmitInterval:    50 * time.Millisecond,
+		ReceiptPollInterval: 10 * time.Millisecond,
+		FeeBumpPercent:      10,
+	}, log.Root())
+
+	// An attempt of a previous run got mined while the sender was down.
+	to := common.HexToAddress("0x1234")
+	prior, err := opts.Signer(from, types.NewTx(&types.DynamicFeeTx{
+		Nonce:     0,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(10 * params.GWei),
+		Gas:       21_000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := backend.Client().SendTransaction(context.Background(), prior); err != nil {
+		t.Fatal(err)
+	}
+	backend.Commit()
+
+	receipt, err := m.Send(context.Background(), 0, Candidate{To: to, Value: big.NewInt(1), GasLimit: 21_000}, []common.Hash{prior.Hash()}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.TxHash != prior.Hash() {
+		t.Fatalf("got receipt of %s, want the prior attempt %s", receipt.TxHash, prior.Hash())
+	}
+}
+
+// mineOnLookup mines the pending transactions right after the first receipt
+// lookup, as if an attempt was included while its status is being checked.
+type mineOnLookup struct {
+	simulated.Client
+	backend *simulated.Backend
+	mined   bool
+}
+
+func (b *mineOnLookup) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, err := b.Client.TransactionReceipt(ctx, txHash)
+	if !b.mined {
+		b.mined = true
+		b.backend.Commit()
+	}
+	return receipt, err
+}
+
+func TestConfirmedAttemptMinedWhileChecking(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	backend := simulated.NewBackend(types.GenesisAlloc{from: {Balance: big.NewInt(params.Ether)}})
+	defer backend.Close()
+	opts, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &mineOnLookup{Client: backend.Client(), backend: backend}
+	m := New(client, opts, DefaultConfig, log.Root())
+	backend.Commit()
+
+	to := common.HexToAddress("0x1234")
+	tx, err := opts.Signer(from, types.NewTx(&types.DynamicFeeTx{
+		Nonce:     0,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(10 * params.GWei),
+		Gas:       21_000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The attempt is mined after its receipt was looked up, the nonce must
+	// not be reported as used by another transaction.
+	used, receipt, err := m.Confirmed(context.Background(), 0, []common.Hash{tx.Hash()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used || receipt != nil {
+		t.Fatalf("got used %v, receipt %v before the attempt was seen mined", used, receipt)
+	}
+	used, receipt, err = m.Confirmed(context.Background(), 0, []common.Hash{tx.Hash()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !used || receipt == nil || receipt.TxHash != tx.Hash() {
+		t.Fatalf("got used %v, receipt %v, want the receipt of %s", used, receipt, tx.Hash())
+	}
+}
+
+func TestBumpFeesRespectsMaxFeeCap(t *testing.T) {
+	m := &Manager{cfg: Config{FeeBumpPercent: 10, MaxFeeCap: big.NewInt(105)}}
+
+	tip, feeCap, bumped := m.bumpFees(big.NewInt(10), big.NewInt(100), big.NewInt(0), big.NewInt(0))
+	if !bumped || tip.Int64() != 11 || feeCap.Int64() != 105 {
+		t.Fatalf("got tip %v, fee cap %v, bumped %v", tip, feeCap, bumped)
+	}
+	if _, _, bumped := m.bumpFees(tip, feeCap, tip, feeCap); bumped {
+		t.Fatal("expected fee cap to be exhausted")
+	}
+}
@@ -0,0 +1,55 @@
+package bindings
+
+// Vetoer1of2OpSignerCantBeZeroAddressError is returned at deployment if opSigner is the zero address.
+type Vetoer1of2OpSignerCantBeZeroAddressError struct{}
+
+func (e *Vetoer1of2OpSignerCantBeZeroAddressError) Error() string {
+	return "Vetoer1of2: OpSignerCantBeZeroAddress()"
+}
+
+// Vetoer1of2OtherSignerCantBeZeroAddressError is returned at deployment if otherSigner is the zero address.
+type Vetoer1of2OtherSignerCantBeZeroAddressError struct{}
+
+func (e *Vetoer1of2OtherSignerCantBeZeroAddressError) Error() string {
+	return "Vetoer1of2: OtherSignerCantBeZeroAddress()"
+}
+
+// Vetoer1of2InitiatorCantBeZeroAddressError is returned at deployment if initiator is the zero address.
+type Vetoer1of2InitiatorCantBeZeroAddressError struct{}
+
+func (e *Vetoer1of2InitiatorCantBeZeroAddressError) Error() string {
+	return "Vetoer1of2: InitiatorCantBeZeroAddress()"
+}
+
+// Vetoer1of2TargetCantBeZeroAddressError is returned at deployment if target is the zero address.
+type Vetoer1of2TargetCantBeZeroAddressError struct{}
+
+func (e *Vetoer1of2TargetCantBeZeroAddressError) Error() string {
+	return "Vetoer1of2: TargetCantBeZeroAddress()"
+}
+
+// Vetoer1of2SenderIsNotWhitelistedSignerError is returned when veto is called by neither signer.
+type Vetoer1of2SenderIsNotWhitelistedSignerError struct{}
+
+func (e *Vetoer1of2SenderIsNotWhitelistedSignerError) Error() string {
+	return "Vetoer1of2: SenderIsNotWhitelistedSigner()"
+}
+
+var vetoer1of2Errors = map[string]func() error{
+	"OpSignerCantBeZeroAddress":    func() error { return new(Vetoer1of2OpSignerCantBeZeroAddressError) },
+	"OtherSignerCantBeZeroAddress": func() error { return new(Vetoer1of2OtherSignerCantBeZeroAddressError) },
+	"InitiatorCantBeZeroAddress":   func() error { return new(Vetoer1of2InitiatorCantBeZeroAddressError) },
+	"TargetCantBeZeroAddress":      func() error { return new(Vetoer1of2TargetCantBeZeroAddressError) },
+	"SenderIsNotWhitelistedSigner": func() error { return new(Vetoer1of2SenderIsNotWhitelistedSignerError) },
+}
+
+// UnpackVetoer1of2Error decodes revert data returned by a Vetoer1of2 call into
+// one of the typed Vetoer1of2 errors. It reports false if the data does not
+// match any custom error declared by the contract.
+func UnpackVetoer1of2Error(data []byte) (error, bool) {
+	parsed, err := Vetoer1of2MetaData.GetAbi()
+	if err != nil {
+		return nil, false
+	}
+	return copyCustomError(parsed, data, vetoer1of2Errors)
+}
@@ -0,0 +1,23 @@
+package bindings
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestUnpackVetoer1of2Error(t *testing.T) {
+	selector := crypto.Keccak256([]byte("SenderIsNotWhitelistedSigner()"))[:4]
+
+	decoded, ok := UnpackVetoer1of2Error(selector)
+	if !ok {
+		t.Fatal("expected SenderIsNotWhitelistedSigner to be decoded")
+	}
+	if _, ok := decoded.(*Vetoer1of2SenderIsNotWhitelistedSignerError); !ok {
+		t.Fatalf("unexpected error type %T", decoded)
+	}
+
+	if _, ok := UnpackVetoer1of2Error(selector[:3]); ok {
+		t.Fatal("expected truncated selector to be rejected")
+	}
+}
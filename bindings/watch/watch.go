@@ -0,0 +1,344 @@
+// Package watch delivers contract events without gaps across dropped
+// connections. Unlike the Watch functions of the bindings, a Watcher
+// resubscribes with backoff when its subscription fails, backfills the blocks
+// it missed, redelivers as removed the events a reorg dropped in the meantime,
+// drops duplicates and delivers the events in chain order.
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// dedupDepth is how many blocks behind the latest delivered event the
+// delivered logs are remembered for deduplication.
+const dedupDepth = 256
+
+// Backend is the chain access needed by a Watcher. Subscribing requires a
+// websocket or IPC connection.
+type Backend interface {
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Source is an event to watch.
+type Source[T any] struct {
+	// Query selects the logs of the event. Its block range is ignored.
+	Query ethereum.FilterQuery
+	// Parse decodes a log, usually the Parse method of a binding's filterer.
+	Parse func(types.Log) (T, error)
+}
+
+// EventSource returns the Source of the named event of the contract described
+// by metadata, deployed at address.
+//
+//	src, err := watch.EventSource(address, bindings.FeeDisburserMetaData, "FeesDisbursed", filterer.ParseFeesDisbursed)
+func EventSource[T any](address common.Address, metadata *bind.MetaData, event string, parse func(types.Log) (T, error)) (Source[T], error) {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return Source[T]{}, err
+	}
+	ev, ok := parsed.Events[event]
+	if !ok {
+		return Source[T]{}, fmt.Errorf("watch: no event %s in ABI", event)
+	}
+	return Source[T]{
+		Query: ethereum.FilterQuery{Addresses: []common.Address{address}, Topics: [][]common.Hash{{ev.ID}}},
+		Parse: parse,
+	}, nil
+}
+
+// Config configures a Watcher.
+type Config struct {
+	// FromBlock is the first block whose events are delivered, nil to only
+	// deliver events from blocks after the head at the time Run is called.
+	FromBlock *uint64
+	// ChunkSize is the largest number of blocks backfilled at once.
+	ChunkSize uint64
+	// MinBackoff is the delay before resubscribing after a failure, doubled
+	// on every consecutive failure up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultConfig is a Config delivering events from the head on.
+var DefaultConfig = Config{
+	ChunkSize:  2000,
+	MinBackoff: time.Second,
+	MaxBackoff: time.Minute,
+}
+
+// logKey identifies a log for deduplication.
+type logKey struct {
+	txHash common.Hash
+	index  uint
+}
+
+// Watcher delivers the events of a Source.
+type Watcher[T any] struct {
+	cfg     Config
+	backend Backend
+	src     Source[T]
+	log     log.Logger
+
+	// start is the first block delivered and next the first block not yet
+	// fully delivered.
+	start   uint64
+	next    uint64
+	started bool
+	// synced is set once a session backfilled up to the head, from then on
+	// the blocks delivered before a failure are rechecked for reorgs.
+	synced bool
+	// delivered holds the recently delivered logs.
+	delivered map[logKey]types.Log
+	// latest is the position of the latest delivered log.
+	latest *types.Log
+}
+
+// New creates a Watcher.
+func New[T any](cfg Config, backend Backend, src Source[T], logger log.Logger) (*Watcher[T], error) {
+	if cfg.ChunkSize == 0 {
+		return nil, errors.New("watch: chunk size must be positive")
+	}
+	w := &Watcher[T]{
+		cfg:       cfg,
+		backend:   backend,
+		src:       src,
+		log:       logger,
+		delivered: make(map[logKey]types.Log),
+	}
+	if cfg.FromBlock != nil {
+		w.start, w.next = *cfg.FromBlock, *cfg.FromBlock
+		w.started = true
+	}
+	return w, nil
+}
+
+// Run delivers events to sink until ctx is cancelled. Logs removed by a reorg
+// are delivered again with their Removed flag set, as the subscriptions of
+// the node do.
+func (w *Watcher[T]) Run(ctx context.Context, sink chan<- T) error {
+	backoff := w.cfg.MinBackoff
+	for {
+		err := w.session(ctx, sink)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if errors.Is(err, errSubscriptionDropped) {
+			// The subscription worked until now, retry quickly.
+			backoff = w.cfg.MinBackoff
+		}
+		w.log.Warn("Event subscription failed, resubscribing", "err", err, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, w.cfg.MaxBackoff)
+	}
+}
+
+var errSubscriptionDropped = errors.New("watch: subscription dropped")
+
+// session subscribes, backfills the blocks missed since the last session and
+// then delivers live logs until the subscription fails.
+func (w *Watcher[T]) session(ctx context.Context, sink chan<- T) error {
+	logs := make(chan types.Log, 128)
+	sub, err := w.backend.SubscribeFilterLogs(ctx, w.src.Query, logs)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	// Logs of blocks up to the head are fetched by the backfill, and those
+	// also delivered by the subscription are dropped as duplicates.
+	head, err := w.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch head: %w", err)
+	}
+	if !w.started {
+		w.start, w.next = head.Number.Uint64()+1, head.Number.Uint64()+1
+		w.started = true
+	}
+	if w.synced {
+		err = w.recheck(ctx, head.Number.Uint64(), sink)
+	} else {
+		err = w.backfill(ctx, head.Number.Uint64(), sink)
+	}
+	if err != nil {
+		return err
+	}
+	w.synced = true
+
+	for {
+		select {
+		case l := <-logs:
+			if err := w.deliver(ctx, l, sink); err != nil {
+				return err
+			}
+			if !l.Removed && l.BlockNumber > w.next {
+				// The subscription delivers all logs of a block at once, so
+				// earlier blocks are complete.
+				w.next = l.BlockNumber
+			}
+		case err := <-sub.Err():
+			return fmt.Errorf("%w: %v", errSubscriptionDropped, err)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// recheck backfills the blocks missed since the previous session failed,
+// starting dedupDepth blocks before them. Delivered logs the node no longer
+// returns, or returns from another block, were removed by a reorg the
+// subscription missed: they are delivered again with their Removed flag set,
+// latest first, before the logs of the new chain.
+func (w *Watcher[T]) recheck(ctx context.Context, head uint64, sink chan<- T) error {
+	from := w.start
+	if w.next-w.start > dedupDepth {
+		from = w.next - dedupDepth
+	}
+	if from > head {
+		return nil
+	}
+	logs, err := w.filter(ctx, from, head)
+	if err != nil {
+		return fmt.Errorf("failed to recheck blocks %d-%d: %w", from, head, err)
+	}
+	current := make(map[logKey]common.Hash, len(logs))
+	for _, l := range logs {
+		current[logKey{l.TxHash, l.Index}] = l.BlockHash
+	}
+	var removed []types.Log
+	for key, l := range w.delivered {
+		if l.BlockNumber < from {
+			continue
+		}
+		if hash, ok := current[key]; !ok || hash != l.BlockHash {
+			removed = append(removed, l)
+		}
+	}
+	if len(removed) > 0 {
+		w.log.Warn("Delivered events removed by a reorg", "from", from, "to", head, "events", len(removed))
+	}
+	sort.Slice(removed, func(i, j int) bool { return before(&removed[j], &removed[i]) })
+	for _, l := range removed {
+		l.Removed = true
+		if err := w.deliver(ctx, l, sink); err != nil {
+			return err
+		}
+	}
+	for _, l := range logs {
+		if err := w.deliver(ctx, l, sink); err != nil {
+			return err
+		}
+	}
+	w.next = max(w.next, head+1)
+	return nil
+}
+
+// backfill delivers the logs of the blocks from next to head.
+func (w *Watcher[T]) backfill(ctx context.Context, head uint64, sink chan<- T) error {
+	for w.next <= head {
+		to := min(w.next+w.cfg.ChunkSize-1, head)
+		logs, err := w.filter(ctx, w.next, to)
+		if err != nil {
+			return fmt.Errorf("failed to backfill blocks %d-%d: %w", w.next, to, err)
+		}
+		for _, l := range logs {
+			if err := w.deliver(ctx, l, sink); err != nil {
+				return err
+			}
+		}
+		if len(logs) > 0 {
+			w.log.Info("Backfilled events", "from", w.next, "to", to, "events", len(logs))
+		}
+		w.next = to + 1
+	}
+	return nil
+}
+
+// filter fetches the logs of the blocks from from to to, in chunks of at most
+// ChunkSize blocks.
+func (w *Watcher[T]) filter(ctx context.Context, from, to uint64) ([]types.Log, error) {
+	var logs []types.Log
+	for from <= to {
+		end := min(from+w.cfg.ChunkSize-1, to)
+		query := w.src.Query
+		query.FromBlock = new(big.Int).SetUint64(from)
+		query.ToBlock = new(big.Int).SetUint64(end)
+		chunk, err := w.backend.FilterLogs(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, chunk...)
+		from = end + 1
+	}
+	return logs, nil
+}
+
+// deliver parses l and sends it to sink unless it is a duplicate. A new log
+// older than the latest delivered one is from a block replaced by a reorg
+// that removed none of the delivered logs, it is delivered all the same.
+func (w *Watcher[T]) deliver(ctx context.Context, l types.Log, sink chan<- T) error {
+	key := logKey{l.TxHash, l.Index}
+	if l.Removed {
+		if _, ok := w.delivered[key]; !ok {
+			return nil
+		}
+		delete(w.delivered, key)
+	} else {
+		if _, ok := w.delivered[key]; ok {
+			return nil
+		}
+		if w.latest != nil && !w.latest.Removed && before(&l, w.latest) {
+			w.log.Warn("Delivering log older than the latest after a reorg", "block", l.BlockNumber, "index", l.Index, "tx", l.TxHash, "latest", w.latest.BlockNumber)
+		}
+		w.delivered[key] = l
+	}
+
+	ev, err := w.src.Parse(l)
+	if err != nil {
+		w.log.Error("Failed to parse log", "block", l.BlockNumber, "index", l.Index, "tx", l.TxHash, "err", err)
+		return nil
+	}
+	select {
+	case sink <- ev:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	w.latest = &l
+	w.prune(l.BlockNumber)
+	return nil
+}
+
+// prune forgets the logs delivered more than dedupDepth blocks before number.
+func (w *Watcher[T]) prune(number uint64) {
+	if number < dedupDepth {
+		return
+	}
+	for key, l := range w.delivered {
+		if l.BlockNumber < number-dedupDepth {
+			delete(w.delivered, key)
+		}
+	}
+}
+
+// before reports whether a precedes b in the chain.
+func before(a, b *types.Log) bool {
+	if a.BlockNumber != b.BlockNumber {
+		return a.BlockNumber < b.BlockNumber
+	}
+	return a.Index < b.Index
+}
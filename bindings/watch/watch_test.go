@@ -0,0 +1,225 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// fakeBackend serves logs from memory and hands every subscription to the
+// test through subs.
+type fakeBackend struct {
+	mu       sync.Mutex
+	head     uint64
+	logs     []types.Log
+	failures int
+	subs     chan *fakeSub
+}
+
+type fakeSub struct {
+	logs chan<- types.Log
+	errc chan error
+}
+
+func (b *fakeBackend) add(l types.Log) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.logs = append(b.logs, l)
+	b.head = max(b.head, l.BlockNumber)
+}
+
+// reorg replaces the logs from block from on with logs.
+func (b *fakeBackend) reorg(from uint64, logs ...types.Log) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	kept := b.logs[:0]
+	for _, l := range b.logs {
+		if l.BlockNumber < from {
+			kept = append(kept, l)
+		}
+	}
+	b.logs = append(kept, logs...)
+	for _, l := range logs {
+		b.head = max(b.head, l.BlockNumber)
+	}
+}
+
+func (b *fakeBackend) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var logs []types.Log
+	for _, l := range b.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func (b *fakeBackend) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	b.mu.Lock()
+	if b.failures > 0 {
+		b.failures--
+		b.mu.Unlock()
+		return nil, errors.New("connection refused")
+	}
+	b.mu.Unlock()
+	s := &fakeSub{logs: ch, errc: make(chan error, 1)}
+	b.subs <- s
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		select {
+		case err := <-s.errc:
+			return err
+		case <-quit:
+			return nil
+		}
+	}), nil
+}
+
+func (b *fakeBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return &types.Header{Number: new(big.Int).SetUint64(b.head)}, nil
+}
+
+func testLog(block uint64, index uint) types.Log {
+	return types.Log{BlockNumber: block, Index: index, TxHash: common.BigToHash(new(big.Int).SetUint64(block))}
+}
+
+func receive(t *testing.T, sink <-chan types.Log, want ...types.Log) {
+	t.Helper()
+	for _, w := range want {
+		select {
+		case got := <-sink:
+			if got.BlockNumber != w.BlockNumber || got.Index != w.Index || got.Removed != w.Removed {
+				t.Fatalf("got log %d/%d (removed %v), want %d/%d (removed %v)", got.BlockNumber, got.Index, got.Removed, w.BlockNumber, w.Index, w.Removed)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for log %d/%d", w.BlockNumber, w.Index)
+		}
+	}
+	select {
+	case got := <-sink:
+		t.Fatalf("unexpected log %d/%d", got.BlockNumber, got.Index)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	backend := &fakeBackend{failures: 1, subs: make(chan *fakeSub)}
+	backend.add(testLog(1, 0))
+	backend.add(testLog(2, 0))
+
+	from := uint64(0)
+	cfg := Config{FromBlock: &from, ChunkSize: 1, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+	src := Source[types.Log]{Parse: func(l types.Log) (types.Log, error) { return l, nil }}
+	w, err := New(cfg, backend, src, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sink := make(chan types.Log)
+	done := make(chan error, 1)
+	go func() { done <- w.Run(ctx, sink) }()
+
+	// The first subscription attempt fails, the second backfills blocks 0-2.
+	sub := <-backend.subs
+	receive(t, sink, testLog(1, 0), testLog(2, 0))
+
+	// Live logs already delivered by the backfill are dropped.
+	sub.logs <- testLog(2, 0)
+	sub.logs <- testLog(3, 0)
+	receive(t, sink, testLog(3, 0))
+
+	// Logs emitted while the subscription is down are backfilled.
+	sub.errc <- errors.New("websocket closed")
+	backend.add(testLog(3, 0))
+	backend.add(testLog(4, 0))
+	backend.add(testLog(4, 1))
+	sub = <-backend.subs
+	receive(t, sink, testLog(4, 0), testLog(4, 1))
+
+	// A reorg removes a delivered log, which may then be delivered again.
+	removed := testLog(4, 1)
+	removed.Removed = true
+	sub.logs <- removed
+	sub.logs <- testLog(4, 1)
+	sub.logs <- testLog(5, 0)
+	receive(t, sink, removed, testLog(4, 1), testLog(5, 0))
+
+	// A reorg that removes no delivered log can still add logs to the blocks
+	// it replaced, below the latest delivered one.
+	replaced := testLog(4, 2)
+	replaced.BlockHash = common.HexToHash("0x4b")
+	sub.logs <- replaced
+	receive(t, sink, replaced)
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run returned %v, want context.Canceled", err)
+	}
+}
+
+func TestWatcherRedeliversLogsReorgedWhileDisconnected(t *testing.T) {
+	backend := &fakeBackend{subs: make(chan *fakeSub)}
+	backend.add(testLog(1, 0))
+	backend.add(testLog(2, 0))
+	backend.add(testLog(3, 0))
+
+	from := uint64(0)
+	cfg := Config{FromBlock: &from, ChunkSize: 2, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+	src := Source[types.Log]{Parse: func(l types.Log) (types.Log, error) { return l, nil }}
+	w, err := New(cfg, backend, src, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sink := make(chan types.Log)
+	go w.Run(ctx, sink)
+
+	sub := <-backend.subs
+	receive(t, sink, testLog(1, 0), testLog(2, 0), testLog(3, 0))
+
+	// Blocks 2 and 3 are reorged out while disconnected. The log of block 3
+	// is included in another block 3, that of block 2 is dropped.
+	sub.errc <- errors.New("websocket closed")
+	moved := testLog(3, 0)
+	moved.BlockHash = common.HexToHash("0x3b")
+	backend.reorg(2, moved, testLog(4, 0))
+	<-backend.subs
+
+	removed2, removed3 := testLog(2, 0), testLog(3, 0)
+	removed2.Removed, removed3.Removed = true, true
+	receive(t, sink, removed3, removed2, moved, testLog(4, 0))
+}
+
+func TestWatcherStartsAtHead(t *testing.T) {
+	backend := &fakeBackend{subs: make(chan *fakeSub)}
+	backend.add(testLog(7, 0))
+
+	cfg := DefaultConfig
+	src := Source[types.Log]{Parse: func(l types.Log) (types.Log, error) { return l, nil }}
+	w, err := New(cfg, backend, src, log.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sink := make(chan types.Log)
+	go w.Run(ctx, sink)
+
+	sub := <-backend.subs
+	sub.logs <- testLog(8, 0)
+	receive(t, sink, testLog(8, 0))
+}
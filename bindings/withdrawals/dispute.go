@@ -0,0 +1,194 @@
+package withdrawals
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// optimismPortal2ABI holds the functions of the fault-proof OptimismPortal2
+// proving withdrawals against dispute games.
+const optimismPortal2ABI = `[{"type":"function","name":"provenWithdrawals","inputs":[{"name":"","type":"bytes32"},{"name":"","type":"address"}],"outputs":[{"name":"disputeGameProxy","type":"address"},{"name":"timestamp","type":"uint64"}],"stateMutability":"view"},{"type":"function","name":"disputeGameFactory","inputs":[],"outputs":[{"name":"","type":"address"}],"stateMutability":"view"},{"type":"function","name":"respectedGameType","inputs":[],"outputs":[{"name":"","type":"uint32"}],"stateMutability":"view"},{"type":"function","name":"respectedGameTypeUpdatedAt","inputs":[],"outputs":[{"name":"","type":"uint64"}],"stateMutability":"view"},{"type":"function","name":"disputeGameBlacklist","inputs":[{"name":"","type":"address"}],"outputs":[{"name":"","type":"bool"}],"stateMutability":"view"},{"type":"function","name":"proofMaturityDelaySeconds","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"},{"type":"function","name":"disputeGameFinalityDelaySeconds","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"}]`
+
+// disputeGameFactoryABI holds the functions of the DisputeGameFactory listing
+// dispute games.
+const disputeGameFactoryABI = `[{"type":"function","name":"gameCount","inputs":[],"outputs":[{"name":"gameCount_","type":"uint256"}],"stateMutability":"view"},{"type":"function","name":"findLatestGames","inputs":[{"name":"_gameType","type":"uint32"},{"name":"_start","type":"uint256"},{"name":"_n","type":"uint256"}],"outputs":[{"name":"games_","type":"tuple[]","components":[{"name":"index","type":"uint256"},{"name":"metadata","type":"bytes32"},{"name":"timestamp","type":"uint64"},{"name":"rootClaim","type":"bytes32"},{"name":"extraData","type":"bytes"}]}],"stateMutability":"view"}]`
+
+// disputeGameABI holds the functions of a dispute game checked by the
+// OptimismPortal2 before finalizing a withdrawal.
+const disputeGameABI = `[{"type":"function","name":"gameType","inputs":[],"outputs":[{"name":"gameType_","type":"uint32"}],"stateMutability":"view"},{"type":"function","name":"status","inputs":[],"outputs":[{"name":"","type":"uint8"}],"stateMutability":"view"},{"type":"function","name":"createdAt","inputs":[],"outputs":[{"name":"","type":"uint64"}],"stateMutability":"view"},{"type":"function","name":"resolvedAt","inputs":[],"outputs":[{"name":"","type":"uint64"}],"stateMutability":"view"}]`
+
+var (
+	optimismPortal2    = mustParse(optimismPortal2ABI)
+	disputeGameFactory = mustParse(disputeGameFactoryABI)
+	disputeGame        = mustParse(disputeGameABI)
+)
+
+// The GameStatus of a dispute game.
+const (
+	gameInProgress uint8 = iota
+	gameChallengerWins
+	gameDefenderWins
+)
+
+// gameSearchDepth is the number of latest dispute games of the respected game
+// type searched for one to prove a withdrawal against.
+const gameSearchDepth = 20
+
+// gameSearchResult is the GameSearchResult tuple of the DisputeGameFactory.
+type gameSearchResult struct {
+	Index     *big.Int
+	Metadata  [32]byte
+	Timestamp uint64
+	RootClaim [32]byte
+	// ExtraData starts with the L2 block number of the output root claimed.
+	ExtraData []byte
+}
+
+// call calls a method returning a single value.
+func call(c *bind.BoundContract, opts *bind.CallOpts, method string, args ...interface{}) (interface{}, error) {
+	var out []interface{}
+	if err := c.Call(opts, &out, method, args...); err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", method, err)
+	}
+	return out[0], nil
+}
+
+// proveAgainstGame builds the proof of w against the latest dispute game of
+// the respected game type covering its L2 block, skipping games that were
+// lost, blacklisted, created before the game type was respected, or whose
+// root claim doesn't match the L2 chain and will be lost.
+func (p *Portal) proveAgainstGame(ctx context.Context, w *Withdrawal) (*Proof, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	gameType, err := call(p.portal2, opts, "respectedGameType")
+	if err != nil {
+		return nil, err
+	}
+	respectedSince, err := call(p.portal2, opts, "respectedGameTypeUpdatedAt")
+	if err != nil {
+		return nil, err
+	}
+	count, err := call(p.factory, opts, "gameCount")
+	if err != nil {
+		return nil, err
+	}
+	if count.(*big.Int).Sign() == 0 {
+		return nil, ErrNotProposed
+	}
+	start := new(big.Int).Sub(count.(*big.Int), common.Big1)
+	found, err := call(p.factory, opts, "findLatestGames", gameType, start, big.NewInt(gameSearchDepth))
+	if err != nil {
+		return nil, err
+	}
+	// The games are the latest first.
+	for _, g := range *abi.ConvertType(found, new([]gameSearchResult)).(*[]gameSearchResult) {
+		if len(g.ExtraData) < 32 {
+			continue
+		}
+		l2Block := new(big.Int).SetBytes(g.ExtraData[:32])
+		if !l2Block.IsUint64() {
+			continue
+		}
+		if l2Block.Uint64() < w.BlockNumber {
+			break
+		}
+		if g.Timestamp < respectedSince.(uint64) {
+			// The next games are older, created before it too.
+			break
+		}
+		// GameId packs the game type, the creation timestamp and the game.
+		proxy := common.BytesToAddress(g.Metadata[12:])
+		status, err := call(bind.NewBoundContract(proxy, disputeGame, p.l1, nil, nil), opts, "status")
+		if err != nil {
+			return nil, err
+		}
+		if status.(uint8) == gameChallengerWins {
+			continue
+		}
+		blacklisted, err := call(p.portal2, opts, "disputeGameBlacklist", proxy)
+		if err != nil {
+			return nil, err
+		}
+		if blacklisted.(bool) {
+			continue
+		}
+		rootProof, account, err := p.outputRootProof(ctx, w, l2Block.Uint64())
+		if err != nil {
+			return nil, err
+		}
+		if rootProof.OutputRoot() != g.RootClaim {
+			continue
+		}
+		return withdrawalProof(w, g.Index, rootProof, account, l2Block.Uint64())
+	}
+	return nil, ErrNotProposed
+}
+
+// gameMaturity returns the L1 timestamp after which a withdrawal proven
+// against a dispute game can be finalized, checking the game like
+// OptimismPortal2.checkWithdrawal.
+func (p *Portal) gameMaturity(opts *bind.CallOpts, proven *ProvenWithdrawal) (uint64, error) {
+	game := bind.NewBoundContract(proven.DisputeGame, disputeGame, p.l1, nil, nil)
+	blacklisted, err := call(p.portal2, opts, "disputeGameBlacklist", proven.DisputeGame)
+	if err != nil {
+		return 0, err
+	}
+	if blacklisted.(bool) {
+		return 0, fmt.Errorf("%w: dispute game %s blacklisted", ErrInvalidProof, proven.DisputeGame)
+	}
+	respected, err := call(p.portal2, opts, "respectedGameType")
+	if err != nil {
+		return 0, err
+	}
+	gameType, err := call(game, opts, "gameType")
+	if err != nil {
+		return 0, err
+	}
+	if gameType.(uint32) != respected.(uint32) {
+		return 0, fmt.Errorf("%w: dispute game %s of type %d, respected type is %d", ErrInvalidProof, proven.DisputeGame, gameType, respected)
+	}
+	createdAt, err := call(game, opts, "createdAt")
+	if err != nil {
+		return 0, err
+	}
+	if proven.Timestamp <= createdAt.(uint64) {
+		return 0, fmt.Errorf("%w: proven before dispute game %s was created", ErrInvalidProof, proven.DisputeGame)
+	}
+	respectedSince, err := call(p.portal2, opts, "respectedGameTypeUpdatedAt")
+	if err != nil {
+		return 0, err
+	}
+	if createdAt.(uint64) < respectedSince.(uint64) {
+		return 0, fmt.Errorf("%w: dispute game %s created before the respected game type was updated", ErrInvalidProof, proven.DisputeGame)
+	}
+	status, err := call(game, opts, "status")
+	if err != nil {
+		return 0, err
+	}
+	switch status.(uint8) {
+	case gameDefenderWins:
+	case gameChallengerWins:
+		return 0, fmt.Errorf("%w: dispute game %s lost", ErrInvalidProof, proven.DisputeGame)
+	default:
+		return 0, fmt.Errorf("%w: dispute game %s", ErrGameInProgress, proven.DisputeGame)
+	}
+	resolvedAt, err := call(game, opts, "resolvedAt")
+	if err != nil {
+		return 0, err
+	}
+	maturityDelay, err := call(p.portal2, opts, "proofMaturityDelaySeconds")
+	if err != nil {
+		return 0, err
+	}
+	finalityDelay, err := call(p.portal2, opts, "disputeGameFinalityDelaySeconds")
+	if err != nil {
+		return 0, err
+	}
+	// The portal requires the proof to have matured and the resolution of
+	// the game to be past the air gap.
+	return max(proven.Timestamp+maturityDelay.(*big.Int).Uint64(), resolvedAt.(uint64)+finalityDelay.(*big.Int).Uint64()), nil
+}
@@ -0,0 +1,396 @@
+package withdrawals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+// l2OutputOracleABI holds the functions of the L2OutputOracle reading the
+// proposed L2 outputs.
+const l2OutputOracleABI = `[{"type":"function","name":"FINALIZATION_PERIOD_SECONDS","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"},{"type":"function","name":"latestBlockNumber","inputs":[],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"},{"type":"function","name":"getL2OutputIndexAfter","inputs":[{"name":"_l2BlockNumber","type":"uint256"}],"outputs":[{"name":"","type":"uint256"}],"stateMutability":"view"},{"type":"function","name":"getL2Output","inputs":[{"name":"_l2OutputIndex","type":"uint256"}],"outputs":[{"name":"","type":"tuple","components":[{"name":"outputRoot","type":"bytes32"},{"name":"timestamp","type":"uint128"},{"name":"l2BlockNumber","type":"uint128"}]}],"stateMutability":"view"}]`
+
+var l2OutputOracle = mustParse(l2OutputOracleABI)
+
+var (
+	// ErrNotProposed is returned when proving a withdrawal initiated after
+	// the latest L2 output proposed to the L2OutputOracle, or after the
+	// latest valid dispute game of the respected game type.
+	ErrNotProposed = errors.New("withdrawals: no L2 output proposed for the withdrawal block yet")
+	// ErrInvalidProof is returned for a proven withdrawal that must be
+	// proven again before it can be finalized.
+	ErrInvalidProof = errors.New("withdrawals: proof no longer valid")
+	// ErrGameInProgress is returned for a withdrawal proven against a
+	// dispute game that isn't resolved yet.
+	ErrGameInProgress = errors.New("withdrawals: dispute game not resolved yet")
+)
+
+// errNoOracle is returned when reading L2 outputs through a fault-proof
+// portal, which has no L2OutputOracle.
+var errNoOracle = errors.New("withdrawals: fault-proof OptimismPortal has no L2OutputOracle")
+
+// OutputRootProof is the preimage of an L2 output root, the OutputRootProof
+// of the OptimismPortal.
+type OutputRootProof struct {
+	Version                  [32]byte
+	StateRoot                [32]byte
+	MessagePasserStorageRoot [32]byte
+	LatestBlockhash          [32]byte
+}
+
+// OutputRoot returns the output root committing to the proof, like
+// Hashing.hashOutputRootProof.
+func (p *OutputRootProof) OutputRoot() common.Hash {
+	return crypto.Keccak256Hash(p.Version[:], p.StateRoot[:], p.MessagePasserStorageRoot[:], p.LatestBlockhash[:])
+}
+
+// Output is an L2 output proposed to the L2OutputOracle.
+type Output struct {
+	Index         *big.Int
+	OutputRoot    common.Hash
+	Timestamp     uint64
+	L2BlockNumber uint64
+}
+
+// outputProposal is the OutputProposal tuple of the L2OutputOracle.
+type outputProposal struct {
+	OutputRoot    [32]byte
+	Timestamp     *big.Int
+	L2BlockNumber *big.Int
+}
+
+// Proof proves a withdrawal against an L2 output, the arguments of
+// OptimismPortal.proveWithdrawalTransaction.
+type Proof struct {
+	// L2OutputIndex is the index of the L2 output in the L2OutputOracle, or
+	// of the dispute game in the DisputeGameFactory on fault-proof portals.
+	L2OutputIndex   *big.Int
+	OutputRootProof OutputRootProof
+	// WithdrawalProof is the storage proof of the withdrawal hash in the
+	// sentMessages mapping of the L2ToL1MessagePasser.
+	WithdrawalProof [][]byte
+}
+
+// ProvenWithdrawal is a withdrawal proven to the OptimismPortal.
+type ProvenWithdrawal struct {
+	// Timestamp is the L1 timestamp of the proof.
+	Timestamp uint64
+	// OutputRoot and L2OutputIndex are the output the withdrawal was proven
+	// against, unset on fault-proof portals.
+	OutputRoot    common.Hash
+	L2OutputIndex *big.Int
+	// DisputeGame is the dispute game the withdrawal was proven against on
+	// fault-proof portals.
+	DisputeGame common.Address
+}
+
+// L2Backend is the L2 chain access needed to build proofs. ethclient.Client
+// implements it.
+type L2Backend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	Client() *rpc.Client
+}
+
+// PortalConfig configures a Portal.
+type PortalConfig struct {
+	// OptimismPortal is the address of the OptimismPortal proxy on L1.
+	OptimismPortal common.Address
+	// L2OutputOracle is the address of the L2OutputOracle proxy on L1 the
+	// portal proves withdrawals against. Fault-proof portals prove them
+	// against dispute games instead and leave it unused.
+	L2OutputOracle common.Address
+	// ProofSubmitter is the account proving withdrawals, fault-proof portals
+	// record proofs by submitter.
+	ProofSubmitter common.Address
+}
+
+// Portal reads the withdrawals known to the OptimismPortal and builds the
+// proofs it expects.
+type Portal struct {
+	cfg     PortalConfig
+	l1      bind.ContractCaller
+	version string
+	portal  *bind.BoundContract
+	// oracle is set if the portal proves withdrawals against the
+	// L2OutputOracle, portal2 and factory if it proves them against dispute
+	// games.
+	oracle  *bind.BoundContract
+	portal2 *bind.BoundContract
+	factory *bind.BoundContract
+	l2      L2Backend
+}
+
+// NewPortal creates a Portal calling the L1 contracts through l1. The version
+// of the OptimismPortal tells whether it proves withdrawals against the
+// L2OutputOracle or, from OptimismPortal2 and version 3 on, against the
+// dispute games of the DisputeGameFactory.
+func NewPortal(opts *bind.CallOpts, cfg PortalConfig, l1 bind.ContractCaller, l2 L2Backend) (*Portal, error) {
+	p := &Portal{
+		cfg:    cfg,
+		l1:     l1,
+		portal: bind.NewBoundContract(cfg.OptimismPortal, optimismPortal, l1, nil, nil),
+		l2:     l2,
+	}
+	var out []interface{}
+	if err := p.portal.Call(opts, &out, "version"); err != nil {
+		return nil, fmt.Errorf("failed to fetch OptimismPortal version: %w", err)
+	}
+	p.version = out[0].(string)
+	major, _, _ := strings.Cut(p.version, ".")
+	n, err := strconv.Atoi(major)
+	if err != nil {
+		return nil, fmt.Errorf("withdrawals: unsupported OptimismPortal version %q", p.version)
+	}
+	if n < 3 {
+		if cfg.L2OutputOracle == (common.Address{}) {
+			return nil, fmt.Errorf("withdrawals: OptimismPortal version %s proves withdrawals against an L2OutputOracle, its address is required", p.version)
+		}
+		p.oracle = bind.NewBoundContract(cfg.L2OutputOracle, l2OutputOracle, l1, nil, nil)
+		return p, nil
+	}
+	if cfg.ProofSubmitter == (common.Address{}) {
+		return nil, fmt.Errorf("withdrawals: OptimismPortal version %s records proofs by submitter, its address is required", p.version)
+	}
+	p.portal2 = bind.NewBoundContract(cfg.OptimismPortal, optimismPortal2, l1, nil, nil)
+	out = nil
+	if err := p.portal2.Call(opts, &out, "disputeGameFactory"); err != nil {
+		return nil, fmt.Errorf("failed to fetch disputeGameFactory: %w", err)
+	}
+	p.factory = bind.NewBoundContract(out[0].(common.Address), disputeGameFactory, l1, nil, nil)
+	return p, nil
+}
+
+// Version returns the version of the OptimismPortal.
+func (p *Portal) Version() string {
+	return p.version
+}
+
+// FaultProofs reports whether the OptimismPortal proves withdrawals against
+// dispute games.
+func (p *Portal) FaultProofs() bool {
+	return p.factory != nil
+}
+
+// ProvenWithdrawal returns the latest proof of the withdrawal with the given
+// hash, by the proof submitter on fault-proof portals, nil if it isn't
+// proven.
+func (p *Portal) ProvenWithdrawal(opts *bind.CallOpts, hash common.Hash) (*ProvenWithdrawal, error) {
+	var out []interface{}
+	if p.FaultProofs() {
+		if err := p.portal2.Call(opts, &out, "provenWithdrawals", hash, p.cfg.ProofSubmitter); err != nil {
+			return nil, fmt.Errorf("failed to fetch provenWithdrawals: %w", err)
+		}
+		timestamp := out[1].(uint64)
+		if timestamp == 0 {
+			return nil, nil
+		}
+		return &ProvenWithdrawal{Timestamp: timestamp, DisputeGame: out[0].(common.Address)}, nil
+	}
+	if err := p.portal.Call(opts, &out, "provenWithdrawals", hash); err != nil {
+		return nil, fmt.Errorf("failed to fetch provenWithdrawals: %w", err)
+	}
+	timestamp := out[1].(*big.Int)
+	if timestamp.Sign() == 0 {
+		return nil, nil
+	}
+	return &ProvenWithdrawal{
+		OutputRoot:    out[0].([32]byte),
+		Timestamp:     timestamp.Uint64(),
+		L2OutputIndex: out[2].(*big.Int),
+	}, nil
+}
+
+// Finalized reports whether the withdrawal with the given hash is finalized.
+func (p *Portal) Finalized(opts *bind.CallOpts, hash common.Hash) (bool, error) {
+	var out []interface{}
+	if err := p.portal.Call(opts, &out, "finalizedWithdrawals", hash); err != nil {
+		return false, fmt.Errorf("failed to fetch finalizedWithdrawals: %w", err)
+	}
+	return out[0].(bool), nil
+}
+
+// Maturity returns the L1 timestamp after which the proven withdrawal can be
+// finalized. It returns ErrInvalidProof if the withdrawal must be proven
+// again, because the output it was proven against was replaced or because its
+// dispute game was lost, blacklisted or isn't of the respected game type, and
+// ErrGameInProgress if the dispute game isn't resolved yet.
+func (p *Portal) Maturity(opts *bind.CallOpts, proven *ProvenWithdrawal) (uint64, error) {
+	if p.FaultProofs() {
+		return p.gameMaturity(opts, proven)
+	}
+	output, err := p.Output(opts, proven.L2OutputIndex)
+	if err != nil {
+		return 0, err
+	}
+	if output.OutputRoot != proven.OutputRoot {
+		return 0, fmt.Errorf("%w: output %s replaced", ErrInvalidProof, proven.L2OutputIndex)
+	}
+	period, err := p.FinalizationPeriod(opts)
+	if err != nil {
+		return 0, err
+	}
+	// The portal requires both the proof and the output to be older than the
+	// finalization period.
+	return max(proven.Timestamp, output.Timestamp) + period, nil
+}
+
+// FinalizationPeriod returns the challenge period, in seconds, both a proof
+// and the output it proves against must be older than for a withdrawal to be
+// finalized.
+func (p *Portal) FinalizationPeriod(opts *bind.CallOpts) (uint64, error) {
+	if p.oracle == nil {
+		return 0, errNoOracle
+	}
+	var out []interface{}
+	if err := p.oracle.Call(opts, &out, "FINALIZATION_PERIOD_SECONDS"); err != nil {
+		return 0, fmt.Errorf("failed to fetch FINALIZATION_PERIOD_SECONDS: %w", err)
+	}
+	return out[0].(*big.Int).Uint64(), nil
+}
+
+// LatestBlockNumber returns the L2 block of the latest proposed output.
+func (p *Portal) LatestBlockNumber(opts *bind.CallOpts) (uint64, error) {
+	if p.oracle == nil {
+		return 0, errNoOracle
+	}
+	var out []interface{}
+	if err := p.oracle.Call(opts, &out, "latestBlockNumber"); err != nil {
+		return 0, fmt.Errorf("failed to fetch latestBlockNumber: %w", err)
+	}
+	return out[0].(*big.Int).Uint64(), nil
+}
+
+// Output returns the proposed output with the given index.
+func (p *Portal) Output(opts *bind.CallOpts, index *big.Int) (*Output, error) {
+	if p.oracle == nil {
+		return nil, errNoOracle
+	}
+	var out []interface{}
+	if err := p.oracle.Call(opts, &out, "getL2Output", index); err != nil {
+		return nil, fmt.Errorf("failed to fetch L2 output %s: %w", index, err)
+	}
+	proposal := abi.ConvertType(out[0], new(outputProposal)).(*outputProposal)
+	return &Output{
+		Index:         index,
+		OutputRoot:    proposal.OutputRoot,
+		Timestamp:     proposal.Timestamp.Uint64(),
+		L2BlockNumber: proposal.L2BlockNumber.Uint64(),
+	}, nil
+}
+
+// Prove builds the proof of w against the first output proposed at or after
+// the L2 block it was initiated in, or on fault-proof portals against the
+// latest dispute game of the respected game type whose root claim matches the
+// L2 chain. It returns ErrNotProposed if there is no such output or game yet.
+func (p *Portal) Prove(ctx context.Context, w *Withdrawal) (*Proof, error) {
+	if p.FaultProofs() {
+		return p.proveAgainstGame(ctx, w)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	latest, err := p.LatestBlockNumber(opts)
+	if err != nil {
+		return nil, err
+	}
+	if latest < w.BlockNumber {
+		return nil, ErrNotProposed
+	}
+	var out []interface{}
+	if err := p.oracle.Call(opts, &out, "getL2OutputIndexAfter", new(big.Int).SetUint64(w.BlockNumber)); err != nil {
+		return nil, fmt.Errorf("failed to fetch getL2OutputIndexAfter: %w", err)
+	}
+	output, err := p.Output(opts, out[0].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	rootProof, account, err := p.outputRootProof(ctx, w, output.L2BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if root := rootProof.OutputRoot(); root != output.OutputRoot {
+		return nil, fmt.Errorf("withdrawals: output root %s of L2 block %d doesn't match the L2 chain, computed %s", output.OutputRoot, output.L2BlockNumber, root)
+	}
+	return withdrawalProof(w, output.Index, rootProof, account, output.L2BlockNumber)
+}
+
+// outputRootProof returns the preimage of the output root of the L2 block and
+// the proof of the sentMessages slot of w in the L2ToL1MessagePasser.
+func (p *Portal) outputRootProof(ctx context.Context, w *Withdrawal, l2Block uint64) (OutputRootProof, *gethclient.AccountResult, error) {
+	block := new(big.Int).SetUint64(l2Block)
+	header, err := p.l2.HeaderByNumber(ctx, block)
+	if err != nil {
+		return OutputRootProof{}, nil, fmt.Errorf("failed to fetch L2 block %d: %w", l2Block, err)
+	}
+	slot := sentMessagesSlot(w.Hash)
+	account, err := gethclient.New(p.l2.Client()).GetProof(ctx, predeploys.L2ToL1MessagePasser, []string{slot.Hex()}, block)
+	if err != nil {
+		return OutputRootProof{}, nil, fmt.Errorf("failed to fetch L2ToL1MessagePasser proof at L2 block %d: %w", l2Block, err)
+	}
+	rootProof := OutputRootProof{
+		StateRoot:                header.Root,
+		MessagePasserStorageRoot: account.StorageHash,
+		LatestBlockhash:          header.Hash(),
+	}
+	return rootProof, account, nil
+}
+
+// withdrawalProof returns the proof of w against the output or dispute game
+// with the given index, once the storage proof shows w was sent.
+func withdrawalProof(w *Withdrawal, index *big.Int, rootProof OutputRootProof, account *gethclient.AccountResult, l2Block uint64) (*Proof, error) {
+	if len(account.StorageProof) != 1 || account.StorageProof[0].Value == nil || account.StorageProof[0].Value.Sign() == 0 {
+		return nil, fmt.Errorf("withdrawals: withdrawal %s not sent as of L2 block %d", w.Hash, l2Block)
+	}
+	proof := &Proof{L2OutputIndex: index, OutputRootProof: rootProof}
+	for _, node := range account.StorageProof[0].Proof {
+		raw, err := hexutil.Decode(node)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode storage proof: %w", err)
+		}
+		proof.WithdrawalProof = append(proof.WithdrawalProof, raw)
+	}
+	return proof, nil
+}
+
+// sentMessagesSlot returns the storage slot of hash in the sentMessages
+// mapping of the L2ToL1MessagePasser, declared first.
+func sentMessagesSlot(hash common.Hash) common.Hash {
+	return crypto.Keccak256Hash(hash[:], common.Hash{}.Bytes())
+}
+
+// withdrawalTransaction is the WithdrawalTransaction tuple of the portal.
+type withdrawalTransaction struct {
+	Nonce    *big.Int
+	Sender   common.Address
+	Target   common.Address
+	Value    *big.Int
+	GasLimit *big.Int
+	Data     []byte
+}
+
+func (w *Withdrawal) transaction() withdrawalTransaction {
+	return withdrawalTransaction{Nonce: w.Nonce, Sender: w.Sender, Target: w.Target, Value: w.Value, GasLimit: w.GasLimit, Data: w.Data}
+}
+
+// ProveCalldata returns the calldata of the OptimismPortal call proving w.
+func ProveCalldata(w *Withdrawal, proof *Proof) ([]byte, error) {
+	return optimismPortal.Pack("proveWithdrawalTransaction", w.transaction(), proof.L2OutputIndex, proof.OutputRootProof, proof.WithdrawalProof)
+}
+
+// FinalizeCalldata returns the calldata of the OptimismPortal call finalizing
+// w.
+func FinalizeCalldata(w *Withdrawal) ([]byte, error) {
+	return optimismPortal.Pack("finalizeWithdrawalTransaction", w.transaction())
+}
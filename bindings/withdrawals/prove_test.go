@@ -0,0 +1,459 @@
+package withdrawals
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+var oracle = common.HexToAddress("0x0c1e")
+
+// errReverted is returned by the fakes for calls that revert.
+var errReverted = errors.New("execution reverted")
+
+// unpackCall returns the method of contract called by call and its arguments.
+func unpackCall(contract abi.ABI, call ethereum.CallMsg) (*abi.Method, []interface{}, error) {
+	method, err := contract.MethodById(call.Data)
+	if err != nil {
+		return nil, nil, errReverted
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return method, args, nil
+}
+
+// fakeOracle serves the outputs proposed to an L2OutputOracle, and the
+// version of an OptimismPortal without fault proofs.
+type fakeOracle struct {
+	outputs []outputProposal
+}
+
+func (o *fakeOracle) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (o *fakeOracle) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	switch *call.To {
+	case portal:
+		method, _, err := unpackCall(optimismPortal, call)
+		if err != nil || method.Name != "version" {
+			return nil, errReverted
+		}
+		return method.Outputs.Pack("2.8.0")
+	case oracle:
+	default:
+		return nil, errReverted
+	}
+	method, args, err := unpackCall(l2OutputOracle, call)
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "latestBlockNumber":
+		if len(o.outputs) == 0 {
+			return method.Outputs.Pack(new(big.Int))
+		}
+		return method.Outputs.Pack(o.outputs[len(o.outputs)-1].L2BlockNumber)
+	case "getL2OutputIndexAfter":
+		for i, output := range o.outputs {
+			if output.L2BlockNumber.Cmp(args[0].(*big.Int)) >= 0 {
+				return method.Outputs.Pack(big.NewInt(int64(i)))
+			}
+		}
+	case "getL2Output":
+		if index := args[0].(*big.Int); index.IsInt64() && index.Int64() < int64(len(o.outputs)) {
+			return method.Outputs.Pack(o.outputs[index.Int64()])
+		}
+	}
+	return nil, errReverted
+}
+
+func (o *fakeOracle) propose(root common.Hash, l2Block uint64) {
+	o.outputs = append(o.outputs, outputProposal{OutputRoot: root, Timestamp: big.NewInt(1_000), L2BlockNumber: new(big.Int).SetUint64(l2Block)})
+}
+
+// newL2 starts a simulated L2 chain whose L2ToL1MessagePasser has sent the
+// withdrawals with the given hashes, and mines a block on top of genesis. The
+// chain is dialed over IPC, the client of the simulated backend doesn't expose
+// eth_getProof.
+func newL2(t *testing.T, sent ...common.Hash) *ethclient.Client {
+	t.Helper()
+	storage := make(map[common.Hash]common.Hash)
+	for _, hash := range sent {
+		storage[sentMessagesSlot(hash)] = common.BigToHash(big.NewInt(1))
+	}
+	ipc := filepath.Join(t.TempDir(), "l2.ipc")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		predeploys.L2ToL1MessagePasser: {Code: []byte{0x00}, Balance: new(big.Int), Storage: storage},
+	}, func(nodeConf *node.Config, _ *ethconfig.Config) { nodeConf.IPCPath = ipc })
+	t.Cleanup(func() { backend.Close() })
+	backend.Commit()
+	client, err := rpc.Dial(ipc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.Close)
+	return ethclient.NewClient(client)
+}
+
+// outputRoot returns the output root of the L2 block, with the storage root
+// of the L2ToL1MessagePasser verified against the state root.
+func outputRoot(t *testing.T, l2 L2Backend, block uint64) common.Hash {
+	t.Helper()
+	header, err := l2.HeaderByNumber(context.Background(), new(big.Int).SetUint64(block))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result struct {
+		AccountProof []string `json:"accountProof"`
+	}
+	if err := l2.Client().Call(&result, "eth_getProof", predeploys.L2ToL1MessagePasser, []string{}, hexutil.EncodeBig(header.Number)); err != nil {
+		t.Fatal(err)
+	}
+	encoded := verify(t, header.Root, crypto.Keccak256(predeploys.L2ToL1MessagePasser.Bytes()), decodeNodes(t, result.AccountProof))
+	var account types.StateAccount
+	if err := rlp.DecodeBytes(encoded, &account); err != nil {
+		t.Fatal(err)
+	}
+	proof := OutputRootProof{StateRoot: header.Root, MessagePasserStorageRoot: account.Root, LatestBlockhash: header.Hash()}
+	return proof.OutputRoot()
+}
+
+func decodeNodes(t *testing.T, nodes []string) [][]byte {
+	t.Helper()
+	var decoded [][]byte
+	for _, node := range nodes {
+		decoded = append(decoded, common.FromHex(node))
+	}
+	return decoded
+}
+
+// verify checks the Merkle proof of key against root and returns its value.
+func verify(t *testing.T, root common.Hash, key []byte, proof [][]byte) []byte {
+	t.Helper()
+	db := rawdb.NewMemoryDatabase()
+	for _, node := range proof {
+		if err := db.Put(crypto.Keccak256(node), node); err != nil {
+			t.Fatal(err)
+		}
+	}
+	value, err := trie.VerifyProof(root, key, db)
+	if err != nil {
+		t.Fatalf("invalid proof: %v", err)
+	}
+	return value
+}
+
+func TestProve(t *testing.T) {
+	w := testWithdrawal(3)
+	w.BlockNumber = 1
+	var err error
+	if w.Hash, err = w.ComputeHash(); err != nil {
+		t.Fatal(err)
+	}
+	l2 := newL2(t, w.Hash)
+	fake := &fakeOracle{}
+	if _, err := NewPortal(&bind.CallOpts{}, PortalConfig{OptimismPortal: portal}, fake, l2); err == nil {
+		t.Fatal("expected the L2OutputOracle to be required")
+	}
+	p, err := NewPortal(&bind.CallOpts{}, PortalConfig{OptimismPortal: portal, L2OutputOracle: oracle}, fake, l2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.FaultProofs() {
+		t.Fatal("expected the portal to prove against the L2OutputOracle")
+	}
+
+	if _, err := p.Prove(context.Background(), w); !errors.Is(err, ErrNotProposed) {
+		t.Fatalf("expected ErrNotProposed without outputs, got %v", err)
+	}
+
+	fake.propose(common.HexToHash("0xbad"), 1)
+	if _, err := p.Prove(context.Background(), w); err == nil {
+		t.Fatal("expected an output root not matching the L2 chain to be rejected")
+	}
+
+	root := outputRoot(t, l2, 1)
+	fake.outputs = nil
+	fake.propose(common.Hash{}, 0)
+	fake.propose(root, 1)
+	proof, err := p.Prove(context.Background(), w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.L2OutputIndex.Int64() != 1 || proof.OutputRootProof.OutputRoot() != root {
+		t.Fatalf("unexpected proof of output %d with root %s", proof.L2OutputIndex, proof.OutputRootProof.OutputRoot())
+	}
+	slot := sentMessagesSlot(w.Hash)
+	value := verify(t, proof.OutputRootProof.MessagePasserStorageRoot, crypto.Keccak256(slot[:]), proof.WithdrawalProof)
+	if !bytes.Equal(value, []byte{1}) {
+		t.Fatalf("withdrawal proof proves value %x, want 01", value)
+	}
+
+	unsent := testWithdrawal(4)
+	unsent.BlockNumber = 1
+	if unsent.Hash, err = unsent.ComputeHash(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Prove(context.Background(), unsent); err == nil {
+		t.Fatal("expected a withdrawal missing from the L2ToL1MessagePasser to be rejected")
+	}
+}
+
+var factory = common.HexToAddress("0xfac7")
+
+// fakeGame is a dispute game created by the DisputeGameFactory.
+type fakeGame struct {
+	proxy      common.Address
+	gameType   uint32
+	rootClaim  common.Hash
+	l2Block    uint64
+	status     uint8
+	createdAt  uint64
+	resolvedAt uint64
+}
+
+// fakeFaultProofs serves an OptimismPortal2 and the dispute games of its
+// DisputeGameFactory.
+type fakeFaultProofs struct {
+	submitter common.Address
+	respected uint32
+	// respectedSince is when the respected game type was last updated.
+	respectedSince uint64
+	games          []*fakeGame
+	blacklisted    map[common.Address]bool
+	// proven are the dispute games withdrawals were proven against by the
+	// proof submitter.
+	proven map[common.Hash]common.Address
+}
+
+func newFakeFaultProofs(submitter common.Address) *fakeFaultProofs {
+	return &fakeFaultProofs{submitter: submitter, blacklisted: make(map[common.Address]bool), proven: make(map[common.Hash]common.Address)}
+}
+
+// create adds a dispute game in progress.
+func (f *fakeFaultProofs) create(gameType uint32, root common.Hash, l2Block uint64) *fakeGame {
+	g := &fakeGame{
+		proxy:     common.BigToAddress(big.NewInt(int64(0x6a00 + len(f.games)))),
+		gameType:  gameType,
+		rootClaim: root,
+		l2Block:   l2Block,
+		createdAt: 900,
+	}
+	f.games = append(f.games, g)
+	return g
+}
+
+func (f *fakeFaultProofs) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (f *fakeFaultProofs) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	switch *call.To {
+	case portal:
+		return f.callPortal(call)
+	case factory:
+		return f.callFactory(call)
+	}
+	for _, g := range f.games {
+		if g.proxy == *call.To {
+			return g.call(call)
+		}
+	}
+	return nil, errReverted
+}
+
+func (f *fakeFaultProofs) callPortal(call ethereum.CallMsg) ([]byte, error) {
+	if method, _, err := unpackCall(optimismPortal, call); err == nil && method.Name == "version" {
+		return method.Outputs.Pack("3.10.0")
+	}
+	method, args, err := unpackCall(optimismPortal2, call)
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "disputeGameFactory":
+		return method.Outputs.Pack(factory)
+	case "proofMaturityDelaySeconds":
+		return method.Outputs.Pack(big.NewInt(100))
+	case "disputeGameFinalityDelaySeconds":
+		return method.Outputs.Pack(big.NewInt(50))
+	case "respectedGameType":
+		return method.Outputs.Pack(f.respected)
+	case "respectedGameTypeUpdatedAt":
+		return method.Outputs.Pack(f.respectedSince)
+	case "disputeGameBlacklist":
+		return method.Outputs.Pack(f.blacklisted[args[0].(common.Address)])
+	case "provenWithdrawals":
+		game, ok := f.proven[args[0].([32]byte)]
+		if !ok || args[1].(common.Address) != f.submitter {
+			return method.Outputs.Pack(common.Address{}, uint64(0))
+		}
+		return method.Outputs.Pack(game, uint64(1_000))
+	}
+	return nil, errReverted
+}
+
+func (f *fakeFaultProofs) callFactory(call ethereum.CallMsg) ([]byte, error) {
+	method, args, err := unpackCall(disputeGameFactory, call)
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "gameCount":
+		return method.Outputs.Pack(big.NewInt(int64(len(f.games))))
+	case "findLatestGames":
+		found := []gameSearchResult{}
+		for i := int(args[1].(*big.Int).Int64()); i >= 0 && len(found) < int(args[2].(*big.Int).Int64()); i-- {
+			g := f.games[i]
+			if g.gameType != args[0].(uint32) {
+				continue
+			}
+			var metadata [32]byte
+			binary.BigEndian.PutUint32(metadata[:4], g.gameType)
+			binary.BigEndian.PutUint64(metadata[4:12], g.createdAt)
+			copy(metadata[12:], g.proxy.Bytes())
+			found = append(found, gameSearchResult{
+				Index:     big.NewInt(int64(i)),
+				Metadata:  metadata,
+				Timestamp: g.createdAt,
+				RootClaim: g.rootClaim,
+				ExtraData: common.BigToHash(new(big.Int).SetUint64(g.l2Block)).Bytes(),
+			})
+		}
+		return method.Outputs.Pack(found)
+	}
+	return nil, errReverted
+}
+
+func (g *fakeGame) call(call ethereum.CallMsg) ([]byte, error) {
+	method, _, err := unpackCall(disputeGame, call)
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "gameType":
+		return method.Outputs.Pack(g.gameType)
+	case "createdAt":
+		return method.Outputs.Pack(g.createdAt)
+	case "status":
+		return method.Outputs.Pack(g.status)
+	case "resolvedAt":
+		return method.Outputs.Pack(g.resolvedAt)
+	}
+	return nil, errReverted
+}
+
+func TestProveFaultProofs(t *testing.T) {
+	w := testWithdrawal(3)
+	w.BlockNumber = 1
+	var err error
+	if w.Hash, err = w.ComputeHash(); err != nil {
+		t.Fatal(err)
+	}
+	l2 := newL2(t, w.Hash)
+	submitter := common.HexToAddress("0x5b")
+	fake := newFakeFaultProofs(submitter)
+	opts := &bind.CallOpts{}
+	if _, err := NewPortal(opts, PortalConfig{OptimismPortal: portal}, fake, l2); err == nil {
+		t.Fatal("expected the proof submitter to be required")
+	}
+	p, err := NewPortal(opts, PortalConfig{OptimismPortal: portal, ProofSubmitter: submitter}, fake, l2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.FaultProofs() || p.Version() != "3.10.0" {
+		t.Fatalf("expected a fault-proof portal, got version %s", p.Version())
+	}
+	if _, err := p.Output(opts, common.Big0); err == nil {
+		t.Fatal("expected fault-proof portals to have no L2 outputs")
+	}
+
+	if _, err := p.Prove(context.Background(), w); !errors.Is(err, ErrNotProposed) {
+		t.Fatalf("expected ErrNotProposed without games, got %v", err)
+	}
+	root := outputRoot(t, l2, 1)
+	fake.create(0, root, 0)
+	if _, err := p.Prove(context.Background(), w); !errors.Is(err, ErrNotProposed) {
+		t.Fatalf("expected ErrNotProposed without games covering the withdrawal, got %v", err)
+	}
+
+	valid := fake.create(0, root, 1)
+	fake.create(0, root, 1).status = gameChallengerWins
+	fake.blacklisted[fake.create(0, root, 1).proxy] = true
+	fake.create(0, common.HexToHash("0xbad"), 1)
+	fake.create(1, root, 1)
+	proof, err := p.Prove(context.Background(), w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.L2OutputIndex.Int64() != 1 || proof.OutputRootProof.OutputRoot() != root {
+		t.Fatalf("unexpected proof against game %d with root %s", proof.L2OutputIndex, proof.OutputRootProof.OutputRoot())
+	}
+
+	if proven, err := p.ProvenWithdrawal(opts, w.Hash); err != nil || proven != nil {
+		t.Fatalf("expected the withdrawal not to be proven, got %+v (%v)", proven, err)
+	}
+	fake.proven[w.Hash] = valid.proxy
+	proven, err := p.ProvenWithdrawal(opts, w.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proven == nil || proven.DisputeGame != valid.proxy || proven.Timestamp != 1_000 {
+		t.Fatalf("unexpected proven withdrawal %+v", proven)
+	}
+	if _, err := p.Maturity(opts, proven); !errors.Is(err, ErrGameInProgress) {
+		t.Fatalf("expected ErrGameInProgress, got %v", err)
+	}
+	valid.status, valid.resolvedAt = gameDefenderWins, 1_200
+	if ready, err := p.Maturity(opts, proven); err != nil || ready != 1_250 {
+		t.Fatalf("got maturity %d (%v), want the air gap to end at 1250", ready, err)
+	}
+	fake.respected = 1
+	if _, err := p.Maturity(opts, proven); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expected a game no longer of the respected type to be invalid, got %v", err)
+	}
+	fake.respected = 0
+	// The respected game type was set again after the game was created.
+	fake.respectedSince = valid.createdAt + 1
+	if _, err := p.Maturity(opts, proven); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expected a game created before the respected game type was updated to be invalid, got %v", err)
+	}
+	if _, err := p.Prove(context.Background(), w); !errors.Is(err, ErrNotProposed) {
+		t.Fatalf("expected games created before the respected game type was updated to be skipped, got %v", err)
+	}
+	fake.respectedSince = 0
+	fake.blacklisted[valid.proxy] = true
+	if _, err := p.Maturity(opts, proven); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expected a blacklisted game to be invalid, got %v", err)
+	}
+	fake.blacklisted[valid.proxy] = false
+	valid.status = gameChallengerWins
+	if _, err := p.Maturity(opts, proven); !errors.Is(err, ErrInvalidProof) {
+		t.Fatalf("expected a lost game to be invalid, got %v", err)
+	}
+}
@@ -0,0 +1,353 @@
+// Package withdrawals follows the L2 to L1 withdrawals bridging the L1 share
+// of the fees disbursed by FeeDisburser.disburseFees, from their initiation in
+// the L2ToL1MessagePasser to their proof and finalization in the
+// OptimismPortal on L1.
+package withdrawals
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+// l2ToL1MessagePasserABI holds the event of the L2ToL1MessagePasser recording
+// an initiated withdrawal.
+const l2ToL1MessagePasserABI = `[{"type":"event","name":"MessagePassed","anonymous":false,"inputs":[{"name":"nonce","type":"uint256","indexed":true},{"name":"sender","type":"address","indexed":true},{"name":"target","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false},{"name":"gasLimit","type":"uint256","indexed":false},{"name":"data","type":"bytes","indexed":false},{"name":"withdrawalHash","type":"bytes32","indexed":false}]}]`
+
+// crossDomainMessageABI holds the relayMessage call of the withdrawals of the
+// L2CrossDomainMessenger, and the finalizeBridgeETH call of the
+// L2StandardBridge it relays for ether bridged to L1.
+const crossDomainMessageABI = `[{"type":"function","name":"relayMessage","inputs":[{"name":"_nonce","type":"uint256"},{"name":"_sender","type":"address"},{"name":"_target","type":"address"},{"name":"_value","type":"uint256"},{"name":"_minGasLimit","type":"uint256"},{"name":"_message","type":"bytes"}],"outputs":[],"stateMutability":"payable"},{"type":"function","name":"finalizeBridgeETH","inputs":[{"name":"_from","type":"address"},{"name":"_to","type":"address"},{"name":"_amount","type":"uint256"},{"name":"_extraData","type":"bytes"}],"outputs":[],"stateMutability":"payable"}]`
+
+// optimismPortalABI holds the functions of the OptimismPortal proving and
+// finalizing a withdrawal against the L2OutputOracle, and the events recording
+// them. OptimismPortal2 shares all of them but provenWithdrawals.
+const optimismPortalABI = `[{"type":"function","name":"proveWithdrawalTransaction","inputs":[{"name":"_tx","type":"tuple","components":[{"name":"nonce","type":"uint256"},{"name":"sender","type":"address"},{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"gasLimit","type":"uint256"},{"name":"data","type":"bytes"}]},{"name":"_l2OutputIndex","type":"uint256"},{"name":"_outputRootProof","type":"tuple","components":[{"name":"version","type":"bytes32"},{"name":"stateRoot","type":"bytes32"},{"name":"messagePasserStorageRoot","type":"bytes32"},{"name":"latestBlockhash","type":"bytes32"}]},{"name":"_withdrawalProof","type":"bytes[]"}],"outputs":[],"stateMutability":"nonpayable"},{"type":"function","name":"finalizeWithdrawalTransaction","inputs":[{"name":"_tx","type":"tuple","components":[{"name":"nonce","type":"uint256"},{"name":"sender","type":"address"},{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"gasLimit","type":"uint256"},{"name":"data","type":"bytes"}]}],"outputs":[],"stateMutability":"nonpayable"},{"type":"function","name":"provenWithdrawals","inputs":[{"name":"","type":"bytes32"}],"outputs":[{"name":"outputRoot","type":"bytes32"},{"name":"timestamp","type":"uint128"},{"name":"l2OutputIndex","type":"uint128"}],"stateMutability":"view"},{"type":"function","name":"finalizedWithdrawals","inputs":[{"name":"","type":"bytes32"}],"outputs":[{"name":"","type":"bool"}],"stateMutability":"view"},{"type":"function","name":"version","inputs":[],"outputs":[{"name":"","type":"string"}],"stateMutability":"view"},{"type":"event","name":"WithdrawalProven","anonymous":false,"inputs":[{"name":"withdrawalHash","type":"bytes32","indexed":true},{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true}]},{"type":"event","name":"WithdrawalFinalized","anonymous":false,"inputs":[{"name":"withdrawalHash","type":"bytes32","indexed":true},{"name":"success","type":"bool","indexed":false}]}]`
+
+// l1StandardBridgeABI holds the event of the L1StandardBridge recording ether
+// bridged from L2 once its withdrawal is finalized.
+const l1StandardBridgeABI = `[{"type":"event","name":"ETHBridgeFinalized","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false},{"name":"extraData","type":"bytes","indexed":false}]}]`
+
+func mustParse(raw string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(raw))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+var (
+	optimismPortal      = mustParse(optimismPortalABI)
+	crossDomainMessage  = mustParse(crossDomainMessageABI)
+	messagePassed       = mustParse(l2ToL1MessagePasserABI).Events["MessagePassed"]
+	withdrawalProven    = optimismPortal.Events["WithdrawalProven"]
+	withdrawalFinalized = optimismPortal.Events["WithdrawalFinalized"]
+	ethBridgeFinalized  = mustParse(l1StandardBridgeABI).Events["ETHBridgeFinalized"]
+)
+
+// Withdrawal is a withdrawal initiated on L2, the WithdrawalTransaction of
+// the OptimismPortal.
+type Withdrawal struct {
+	Nonce    *big.Int
+	Sender   common.Address
+	Target   common.Address
+	Value    *big.Int
+	GasLimit *big.Int
+	Data     []byte
+	// Hash is the withdrawal hash, identifying the withdrawal on L1.
+	Hash common.Hash
+	// BlockNumber is the L2 block the withdrawal was initiated in.
+	BlockNumber uint64
+	// TxHash is the L2 transaction initiating the withdrawal.
+	TxHash common.Hash
+}
+
+// ComputeHash returns the hash of the withdrawal, like Hashing.hashWithdrawal.
+func (w *Withdrawal) ComputeHash() (common.Hash, error) {
+	uint256, _ := abi.NewType("uint256", "", nil)
+	address, _ := abi.NewType("address", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	args := abi.Arguments{{Type: uint256}, {Type: address}, {Type: address}, {Type: uint256}, {Type: uint256}, {Type: bytesType}}
+	encoded, err := args.Pack(w.Nonce, w.Sender, w.Target, w.Value, w.GasLimit, w.Data)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode withdrawal: %w", err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// FromReceipt returns the withdrawals initiated by the L2 transaction of the
+// receipt, in the order of its logs.
+func FromReceipt(receipt *types.Receipt) ([]*Withdrawal, error) {
+	var withdrawals []*Withdrawal
+	for _, l := range receipt.Logs {
+		if l.Address != predeploys.L2ToL1MessagePasser || len(l.Topics) != 4 || l.Topics[0] != messagePassed.ID {
+			continue
+		}
+		args, err := messagePassed.Inputs.NonIndexed().Unpack(l.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode MessagePassed: %w", err)
+		}
+		w := &Withdrawal{
+			Nonce:       l.Topics[1].Big(),
+			Sender:      common.BytesToAddress(l.Topics[2].Bytes()),
+			Target:      common.BytesToAddress(l.Topics[3].Bytes()),
+			Value:       args[0].(*big.Int),
+			GasLimit:    args[1].(*big.Int),
+			Data:        args[2].([]byte),
+			Hash:        args[3].([32]byte),
+			BlockNumber: l.BlockNumber,
+			TxHash:      l.TxHash,
+		}
+		if hash, err := w.ComputeHash(); err != nil {
+			return nil, err
+		} else if hash != w.Hash {
+			return nil, fmt.Errorf("withdrawals: hash %s of MessagePassed doesn't match the withdrawal, computed %s", w.Hash, hash)
+		}
+		withdrawals = append(withdrawals, w)
+	}
+	return withdrawals, nil
+}
+
+// ETHRecipient returns the L1 recipient of the ether bridged by the
+// withdrawal, if it is a message of the L2CrossDomainMessenger relaying a
+// finalizeBridgeETH call of the L2StandardBridge.
+func (w *Withdrawal) ETHRecipient() (common.Address, bool) {
+	if w.Sender != predeploys.L2CrossDomainMessenger {
+		return common.Address{}, false
+	}
+	relay, ok := unpackMessage("relayMessage", w.Data)
+	if !ok || relay[1].(common.Address) != predeploys.L2StandardBridge {
+		return common.Address{}, false
+	}
+	bridge, ok := unpackMessage("finalizeBridgeETH", relay[5].([]byte))
+	if !ok {
+		return common.Address{}, false
+	}
+	return bridge[1].(common.Address), true
+}
+
+// unpackMessage decodes the arguments of a call to the method of
+// crossDomainMessage.
+func unpackMessage(method string, data []byte) ([]interface{}, bool) {
+	m := crossDomainMessage.Methods[method]
+	if len(data) < 4 || !bytes.Equal(data[:4], m.ID) {
+		return nil, false
+	}
+	args, err := m.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, false
+	}
+	return args, true
+}
+
+// BridgingETHTo returns the last of the withdrawals bridging ether to
+// recipient, nil if none does. FeeDisburser.disburseFees initiates a single
+// one, bridging the L1 share to its L1 wallet.
+func BridgingETHTo(ws []*Withdrawal, recipient common.Address) *Withdrawal {
+	for i := len(ws) - 1; i >= 0; i-- {
+		if to, ok := ws[i].ETHRecipient(); ok && to == recipient {
+			return ws[i]
+		}
+	}
+	return nil
+}
+
+// Status is the progress of a withdrawal on L1.
+type Status struct {
+	// Proven is set once the withdrawal is proven.
+	Proven bool
+	// ProvenTime is the L1 timestamp of the latest proof of the withdrawal.
+	ProvenTime uint64
+	// ProveTx is the L1 transaction of the latest proof.
+	ProveTx common.Hash
+	// Finalized is set once the withdrawal is finalized.
+	Finalized bool
+	// Success is whether the finalized withdrawal was executed successfully.
+	Success bool
+	// FinalizeTx is the L1 transaction finalizing the withdrawal.
+	FinalizeTx common.Hash
+	// FinalizedAmount is the ether the L1StandardBridge released to the
+	// recipient of the withdrawal when it was finalized.
+	FinalizedAmount *big.Int
+}
+
+// L1Backend is the L1 chain access needed by a Tracker. ethclient.Client
+// implements it.
+type L1Backend interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// TrackerConfig configures a Tracker.
+type TrackerConfig struct {
+	// OptimismPortal is the address of the OptimismPortal proxy on L1.
+	OptimismPortal common.Address
+	// L1StandardBridge is the address of the L1StandardBridge proxy, whose
+	// ETHBridgeFinalized events tell the amount of finalized withdrawals.
+	L1StandardBridge common.Address
+	// StartBlock is the first L1 block searched for proofs and finalizations,
+	// usually the block the first withdrawal was initiated at.
+	StartBlock uint64
+	// ChunkSize is the largest number of blocks whose logs are fetched at once.
+	ChunkSize uint64
+	// HashBatchSize is the largest number of withdrawal hashes logs are
+	// filtered by at once, DefaultHashBatchSize if zero.
+	HashBatchSize int
+}
+
+// DefaultHashBatchSize keeps log filters within the topic limits of common
+// providers.
+const DefaultHashBatchSize = 100
+
+// Tracker reads the status of withdrawals from the events of the
+// OptimismPortal.
+type Tracker struct {
+	cfg     TrackerConfig
+	backend L1Backend
+}
+
+// NewTracker creates a Tracker.
+func NewTracker(cfg TrackerConfig, backend L1Backend) (*Tracker, error) {
+	if cfg.ChunkSize == 0 {
+		return nil, errors.New("withdrawals: chunk size must be positive")
+	}
+	if cfg.HashBatchSize < 0 {
+		return nil, errors.New("withdrawals: hash batch size must not be negative")
+	}
+	if cfg.HashBatchSize == 0 {
+		cfg.HashBatchSize = DefaultHashBatchSize
+	}
+	return &Tracker{cfg: cfg, backend: backend}, nil
+}
+
+// Head returns the latest L1 block header.
+func (t *Tracker) Head(ctx context.Context) (*types.Header, error) {
+	head, err := t.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 head: %w", err)
+	}
+	return head, nil
+}
+
+// Statuses returns the status of the withdrawals with the given hashes, as of
+// the L1 block head, for which a nil header means the latest block.
+func (t *Tracker) Statuses(ctx context.Context, head *types.Header, hashes []common.Hash) (map[common.Hash]*Status, error) {
+	statuses := make(map[common.Hash]*Status, len(hashes))
+	for _, hash := range hashes {
+		statuses[hash] = &Status{}
+	}
+	if len(hashes) == 0 {
+		return statuses, nil
+	}
+	if head == nil {
+		var err error
+		if head, err = t.Head(ctx); err != nil {
+			return nil, err
+		}
+	}
+	for start := 0; start < len(hashes); start += t.cfg.HashBatchSize {
+		batch := hashes[start:min(start+t.cfg.HashBatchSize, len(hashes))]
+		if err := t.track(ctx, head.Number.Uint64(), batch, statuses); err != nil {
+			return nil, err
+		}
+	}
+	return statuses, nil
+}
+
+// track updates the statuses of a batch of withdrawals from the OptimismPortal
+// logs up to the L1 block end. A finalized withdrawal can't be proven or
+// finalized again, so the following blocks are only searched for the others,
+// and not at all once every withdrawal of the batch is finalized.
+func (t *Tracker) track(ctx context.Context, end uint64, batch []common.Hash, statuses map[common.Hash]*Status) error {
+	pending := append([]common.Hash(nil), batch...)
+	for from := t.cfg.StartBlock; from <= end && len(pending) > 0; from += t.cfg.ChunkSize {
+		to := min(from+t.cfg.ChunkSize-1, end)
+		logs, err := t.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{t.cfg.OptimismPortal},
+			Topics:    [][]common.Hash{{withdrawalProven.ID, withdrawalFinalized.ID}, pending},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to fetch OptimismPortal logs of blocks %d-%d: %w", from, to, err)
+		}
+		for _, l := range logs {
+			if l.Removed || len(l.Topics) < 2 {
+				continue
+			}
+			status, ok := statuses[l.Topics[1]]
+			if !ok {
+				continue
+			}
+			switch l.Topics[0] {
+			case withdrawalProven.ID:
+				header, err := t.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(l.BlockNumber))
+				if err != nil {
+					return fmt.Errorf("failed to fetch L1 block %d: %w", l.BlockNumber, err)
+				}
+				// Withdrawals can be proven again, the latest proof counts.
+				status.Proven, status.ProvenTime, status.ProveTx = true, header.Time, l.TxHash
+			case withdrawalFinalized.ID:
+				args, err := withdrawalFinalized.Inputs.NonIndexed().Unpack(l.Data)
+				if err != nil {
+					return fmt.Errorf("failed to decode WithdrawalFinalized: %w", err)
+				}
+				status.Finalized, status.Success, status.FinalizeTx = true, args[0].(bool), l.TxHash
+				if status.FinalizedAmount, err = t.finalizedAmount(ctx, l); err != nil {
+					return err
+				}
+			}
+		}
+		unfinalized := pending[:0]
+		for _, hash := range pending {
+			if !statuses[hash].Finalized {
+				unfinalized = append(unfinalized, hash)
+			}
+		}
+		pending = unfinalized
+	}
+	return nil
+}
+
+// finalizedAmount returns the ether released by the L1StandardBridge when the
+// withdrawal of the WithdrawalFinalized log was finalized. The bridge emits
+// ETHBridgeFinalized before the portal emits WithdrawalFinalized, so it is
+// the last one of the transaction since the previous finalization.
+func (t *Tracker) finalizedAmount(ctx context.Context, finalized types.Log) (*big.Int, error) {
+	receipt, err := t.backend.TransactionReceipt(ctx, finalized.TxHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch receipt of %s: %w", finalized.TxHash, err)
+	}
+	amount := new(big.Int)
+	for _, l := range receipt.Logs {
+		if l.Index == finalized.Index {
+			return amount, nil
+		}
+		if len(l.Topics) == 0 {
+			continue
+		}
+		switch {
+		case l.Address == t.cfg.OptimismPortal && l.Topics[0] == withdrawalFinalized.ID:
+			amount = new(big.Int)
+		case l.Address == t.cfg.L1StandardBridge && l.Topics[0] == ethBridgeFinalized.ID:
+			args, err := ethBridgeFinalized.Inputs.NonIndexed().Unpack(l.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode ETHBridgeFinalized: %w", err)
+			}
+			amount = args[0].(*big.Int)
+		}
+	}
+	return nil, fmt.Errorf("withdrawals: log %d not found in receipt of %s", finalized.Index, finalized.TxHash)
+}
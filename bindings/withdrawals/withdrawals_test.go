@@ -0,0 +1,285 @@
+package withdrawals
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/base-org/contracts/bindings/predeploys"
+)
+
+var (
+	portal   = common.HexToAddress("0x0b0b")
+	bridge   = common.HexToAddress("0xb41d")
+	l1Wallet = common.HexToAddress("0x11")
+)
+
+// messagePassedLog returns the MessagePassed log of w, with its hash
+// computed unless w.Hash is set.
+func messagePassedLog(t *testing.T, w *Withdrawal) types.Log {
+	t.Helper()
+	hash := w.Hash
+	if hash == (common.Hash{}) {
+		var err error
+		if hash, err = w.ComputeHash(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := messagePassed.Inputs.NonIndexed().Pack(w.Value, w.GasLimit, w.Data, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{
+		Address: predeploys.L2ToL1MessagePasser,
+		Topics:  []common.Hash{messagePassed.ID, common.BigToHash(w.Nonce), common.BytesToHash(w.Sender.Bytes()), common.BytesToHash(w.Target.Bytes())},
+		Data:    data,
+	}
+}
+
+func testWithdrawal(nonce int64) *Withdrawal {
+	return &Withdrawal{
+		Nonce:    big.NewInt(nonce),
+		Sender:   common.HexToAddress("0x4200000000000000000000000000000000000007"),
+		Target:   common.HexToAddress("0xce55"),
+		Value:    big.NewInt(1_000),
+		GasLimit: big.NewInt(200_000),
+		Data:     []byte{0xd7, 0x64, 0xad, 0x0b},
+	}
+}
+
+func TestFromReceipt(t *testing.T) {
+	w := testWithdrawal(7)
+	other := messagePassedLog(t, w)
+	other.Address = common.HexToAddress("0xbad")
+	receipt := &types.Receipt{Logs: []*types.Log{&other, ptr(messagePassedLog(t, w))}}
+
+	ws, err := FromReceipt(receipt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := w.ComputeHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ws) != 1 || ws[0].Hash != want || ws[0].Nonce.Int64() != 7 || ws[0].Target != w.Target || ws[0].Value.Int64() != 1_000 {
+		t.Fatalf("unexpected withdrawals %+v", ws)
+	}
+
+	w.Hash = common.HexToHash("0xbad")
+	if _, err := FromReceipt(&types.Receipt{Logs: []*types.Log{ptr(messagePassedLog(t, w))}}); err == nil {
+		t.Fatal("expected a mismatching withdrawal hash to be rejected")
+	}
+}
+
+func ptr(l types.Log) *types.Log { return &l }
+
+// bridgeETHData returns the data of a withdrawal of the L2CrossDomainMessenger
+// bridging amount to recipient through the L2StandardBridge.
+func bridgeETHData(t *testing.T, recipient common.Address, amount int64) []byte {
+	t.Helper()
+	message, err := crossDomainMessage.Pack("finalizeBridgeETH", common.HexToAddress("0xfd"), recipient, big.NewInt(amount), []byte{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := crossDomainMessage.Pack("relayMessage", big.NewInt(1), predeploys.L2StandardBridge, common.HexToAddress("0xb41d"), big.NewInt(amount), big.NewInt(200_000), message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestBridgingETHTo(t *testing.T) {
+	toWallet := testWithdrawal(1)
+	toWallet.Data = bridgeETHData(t, l1Wallet, 1_000)
+	toOther := testWithdrawal(2)
+	toOther.Data = bridgeETHData(t, common.HexToAddress("0xbeef"), 1_000)
+	// Same message, but not sent by the L2CrossDomainMessenger.
+	spoofed := testWithdrawal(3)
+	spoofed.Sender = common.HexToAddress("0xbad")
+	spoofed.Data = toWallet.Data
+	undecodable := testWithdrawal(4)
+
+	if to, ok := toWallet.ETHRecipient(); !ok || to != l1Wallet {
+		t.Fatalf("got recipient %s (%v), want the L1 wallet", to, ok)
+	}
+	if w := BridgingETHTo([]*Withdrawal{toWallet, toOther, spoofed, undecodable}, l1Wallet); w != toWallet {
+		t.Fatalf("got withdrawal %+v, want the one bridging to the L1 wallet", w)
+	}
+	if w := BridgingETHTo([]*Withdrawal{toOther, spoofed, undecodable}, l1Wallet); w != nil {
+		t.Fatalf("got withdrawal %+v, want none", w)
+	}
+}
+
+// fakeL1 serves headers, logs and receipts of an L1 chain.
+type fakeL1 struct {
+	head     uint64
+	logs     []types.Log
+	receipts map[common.Hash]*types.Receipt
+	// queries are the log filters received.
+	queries []ethereum.FilterQuery
+}
+
+func (c *fakeL1) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	n := c.head
+	if number != nil {
+		n = number.Uint64()
+	}
+	return &types.Header{Number: new(big.Int).SetUint64(n), Time: 1_000 + 12*n}, nil
+}
+
+func (c *fakeL1) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	c.queries = append(c.queries, q)
+	var logs []types.Log
+	for _, l := range c.logs {
+		if l.BlockNumber < q.FromBlock.Uint64() || l.BlockNumber > q.ToBlock.Uint64() || l.Address != q.Addresses[0] {
+			continue
+		}
+		if contains(q.Topics[0], l.Topics[0]) && contains(q.Topics[1], l.Topics[1]) {
+			logs = append(logs, l)
+		}
+	}
+	return logs, nil
+}
+
+func contains(hashes []common.Hash, hash common.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *fakeL1) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if receipt, ok := c.receipts[txHash]; ok {
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *fakeL1) prove(hash common.Hash, block uint64) {
+	c.logs = append(c.logs, types.Log{
+		Address:     portal,
+		Topics:      []common.Hash{withdrawalProven.ID, hash, {}, {}},
+		BlockNumber: block,
+		TxHash:      common.BigToHash(new(big.Int).SetUint64(block)),
+	})
+}
+
+// finalize adds the logs of the finalization of the withdrawals in a single
+// transaction at block, each releasing its amount to the L1 wallet.
+func (c *fakeL1) finalize(t *testing.T, block uint64, success bool, amounts map[common.Hash]int64, hashes ...common.Hash) {
+	t.Helper()
+	txHash := common.BigToHash(new(big.Int).SetUint64(block))
+	receipt := &types.Receipt{TxHash: txHash}
+	for _, hash := range hashes {
+		data, err := ethBridgeFinalized.Inputs.NonIndexed().Pack(big.NewInt(amounts[hash]), []byte{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipt.Logs = append(receipt.Logs, &types.Log{
+			Address: bridge,
+			Topics:  []common.Hash{ethBridgeFinalized.ID, {}, common.BytesToHash(l1Wallet.Bytes())},
+			Data:    data,
+		})
+		data, err = withdrawalFinalized.Inputs.NonIndexed().Pack(success)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finalized := &types.Log{Address: portal, Topics: []common.Hash{withdrawalFinalized.ID, hash}, Data: data, BlockNumber: block, TxHash: txHash}
+		receipt.Logs = append(receipt.Logs, finalized)
+	}
+	for i, l := range receipt.Logs {
+		l.Index = uint(i)
+		if l.Address == portal {
+			c.logs = append(c.logs, *l)
+		}
+	}
+	if c.receipts == nil {
+		c.receipts = make(map[common.Hash]*types.Receipt)
+	}
+	c.receipts[txHash] = receipt
+}
+
+func TestStatuses(t *testing.T) {
+	unproven, proven, finalized, batched := common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03"), common.HexToHash("0x04")
+	chain := &fakeL1{head: 20}
+	chain.prove(proven, 3)
+	chain.prove(finalized, 4)
+	chain.prove(proven, 9)
+	chain.finalize(t, 15, true, map[common.Hash]int64{finalized: 30, batched: 40}, finalized, batched)
+	chain.prove(common.HexToHash("0xff"), 5)
+
+	tracker, err := NewTracker(TrackerConfig{OptimismPortal: portal, L1StandardBridge: bridge, StartBlock: 1, ChunkSize: 4}, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := tracker.Statuses(context.Background(), nil, []common.Hash{unproven, proven, finalized, batched})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := statuses[unproven]; s.Proven || s.Finalized {
+		t.Fatalf("unexpected status of the unproven withdrawal: %+v", s)
+	}
+	if s := statuses[proven]; !s.Proven || s.ProvenTime != 1_000+12*9 || s.Finalized {
+		t.Fatalf("expected the latest proof to count, got %+v", s)
+	}
+	if s := statuses[finalized]; !s.Proven || !s.Finalized || !s.Success || s.FinalizedAmount.Int64() != 30 {
+		t.Fatalf("unexpected status of the finalized withdrawal: %+v", s)
+	}
+	if s := statuses[batched]; !s.Finalized || s.FinalizedAmount.Int64() != 40 {
+		t.Fatalf("expected the amount of the second finalization of the transaction, got %+v", s)
+	}
+	if len(statuses) != 4 {
+		t.Fatalf("got statuses of unknown withdrawals: %v", statuses)
+	}
+
+	chain.receipts = nil
+	if _, err := tracker.Statuses(context.Background(), nil, []common.Hash{finalized}); !errors.Is(err, ethereum.NotFound) {
+		t.Fatalf("expected the missing receipt to fail, got %v", err)
+	}
+}
+
+func TestStatusesBatchesHashes(t *testing.T) {
+	first, second, third := common.HexToHash("0x01"), common.HexToHash("0x02"), common.HexToHash("0x03")
+	chain := &fakeL1{head: 20}
+	chain.prove(first, 2)
+	chain.prove(second, 2)
+	chain.finalize(t, 6, true, map[common.Hash]int64{first: 10, second: 20}, first, second)
+	chain.prove(third, 14)
+
+	tracker, err := NewTracker(TrackerConfig{OptimismPortal: portal, L1StandardBridge: bridge, StartBlock: 1, ChunkSize: 5, HashBatchSize: 2}, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := tracker.Statuses(context.Background(), nil, []common.Hash{first, second, third})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !statuses[first].Finalized || !statuses[second].Finalized || !statuses[third].Proven || statuses[third].Finalized {
+		t.Fatalf("unexpected statuses: %+v %+v %+v", statuses[first], statuses[second], statuses[third])
+	}
+	// The first batch is finalized by block 10, the second is searched up to
+	// the head.
+	var ranges [][2]uint64
+	for _, q := range chain.queries {
+		if len(q.Topics[1]) > 2 {
+			t.Fatalf("filtered by %d hashes at once", len(q.Topics[1]))
+		}
+		ranges = append(ranges, [2]uint64{q.FromBlock.Uint64(), q.ToBlock.Uint64()})
+	}
+	want := [][2]uint64{{1, 5}, {6, 10}, {1, 5}, {6, 10}, {11, 15}, {16, 20}}
+	if len(ranges) != len(want) {
+		t.Fatalf("got queries of blocks %v, want %v", ranges, want)
+	}
+	for i := range want {
+		if ranges[i] != want[i] {
+			t.Fatalf("got queries of blocks %v, want %v", ranges, want)
+		}
+	}
+}